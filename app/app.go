@@ -5,21 +5,29 @@ package app
 
 import (
 	"swarmcli/docker"
+	"swarmcli/utils/config"
 	swarmlog "swarmcli/utils/log"
+	clustersview "swarmcli/views/clusters"
 	configsview "swarmcli/views/configs"
 	contextsview "swarmcli/views/contexts"
+	deployview "swarmcli/views/deploy"
+	diffview "swarmcli/views/diff"
+	explainview "swarmcli/views/explain"
+	graphview "swarmcli/views/graph"
 	helpview "swarmcli/views/help"
 	inspectview "swarmcli/views/inspect"
 	loadingview "swarmcli/views/loading"
 	logsview "swarmcli/views/logs"
 	networksview "swarmcli/views/networks"
 	nodesview "swarmcli/views/nodes"
+	pulseview "swarmcli/views/pulse"
 	revealsecretview "swarmcli/views/revealsecret"
 	secretsview "swarmcli/views/secrets"
 	servicesview "swarmcli/views/services"
 	stacksview "swarmcli/views/stacks"
 	tasksview "swarmcli/views/tasks"
 	"swarmcli/views/view"
+	xrayview "swarmcli/views/xray"
 
 	tea "github.com/charmbracelet/bubbletea"
 
@@ -49,6 +57,7 @@ func SetVersion(v string) {
 // Init should be called once at the start of the application to register all views.
 func Init() {
 	swarmlog.Init(appName)
+	config.Init(appName)
 	l := swarmlog.L()
 	defer swarmlog.Sync()
 
@@ -72,11 +81,49 @@ func Init() {
 		return helpview.New(w, h, cmds), nil
 	})
 	registerView(logsview.ViewName, func(w, h int, payload any) (view.View, tea.Cmd) {
+		if target, ok := payload.(logsview.TaskTarget); ok {
+			v := logsview.NewTask(w, h, 10000, target)
+			return v, logsview.StartTaskStreamingCmd(v.StreamCtx, target, 200, v.MaxLines)
+		}
 		service := payload.(docker.ServiceEntry)
 		v := logsview.New(w, h, 10000, service)
 		return v, logsview.StartStreamingCmd(v.StreamCtx, service, 200, v.MaxLines)
 	})
 
+	registerView(clustersview.ViewName, func(w, h int, payload any) (view.View, tea.Cmd) {
+		names, _ := payload.([]string)
+		return clustersview.New(w, h, names), nil
+	})
+
+	registerView(explainview.ViewName, func(w, h int, payload any) (view.View, tea.Cmd) {
+		target, _ := payload.(explainview.Target)
+		return explainview.New(w, h, target), nil
+	})
+
+	registerView(deployview.ViewName, func(w, h int, payload any) (view.View, tea.Cmd) {
+		req, _ := payload.(deployview.Request)
+		return deployview.New(w, h, req), deployview.StartCmd(req)
+	})
+
+	registerView(diffview.ViewName, func(w, h int, payload any) (view.View, tea.Cmd) {
+		req, _ := payload.(diffview.Request)
+		return diffview.New(w, h, req), diffview.CompareCmd(req)
+	})
+
+	registerView(xrayview.ViewName, func(w, h int, payload any) (view.View, tea.Cmd) {
+		scope, _ := payload.(docker.XrayScope)
+		return xrayview.New(w, h, scope), nil
+	})
+
+	registerView(graphview.ViewName, func(w, h int, payload any) (view.View, tea.Cmd) {
+		stack, _ := payload.(string)
+		return graphview.New(w, h, stack), graphview.LoadCmd(stack)
+	})
+
+	registerView(pulseview.ViewName, func(w, h int, payload any) (view.View, tea.Cmd) {
+		return pulseview.New(w, h), nil
+	})
+
 	registerView(configsview.ViewName, func(w, h int, payload any) (view.View, tea.Cmd) {
 		model := configsview.New(w, h)
 		return model, model.Init()
@@ -172,10 +219,10 @@ func Init() {
 			v.SetPendingSelectServiceName(selectServiceName)
 		}
 
-		entries, title := servicesview.LoadServicesForView(filterType, nodeID, stackName)
-
-		// Initialize view and return first payload
-		return v, func() tea.Msg {
+		// Initialize view and return first payload; loading runs in the
+		// background as it gathers usage stats
+		return v, docker.ContextCmd(func(ctxName string) tea.Msg {
+			entries, title := servicesview.LoadServicesForView(ctxName, filterType, nodeID, stackName)
 			return servicesview.Msg{
 				Title:      title,
 				Entries:    entries,
@@ -183,10 +230,14 @@ func Init() {
 				NodeID:     nodeID,
 				StackName:  stackName,
 			}
-		}
+		})
 	})
 
 	registerView(tasksview.ViewName, func(w, h int, payload any) (view.View, tea.Cmd) {
+		if node, ok := payload.(tasksview.NodeScope); ok {
+			model := tasksview.NewForNode(w, h, node)
+			return model, model.OnEnter()
+		}
 		stackName, _ := payload.(string)
 		model := tasksview.New(w, h, stackName)
 		return model, model.OnEnter()
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package app
+
+import (
+	"fmt"
+	"swarmcli/docker"
+	swarmlog "swarmcli/utils/log"
+	systeminfoview "swarmcli/views/systeminfo"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// handleStatsCollector reports the outcome of :collector and refreshes the
+// header's CPU/MEM, whose source changes with the collector.
+func (m *Model) handleStatsCollector(msg docker.StatsCollectorMsg) tea.Cmd {
+	if msg.Err != nil {
+		swarmlog.L().Errorf("Stats collector %s failed: %v", msg.Action, msg.Err)
+		return m.showToast(fmt.Sprintf("Stats collector: %v", msg.Err), true)
+	}
+
+	var text string
+	switch {
+	case !msg.Running && msg.Action == "stop":
+		text = "Stats collector removed, CPU/MEM cover the local daemon only"
+	case !msg.Running:
+		text = "Stats collector not deployed (:collector start)"
+	case msg.Nodes == 0:
+		text = "Stats collector starting, waiting for the first samples"
+	default:
+		text = fmt.Sprintf("Stats collector reporting from %d nodes", msg.Nodes)
+	}
+	return tea.Batch(m.showToast(text, false), systeminfoview.LoadSlowStatus())
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package app
+
+import (
+	"fmt"
+	"swarmcli/core/clipboard"
+	swarmlog "swarmcli/utils/log"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// canCopy reports whether y/Y should copy from the current view rather than
+// reach it as a key: the view must support copying and must not be taking
+// text input.
+func (m *Model) canCopy() bool {
+	v := m.focusedView()
+	_, ok := v.(clipboard.Copier)
+	return ok && !takesInput(v)
+}
+
+// copyFromCurrentView copies the current view's selection. The result
+// arrives as a clipboard.DoneMsg.
+func (m *Model) copyFromCurrentView(req clipboard.RequestMsg) tea.Cmd {
+	copier, ok := m.focusedView().(clipboard.Copier)
+	if !ok {
+		return m.showToast(fmt.Sprintf("Nothing to copy in the %s view", m.focusedView().Name()), true)
+	}
+
+	what, text := copier.CopyText(req.Alt)
+	if text == "" {
+		return m.showToast("Nothing to copy", true)
+	}
+
+	method, err := clipboard.Resolve()
+	done := clipboard.DoneMsg{What: what, Bytes: len(text), Method: method, Err: err}
+	switch {
+	case err != nil:
+		return m.handleCopyDone(done)
+	case method == clipboard.MethodOSC52:
+		// Written here rather than from a tea.Cmd so the sequence goes out
+		// between two frames instead of racing the renderer.
+		done.Err = clipboard.WriteOSC52(text)
+		return m.handleCopyDone(done)
+	}
+	return func() tea.Msg {
+		done.Err = clipboard.CopyLocal(text)
+		return done
+	}
+}
+
+func (m *Model) handleCopyDone(msg clipboard.DoneMsg) tea.Cmd {
+	if msg.Err != nil {
+		swarmlog.L().Errorf("Copy failed: %v", msg.Err)
+		return m.showToast(fmt.Sprintf("Copy failed: %v", msg.Err), true)
+	}
+	swarmlog.L().Infof("Copied %s (%d bytes) via %s", msg.What, msg.Bytes, msg.Method)
+	return m.showToast(fmt.Sprintf("Copied %s", msg.What), false)
+}
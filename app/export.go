@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package app
+
+import (
+	"fmt"
+	"swarmcli/core/export"
+	"swarmcli/utils/config"
+	swarmlog "swarmcli/utils/log"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// exportCurrentView snapshots the current view's rows and writes them to
+// disk in the background. The result arrives as an export.DoneMsg.
+func (m *Model) exportCurrentView(req export.RequestMsg) tea.Cmd {
+	v := m.focusedView()
+	exporter, ok := v.(export.Exporter)
+	if !ok {
+		return m.showToast(fmt.Sprintf("The %s view cannot be exported", v.Name()), true)
+	}
+
+	table := exporter.ExportTable()
+	path := req.Path
+	if path == "" {
+		path = export.DefaultPath(config.StateDir(), v.Name(), req.Format, time.Now())
+	}
+
+	return func() tea.Msg {
+		written, err := export.WriteFile(path, req.Format, table)
+		return export.DoneMsg{Path: written, Rows: len(table.Rows), Err: err}
+	}
+}
+
+func (m *Model) handleExportDone(msg export.DoneMsg) tea.Cmd {
+	if msg.Err != nil {
+		swarmlog.L().Errorf("Export failed: %v", msg.Err)
+		return m.showToast(fmt.Sprintf("Export failed: %v", msg.Err), true)
+	}
+	swarmlog.L().Infof("Exported %d rows to %s", msg.Rows, msg.Path)
+	return m.showToast(fmt.Sprintf("Exported %d rows to %s", msg.Rows, msg.Path), false)
+}
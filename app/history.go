@@ -0,0 +1,66 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package app
+
+import (
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// navigateHistory moves back (negative offset) or forward through the
+// navigation history. The views involved are the same instances that were
+// left, so they come back with their scroll position, filter and selection.
+func (m *Model) navigateHistory(offset int) tea.Cmd {
+	oldView := m.currentView
+	target := m.viewStack.Move(oldView, offset)
+	if target == nil {
+		return nil
+	}
+
+	exitCmd := oldView.OnExit()
+	m.currentView = target
+	enterCmd := target.OnEnter()
+	resizeCmd := handleViewResize(target, m.viewport.Width, m.viewport.Height, false)
+
+	return tea.Batch(exitCmd, enterCmd, resizeCmd)
+}
+
+// openHistoryPicker shows the breadcrumb picker over the current view.
+func (m *Model) openHistoryPicker() {
+	back := m.viewStack.Views()
+	forward := m.viewStack.Forward()
+
+	entries := make([]string, 0, len(back)+1+len(forward))
+	for _, v := range back {
+		entries = append(entries, v.Name())
+	}
+	entries = append(entries, m.currentView.Name())
+	for _, v := range forward {
+		entries = append(entries, v.Name())
+	}
+	m.historyPicker.Show(entries, len(back))
+}
+
+// viewTakesInput reports whether the current view is reading text (a
+// search, filter or dialog), in which case global letter keys must reach it.
+func (m *Model) viewTakesInput() bool {
+	return takesInput(m.currentView)
+}
+
+func takesInput(v view.View) bool {
+	if d, ok := v.(interface{ HasActiveDialog() bool }); ok && d.HasActiveDialog() {
+		return true
+	}
+	if s, ok := v.(interface{ IsSearching() bool }); ok && s.IsSearching() {
+		return true
+	}
+	if s, ok := v.(interface{ GetSearchMode() bool }); ok && s.GetSearchMode() {
+		return true
+	}
+	if n, ok := v.(interface{ GetNodeSelectVisible() bool }); ok && n.GetNodeSelectVisible() {
+		return true
+	}
+	return false
+}
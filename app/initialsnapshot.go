@@ -24,8 +24,8 @@ type snapshotLoadedMsg struct{ Err error }
 // loadSnapshotAndNavigateToStacksCmd loads snapshot and then navigates to stacks view
 // Used after context switch to show the stacks for the new context
 func loadSnapshotAndNavigateToStacksCmd() tea.Cmd {
-	return func() tea.Msg {
-		_, err := docker.RefreshSnapshot()
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		_, err := docker.RefreshSnapshotFor(ctxName)
 		if err != nil {
 			return snapshotLoadedMsg{Err: err}
 		}
@@ -34,5 +34,5 @@ func loadSnapshotAndNavigateToStacksCmd() tea.Cmd {
 			ViewName: stacksview.ViewName,
 			Replace:  true, // Replace the loading view
 		}
-	}
+	})
 }
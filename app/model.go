@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"swarmcli/docker"
 	"swarmcli/ui"
+	swarmlog "swarmcli/utils/log"
 	"swarmcli/views/commandinput"
+	"swarmcli/views/historypicker"
 	loadingview "swarmcli/views/loading"
 	systeminfoview "swarmcli/views/systeminfo"
 	"swarmcli/views/view"
@@ -29,9 +31,26 @@ type Model struct {
 
 	commandInput *commandinput.Model
 
+	// Optional linked pane next to the current view
+	split splitState
+
+	// Tabs, each bound to a Docker context. The fields above hold the
+	// active tab's views.
+	tabs      []*tab
+	activeTab int
+	nextTabID int
+
+	// Breadcrumb picker over the navigation history
+	historyPicker *historypicker.Model
+
 	// Terminal dimensions
 	terminalWidth  int
 	terminalHeight int
+
+	// Transient notice shown next to the breadcrumb bar
+	toast    string
+	toastErr bool
+	toastID  int
 }
 
 func InitialModel() *Model {
@@ -49,12 +68,21 @@ func InitialModel() *Model {
 		"message": "Loading Swarm nodes and stacks...",
 	})
 
+	// The first tab is bound to the context we were started with
+	ctxName, err := docker.GetContextFromEnv()
+	if err != nil {
+		swarmlog.L().Warnf("resolving docker context: %v", err)
+	}
+	docker.SetActiveContext(ctxName)
+
 	return &Model{
+		tabs:           []*tab{newTab(0, ctxName)},
 		viewport:       vp,
 		currentView:    loading,
 		systemInfo:     systeminfoview.New(version),
 		viewStack:      viewstack.Stack{},
 		commandInput:   cmdBar(),
+		historyPicker:  historypicker.New(),
 		terminalWidth:  terminalWidth,
 		terminalHeight: terminalHeight,
 	}
@@ -85,8 +113,9 @@ func (m *Model) switchToView(name string, data any) tea.Cmd {
 	newView, loadCmd := factory(m.viewport.Width, m.viewport.Height, data)
 	resizeCmd := handleViewResize(newView, m.viewport.Width, m.viewport.Height, false)
 
-	// Push current view onto stack
+	// Push current view onto stack; opening a new view ends the forward history
 	m.viewStack.Push(m.currentView)
+	m.viewStack.ClearForward()
 	m.currentView = newView
 
 	// Enter hook for new view
@@ -121,6 +150,9 @@ func (m *Model) renderStackBar() string {
 	stack := append(m.viewStack.Views(), m.currentView)
 
 	var parts []string
+	if bar := m.renderTabBar(); bar != "" {
+		parts = append(parts, bar)
+	}
 	for i, v := range stack {
 		if i > 0 {
 			parts = append(parts, lipgloss.NewStyle().Faint(true).Render(" → "))
@@ -131,6 +163,14 @@ func (m *Model) renderStackBar() string {
 		parts = append(parts, style.Render(fmt.Sprintf(" %s ", label)))
 	}
 
+	// Views ahead of the current one, reachable with ]
+	for _, v := range m.viewStack.Forward() {
+		faint := lipgloss.NewStyle().Faint(true)
+		parts = append(parts, faint.Render(" → "), faint.Render(fmt.Sprintf(" %s ", v.Name())))
+	}
+
+	parts = append(parts, m.renderToast())
+
 	return lipgloss.JoinHorizontal(lipgloss.Left, parts...)
 }
 
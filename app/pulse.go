@@ -0,0 +1,31 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package app
+
+import (
+	"swarmcli/core/pulse"
+	"swarmcli/docker"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// pulseRecorder returns the metrics recorder of the active tab's context.
+func (m *Model) pulseRecorder() *pulse.Recorder {
+	return pulse.For(m.currentTab().context)
+}
+
+// samplePulseCmd records the cached snapshot of the active tab's context and
+// starts a background refresh for the next tick, so sampling never blocks on
+// the API.
+func (m *Model) samplePulseCmd() tea.Cmd {
+	ctxName := m.currentTab().context
+	rec := pulse.For(ctxName)
+	return func() tea.Msg {
+		docker.TriggerRefreshIfNeededFor(ctxName)
+		if rec.RecordSnapshot(docker.SnapshotFor(ctxName)) {
+			return pulse.SampledMsg{}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,435 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package app
+
+import (
+	"fmt"
+	"strings"
+	"swarmcli/core/clipboard"
+	"swarmcli/docker"
+	"swarmcli/views/helpbar"
+	logsview "swarmcli/views/logs"
+	nodesview "swarmcli/views/nodes"
+	servicesview "swarmcli/views/services"
+	stacksview "swarmcli/views/stacks"
+	tasksview "swarmcli/views/tasks"
+	"swarmcli/views/view"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type splitOrientation int
+
+const (
+	// splitVertical puts the linked pane to the right of the current view.
+	splitVertical splitOrientation = iota
+	// splitHorizontal puts the linked pane below the current view.
+	splitHorizontal
+)
+
+const (
+	splitDefaultRatio = 50
+	splitMinRatio     = 20
+	splitMaxRatio     = 80
+	splitStep         = 5
+	// splitFollowDelay debounces cursor movement so scrolling through a list
+	// does not open a new pane for every row passed.
+	splitFollowDelay = 300 * time.Millisecond
+)
+
+// paneLink describes the view shown next to a list and how it follows the
+// list's selection.
+type paneLink struct {
+	target string
+	// follow returns a key identifying the selection and the payload used
+	// to open the target view for it.
+	follow func(v view.View) (key string, payload any, ok bool)
+}
+
+var paneLinks = map[string]paneLink{
+	servicesview.ViewName: {
+		target: logsview.ViewName,
+		follow: func(v view.View) (string, any, bool) {
+			sv, ok := v.(interface {
+				SelectedService() (docker.ServiceEntry, bool)
+			})
+			if !ok {
+				return "", nil, false
+			}
+			s, ok := sv.SelectedService()
+			return s.ServiceID, s, ok
+		},
+	},
+	nodesview.ViewName: {
+		target: tasksview.ViewName,
+		follow: func(v view.View) (string, any, bool) {
+			nv, ok := v.(interface {
+				SelectedNode() (docker.NodeEntry, bool)
+			})
+			if !ok {
+				return "", nil, false
+			}
+			n, ok := nv.SelectedNode()
+			return n.ID, tasksview.NodeScope{ID: n.ID, Hostname: n.Hostname}, ok
+		},
+	},
+	stacksview.ViewName: {
+		target: tasksview.ViewName,
+		follow: func(v view.View) (string, any, bool) {
+			sv, ok := v.(interface {
+				SelectedStack() (docker.StackEntry, bool)
+			})
+			if !ok {
+				return "", nil, false
+			}
+			s, ok := sv.SelectedStack()
+			return s.Name, s.Name, ok
+		},
+	},
+}
+
+// splitState is the optional second pane. The split stays enabled while
+// navigating; the pane is shown whenever the current view has a link.
+type splitState struct {
+	enabled     bool
+	orientation splitOrientation
+	ratio       int
+
+	pane        view.View // nil while hidden
+	owner       view.View // the view the pane follows
+	key         string    // selection shown in the pane
+	pending     string    // selection waiting for the follow delay
+	paneFocused bool
+
+	// outer sizes of both panes from the last layout, help line included
+	mainW, mainH int
+	paneW, paneH int
+}
+
+// splitFollowMsg fires after the follow delay for a selection.
+type splitFollowMsg struct{ key string }
+
+// focusedView returns the view receiving keys: the linked pane when it has
+// focus, otherwise the current view.
+func (m *Model) focusedView() view.View {
+	if m.split.pane != nil && m.split.paneFocused {
+		return m.split.pane
+	}
+	return m.currentView
+}
+
+// toggleSplit turns the split on with the given orientation, switches the
+// orientation of an open split, or turns it off when the orientation is
+// unchanged.
+func (m *Model) toggleSplit(o splitOrientation) tea.Cmd {
+	if m.split.enabled && m.split.orientation == o {
+		return m.disableSplit()
+	}
+	if _, ok := paneLinks[m.currentView.Name()]; !ok && !m.split.enabled {
+		return m.showToast(fmt.Sprintf("The %s view has no linked pane", m.currentView.Name()), true)
+	}
+	m.split.enabled = true
+	m.split.orientation = o
+	if m.split.ratio == 0 {
+		m.split.ratio = splitDefaultRatio
+	}
+	if m.split.pane != nil {
+		return m.layoutSplit(m.viewport.Width, m.mainHeight())
+	}
+	return m.syncSplit()
+}
+
+// handleSplitMsg applies :split.
+func (m *Model) handleSplitMsg(msg view.SplitMsg) tea.Cmd {
+	switch msg.Mode {
+	case "":
+		if m.split.enabled {
+			return m.disableSplit()
+		}
+		return m.toggleSplit(splitVertical)
+	case "v", "vertical":
+		if m.split.enabled && m.split.orientation == splitVertical {
+			return nil
+		}
+		return m.toggleSplit(splitVertical)
+	case "h", "horizontal":
+		if m.split.enabled && m.split.orientation == splitHorizontal {
+			return nil
+		}
+		return m.toggleSplit(splitHorizontal)
+	case "off":
+		return m.disableSplit()
+	}
+	return m.showToast(fmt.Sprintf("Unknown split mode %q (use v, h or off)", msg.Mode), true)
+}
+
+func (m *Model) disableSplit() tea.Cmd {
+	m.split.enabled = false
+	return m.closePane()
+}
+
+// closePane removes the pane and gives the full area back to the current
+// view.
+func (m *Model) closePane() tea.Cmd {
+	if m.split.pane == nil {
+		return nil
+	}
+	exitCmd := m.split.pane.OnExit()
+	m.split.pane = nil
+	m.split.owner = nil
+	m.split.key, m.split.pending = "", ""
+	m.split.paneFocused = false
+	return tea.Batch(exitCmd, handleViewResize(m.currentView, m.viewport.Width, m.mainHeight(), false))
+}
+
+// syncSplit shows, replaces or hides the pane for the current view.
+func (m *Model) syncSplit() tea.Cmd {
+	if !m.split.enabled {
+		return m.closePane()
+	}
+	link, ok := paneLinks[m.currentView.Name()]
+	if !ok {
+		return m.closePane()
+	}
+	key, payload, ok := link.follow(m.currentView)
+	if !ok {
+		// Nothing selected yet (e.g. still loading); followSelection opens
+		// the pane once there is.
+		m.split.owner = m.currentView
+		return nil
+	}
+	return m.openPane(link, key, payload)
+}
+
+// openPane replaces the pane with the link target for the given selection.
+func (m *Model) openPane(link paneLink, key string, payload any) tea.Cmd {
+	factory, ok := viewRegistry[link.target]
+	if !ok {
+		return nil
+	}
+	var exitCmd tea.Cmd
+	if m.split.pane != nil {
+		exitCmd = m.split.pane.OnExit()
+	}
+
+	pane, loadCmd := factory(m.viewport.Width, m.viewport.Height, payload)
+	m.split.pane = pane
+	m.split.owner = m.currentView
+	m.split.key = key
+	m.split.pending = ""
+	enterCmd := pane.OnEnter()
+
+	return tea.Batch(exitCmd, loadCmd, enterCmd, m.layoutSplit(m.viewport.Width, m.mainHeight()))
+}
+
+// followSelection keeps the pane in step with the current view: it syncs
+// immediately after navigation and, after a short delay, when the selection
+// changes.
+func (m *Model) followSelection() tea.Cmd {
+	if !m.split.enabled {
+		return nil
+	}
+	if m.split.owner != m.currentView {
+		return m.syncSplit()
+	}
+	link, ok := paneLinks[m.currentView.Name()]
+	if !ok {
+		return nil
+	}
+	key, _, ok := link.follow(m.currentView)
+	if !ok || (key == m.split.key && m.split.pane != nil) || key == m.split.pending {
+		return nil
+	}
+	m.split.pending = key
+	return tea.Tick(splitFollowDelay, func(time.Time) tea.Msg {
+		return splitFollowMsg{key: key}
+	})
+}
+
+func (m *Model) handleSplitFollow(msg splitFollowMsg) tea.Cmd {
+	if !m.split.enabled || msg.key != m.split.pending {
+		return nil
+	}
+	m.split.pending = ""
+	link, ok := paneLinks[m.currentView.Name()]
+	if !ok {
+		return nil
+	}
+	key, payload, ok := link.follow(m.currentView)
+	if !ok || key != msg.key || (key == m.split.key && m.split.pane != nil) {
+		return nil
+	}
+	return m.openPane(link, key, payload)
+}
+
+// resizeSplit grows (positive) or shrinks the current view's share.
+func (m *Model) resizeSplit(delta int) tea.Cmd {
+	if delta == 0 {
+		m.split.ratio = splitDefaultRatio
+	} else {
+		m.split.ratio = max(splitMinRatio, min(splitMaxRatio, m.split.ratio+delta))
+	}
+	return m.layoutSplit(m.viewport.Width, m.mainHeight())
+}
+
+// mainHeight is the height passed to views for the main area, accounting for
+// the command input.
+func (m *Model) mainHeight() int {
+	if m.commandInput != nil && m.commandInput.Visible() {
+		return max(m.viewport.Height-3, 0)
+	}
+	return m.viewport.Height
+}
+
+// resizeMain sizes the current view, or both panes when split.
+func (m *Model) resizeMain(width, height int) tea.Cmd {
+	if m.split.pane != nil {
+		return m.layoutSplit(width, height)
+	}
+	return handleViewResize(m.currentView, width, height, false)
+}
+
+// layoutSplit divides the main area between the current view and the pane.
+// width and height are what handleViewResize would get for a single view.
+// Each pane keeps one line for its help bar.
+func (m *Model) layoutSplit(width, height int) tea.Cmd {
+	if m.split.pane == nil {
+		return nil
+	}
+	// Outer area in terminal cells: views add 4 columns of frame padding and
+	// handleViewResize takes 7 lines for the header and breadcrumbs.
+	totalW := width + 4
+	totalH := height - 7
+
+	if m.split.orientation == splitVertical {
+		m.split.mainW = totalW * m.split.ratio / 100
+		m.split.paneW = totalW - m.split.mainW
+		m.split.mainH, m.split.paneH = totalH, totalH
+	} else {
+		m.split.mainH = totalH * m.split.ratio / 100
+		m.split.paneH = totalH - m.split.mainH
+		m.split.mainW, m.split.paneW = totalW, totalW
+	}
+
+	return tea.Batch(
+		m.currentView.Update(tea.WindowSizeMsg{Width: max(m.split.mainW-4, 1), Height: max(m.split.mainH-1, 1)}),
+		m.split.pane.Update(tea.WindowSizeMsg{Width: max(m.split.paneW-4, 1), Height: max(m.split.paneH-1, 1)}),
+	)
+}
+
+// renderSplit draws both panes, each with its own help line.
+func (m *Model) renderSplit() string {
+	main := renderPane(m.currentView, m.split.mainW, m.split.mainH, !m.split.paneFocused)
+	pane := renderPane(m.split.pane, m.split.paneW, m.split.paneH, m.split.paneFocused)
+	if m.split.orientation == splitVertical {
+		return lipgloss.JoinHorizontal(lipgloss.Top, main, pane)
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, main, pane)
+}
+
+func renderPane(v view.View, width, height int, focused bool) string {
+	body := lipgloss.NewStyle().MaxWidth(width).MaxHeight(max(height-1, 0)).Render(v.View())
+	body = lipgloss.Place(width, max(height-1, 0), lipgloss.Left, lipgloss.Top, body)
+	return body + "\n" + paneHelp(v.ShortHelpItems(), width, focused)
+}
+
+// paneHelp renders a pane's help entries on one line. The focused pane is
+// marked and highlighted.
+func paneHelp(entries []helpbar.HelpEntry, width int, focused bool) string {
+	keyStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	descStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	marker := "  "
+	if focused {
+		keyStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("63")).Bold(true)
+		descStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("250"))
+		marker = keyStyle.Render("▶ ")
+	}
+
+	var b strings.Builder
+	b.WriteString(marker)
+	used := 2
+	for _, e := range entries {
+		w := lipgloss.Width(e.Key) + lipgloss.Width(e.Desc) + 5
+		if used+w > width {
+			break
+		}
+		b.WriteString(keyStyle.Render("<"+e.Key+">") + " " + descStyle.Render(e.Desc) + "  ")
+		used += w
+	}
+	return lipgloss.NewStyle().MaxWidth(width).Render(b.String())
+}
+
+// handlePaneKey handles keys while the linked pane has focus.
+func (m *Model) handlePaneKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	pane := m.split.pane
+	if takesInput(pane) {
+		return m, pane.Update(msg)
+	}
+
+	switch msg.String() {
+	case "tab":
+		m.split.paneFocused = false
+		return m, nil
+	case "esc":
+		// Let the pane leave its own modes first (line selection, fullscreen)
+		if v, ok := pane.(interface{ HasLineSelection() bool }); ok && v.HasLineSelection() {
+			return m, pane.Update(msg)
+		}
+		if v, ok := pane.(interface{ GetFullscreen() bool }); ok && v.GetFullscreen() {
+			return m, pane.Update(msg)
+		}
+		m.split.paneFocused = false
+		return m, nil
+	case "q":
+		return m, m.disableSplit()
+	case "ctrl+c":
+		return m, tea.Batch(m.disableSplit(), m.goBack())
+	}
+
+	if cmd, ok := m.handleTabKey(msg); ok {
+		return m, cmd
+	}
+	if cmd, ok := m.handleSplitKey(msg); ok {
+		return m, cmd
+	}
+	if k := msg.String(); (k == "y" || k == "Y") && m.canCopy() {
+		return m, m.copyFromCurrentView(clipboard.RequestMsg{Alt: k == "Y"})
+	}
+	return m, pane.Update(msg)
+}
+
+// handleSplitKey handles the split keys shared by both panes.
+func (m *Model) handleSplitKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	switch msg.String() {
+	case "|":
+		return m.toggleSplit(splitVertical), true
+	case "\\":
+		return m.toggleSplit(splitHorizontal), true
+	}
+	if m.split.pane == nil {
+		return nil, false
+	}
+	switch msg.String() {
+	case "tab":
+		m.split.paneFocused = !m.split.paneFocused
+		return nil, true
+	case ">":
+		return m.resizeSplit(splitStep), true
+	case "<":
+		return m.resizeSplit(-splitStep), true
+	case "=":
+		return m.resizeSplit(0), true
+	}
+	return nil, false
+}
+
+// refreshPane reloads a pane that does not update itself (e.g. tasks).
+func (m *Model) refreshPane() tea.Cmd {
+	if r, ok := m.split.pane.(interface{ Refresh() tea.Cmd }); ok {
+		return r.Refresh()
+	}
+	return nil
+}
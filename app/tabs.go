@@ -0,0 +1,267 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package app
+
+import (
+	"fmt"
+	"hash/fnv"
+	"reflect"
+	"swarmcli/docker"
+	"swarmcli/utils/config"
+	swarmlog "swarmcli/utils/log"
+	loadingview "swarmcli/views/loading"
+	systeminfoview "swarmcli/views/systeminfo"
+	"swarmcli/views/view"
+	"swarmcli/views/viewstack"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// tabColors are the default tab colours; a context gets one from its name
+// unless contexts.yaml sets it.
+var tabColors = []string{"63", "39", "35", "214", "170", "81", "203", "149"}
+
+// tab is a workspace bound to one Docker context. The active tab's views
+// live in the Model's fields; the others are parked here with everything
+// they had on screen.
+type tab struct {
+	id      int
+	context string
+	color   lipgloss.Color
+
+	currentView view.View
+	viewStack   viewstack.Stack
+	split       splitState
+
+	// pending holds results for this tab's views that arrived while it was
+	// in the background; they are replayed when it becomes active.
+	pending []tea.Msg
+}
+
+// tabMsg is a message produced by a command of the given tab.
+type tabMsg struct {
+	tab int
+	msg tea.Msg
+}
+
+func newTab(id int, context string) *tab {
+	return &tab{id: id, context: context, color: contextColor(context)}
+}
+
+// contextColor returns the configured colour of a context, or one picked
+// from its name.
+func contextColor(name string) lipgloss.Color {
+	settings, err := config.Context(name)
+	if err != nil {
+		swarmlog.L().Warnf("reading context settings: %v", err)
+	}
+	if settings.Color != "" {
+		return lipgloss.Color(settings.Color)
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return lipgloss.Color(tabColors[h.Sum32()%uint32(len(tabColors))])
+}
+
+// tagCmd wraps cmd so its result is delivered to the given tab even after
+// the user switched to another one. Commands asking for a Docker context
+// (docker.ContextCmd) run against the tab's, captured here, whichever tab is
+// active by the time they run.
+func tagCmd(t *tab, cmd tea.Cmd) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+	ctxName := t.context
+	return func() tea.Msg {
+		msg := cmd()
+		// A request may answer with another, e.g. an action that ends by
+		// running a refresh command
+		for req, ok := msg.(docker.ContextRequest); ok; req, ok = msg.(docker.ContextRequest) {
+			msg = req(ctxName)
+		}
+		return tagMsg(t, msg)
+	}
+}
+
+var teaPkg = reflect.TypeOf(tea.QuitMsg{}).PkgPath()
+
+func tagMsg(t *tab, msg tea.Msg) tea.Msg {
+	switch msg := msg.(type) {
+	case nil, tabMsg:
+		return msg
+	case tea.BatchMsg:
+		out := make(tea.BatchMsg, len(msg))
+		for i, c := range msg {
+			out[i] = tagCmd(t, c)
+		}
+		return out
+	}
+	// Bubble Tea's own messages (quit, sequences, exec, ...) are handled by
+	// the runtime and must reach it as they are
+	if reflect.TypeOf(msg).PkgPath() == teaPkg {
+		return msg
+	}
+	return tabMsg{tab: t.id, msg: msg}
+}
+
+// isAppMsg reports whether msg belongs to the app rather than to a tab's
+// views; such messages are handled right away whichever tab they came from.
+func isAppMsg(msg tea.Msg) bool {
+	switch msg.(type) {
+	case tickMsg, toastExpiredMsg, docker.EventMsg, docker.StatsCollectorMsg,
+		systeminfoview.Msg, systeminfoview.SlowStatusMsg,
+		systeminfoview.TickMsg, systeminfoview.SpinnerTickMsg:
+		return true
+	}
+	return false
+}
+
+func (m *Model) activeTabID() int {
+	return m.tabs[m.activeTab].id
+}
+
+func (m *Model) currentTab() *tab {
+	return m.tabs[m.activeTab]
+}
+
+// routeTabMsg unwraps a tagged message. It returns false when the message
+// belongs to a background tab, which keeps it until it is active again.
+func (m *Model) routeTabMsg(msg tabMsg) (tea.Msg, bool) {
+	if msg.tab == m.activeTabID() || isAppMsg(msg.msg) {
+		return msg.msg, true
+	}
+	for _, t := range m.tabs {
+		if t.id == msg.tab {
+			t.pending = append(t.pending, msg.msg)
+			break
+		}
+	}
+	// Messages of closed tabs are dropped
+	return nil, false
+}
+
+// parkActiveTab stores the active tab's views and leaves them.
+func (m *Model) parkActiveTab() tea.Cmd {
+	t := m.tabs[m.activeTab]
+	cmds := []tea.Cmd{m.currentView.OnExit()}
+	if m.split.pane != nil {
+		cmds = append(cmds, m.split.pane.OnExit())
+	}
+	t.currentView, t.viewStack, t.split = m.currentView, m.viewStack, m.split
+	return tagCmd(t, tea.Batch(cmds...))
+}
+
+// activateTab binds the app to the tab at index i and restores its views.
+func (m *Model) activateTab(i int) tea.Cmd {
+	m.activeTab = i
+	t := m.tabs[i]
+	docker.SetActiveContext(t.context)
+	m.currentView, m.viewStack, m.split = t.currentView, t.viewStack, t.split
+	t.currentView, t.viewStack, t.split = nil, viewstack.Stack{}, splitState{}
+	m.historyPicker.Visible = false
+
+	cmds := []tea.Cmd{m.currentView.OnEnter()}
+	if m.split.pane != nil {
+		cmds = append(cmds, m.split.pane.OnEnter())
+	}
+	cmds = append(cmds, m.updateForResize(tea.WindowSizeMsg{
+		Width:  m.terminalWidth,
+		Height: m.terminalHeight,
+	}))
+
+	// Catch up on what arrived while in the background
+	pending := t.pending
+	t.pending = nil
+	for _, msg := range pending {
+		_, cmd := m.update(msg)
+		cmds = append(cmds, cmd)
+	}
+	cmds = append(cmds, systeminfoview.LoadStatus())
+	return tea.Batch(cmds...)
+}
+
+// switchTab makes the tab at index i the active one.
+func (m *Model) switchTab(i int) tea.Cmd {
+	if i == m.activeTab || i < 0 || i >= len(m.tabs) {
+		return nil
+	}
+	exitCmd := m.parkActiveTab()
+	return tea.Batch(exitCmd, m.activateTab(i))
+}
+
+// openTab switches to the tab bound to context, opening one if there is
+// none.
+func (m *Model) openTab(context string) tea.Cmd {
+	if context == "" {
+		context = m.tabs[m.activeTab].context
+	}
+	for i, t := range m.tabs {
+		if t.context == context {
+			if i == m.activeTab {
+				return m.showToast(fmt.Sprintf("Already on %s", context), false)
+			}
+			return m.switchTab(i)
+		}
+	}
+
+	exitCmd := m.parkActiveTab()
+	m.nextTabID++
+	t := newTab(m.nextTabID, context)
+	t.currentView = loadingview.New(m.viewport.Width, m.viewport.Height, true, map[string]string{
+		"title":   "Loading",
+		"header":  "Fetching cluster info",
+		"message": fmt.Sprintf("Loading Swarm nodes and stacks of %s...", context),
+	})
+	m.tabs = append(m.tabs, t)
+
+	return tea.Batch(exitCmd, m.activateTab(len(m.tabs)-1), loadSnapshotAndNavigateToStacksCmd())
+}
+
+// closeTab closes the active tab and activates its neighbour.
+func (m *Model) closeTab() tea.Cmd {
+	if len(m.tabs) == 1 {
+		return m.showToast("Cannot close the last tab", true)
+	}
+	exitCmd := m.parkActiveTab()
+	m.tabs = append(m.tabs[:m.activeTab], m.tabs[m.activeTab+1:]...)
+	return tea.Batch(exitCmd, m.activateTab(min(m.activeTab, len(m.tabs)-1)))
+}
+
+// handleTabKey handles the tab keys: alt+1..9 jump to a tab, ctrl+pgdown
+// and ctrl+pgup cycle through them.
+func (m *Model) handleTabKey(msg tea.KeyMsg) (tea.Cmd, bool) {
+	if len(m.tabs) < 2 {
+		return nil, false
+	}
+	switch k := msg.String(); k {
+	case "ctrl+pgdown":
+		return m.switchTab((m.activeTab + 1) % len(m.tabs)), true
+	case "ctrl+pgup":
+		return m.switchTab((m.activeTab + len(m.tabs) - 1) % len(m.tabs)), true
+	case "alt+1", "alt+2", "alt+3", "alt+4", "alt+5", "alt+6", "alt+7", "alt+8", "alt+9":
+		return m.switchTab(int(k[len(k)-1] - '1')), true
+	}
+	return nil, false
+}
+
+// renderTabBar draws the tabs with their context colours; nothing while
+// there is a single tab.
+func (m *Model) renderTabBar() string {
+	if len(m.tabs) < 2 {
+		return ""
+	}
+	var parts []string
+	for i, t := range m.tabs {
+		label := fmt.Sprintf(" %d %s ", i+1, t.context)
+		if i == m.activeTab {
+			parts = append(parts, lipgloss.NewStyle().Bold(true).
+				Foreground(lipgloss.Color("0")).Background(t.color).Render(label))
+		} else {
+			parts = append(parts, lipgloss.NewStyle().Foreground(t.color).Render(label))
+		}
+	}
+	parts = append(parts, lipgloss.NewStyle().Faint(true).Render(" │ "))
+	return lipgloss.JoinHorizontal(lipgloss.Left, parts...)
+}
@@ -18,5 +18,5 @@ func tick() tea.Cmd {
 }
 
 func (m *Model) handleTick(msg tickMsg) (tea.Model, tea.Cmd) {
-	return m, systeminfoview.LoadStatus()
+	return m, tea.Batch(systeminfoview.LoadStatus(), m.refreshPane(), m.samplePulseCmd())
 }
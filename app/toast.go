@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package app
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const toastDuration = 5 * time.Second
+
+// toastExpiredMsg clears the toast it was scheduled for, unless a newer
+// toast replaced it in the meantime.
+type toastExpiredMsg struct{ id int }
+
+// showToast displays a short notice next to the breadcrumb bar.
+func (m *Model) showToast(text string, isErr bool) tea.Cmd {
+	m.toastID++
+	m.toast = text
+	m.toastErr = isErr
+	id := m.toastID
+	return tea.Tick(toastDuration, func(time.Time) tea.Msg {
+		return toastExpiredMsg{id: id}
+	})
+}
+
+func (m *Model) renderToast() string {
+	if m.toast == "" {
+		return ""
+	}
+	color := lipgloss.Color("10")
+	if m.toastErr {
+		color = lipgloss.Color("9")
+	}
+	return lipgloss.NewStyle().Foreground(color).Bold(true).Render("  " + m.toast)
+}
@@ -7,9 +7,12 @@ import (
 	"fmt"
 	"strings"
 	"swarmcli/commands/api"
+	"swarmcli/core/clipboard"
+	"swarmcli/core/export"
 	"swarmcli/docker"
 	"swarmcli/views/commandinput"
 	contextsview "swarmcli/views/contexts"
+	"swarmcli/views/historypicker"
 	loadingview "swarmcli/views/loading"
 	logsview "swarmcli/views/logs"
 	nodesview "swarmcli/views/nodes"
@@ -21,11 +24,35 @@ import (
 )
 
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch tm := msg.(type) {
+	case tabMsg:
+		var ok bool
+		if msg, ok = m.routeTabMsg(tm); !ok {
+			return m, nil
+		}
+	case docker.ContextRequest:
+		// A command that bypassed tagCmd, e.g. from Init; run it against
+		// the active tab
+		return m, tagCmd(m.currentTab(), func() tea.Msg { return tm })
+	}
+	model, cmd := m.update(msg)
+	// Keep a linked pane in step with the current view's selection
+	if follow := m.followSelection(); follow != nil {
+		cmd = tea.Batch(cmd, follow)
+	}
+	// Results go back to the tab that asked for them
+	return model, tagCmd(m.currentTab(), cmd)
+}
+
+func (m *Model) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case docker.EventMsg:
 		// On Docker events, trigger a background refresh and, if currently
 		// viewing stacks/nodes, trigger a reload so the UI updates quickly using cached data.
 		docker.TriggerRefreshIfNeeded()
+		if msg.Err == nil && msg.Type != "timeout" {
+			m.pulseRecorder().RecordEvent()
+		}
 		// If node event, refresh nodes view; if stacks view, refresh stacks.
 		switch msg.Type {
 		case "node":
@@ -98,6 +125,10 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					return m, cmd
 				}
 			}
+			// A focused pane reading text gets the key too
+			if m.split.pane != nil && m.split.paneFocused && takesInput(m.split.pane) {
+				return m, m.split.pane.Update(msg)
+			}
 
 			if !m.commandInput.Visible() {
 				cmd := m.commandInput.Show()
@@ -107,7 +138,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if adjHeight < 0 {
 					adjHeight = 0
 				}
-				resizeCmd := handleViewResize(m.currentView, m.viewport.Width, adjHeight, false)
+				resizeCmd := m.resizeMain(m.viewport.Width, adjHeight)
 				return m, tea.Batch(cmd, resizeCmd)
 			}
 			// If already visible, consume it and do nothing
@@ -125,7 +156,7 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			// If visibility changed from true -> false, trigger resize to restore height
 			if prevVisible && !m.commandInput.Visible() {
 				// Command input just hid: restore the full usable viewport height.
-				resizeCmd := handleViewResize(m.currentView, m.viewport.Width, m.viewport.Height, false)
+				resizeCmd := m.resizeMain(m.viewport.Width, m.viewport.Height)
 				return m, tea.Batch(cmd, resizeCmd)
 			}
 			return m, cmd
@@ -142,6 +173,9 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, cmd
 
 	case systeminfoview.SlowStatusMsg:
+		if cpu, mem, ok := msg.Usage(); ok {
+			m.pulseRecorder().RecordUsage(cpu, mem)
+		}
 		var cmd tea.Cmd
 		cmd = m.systemInfo.Update(msg)
 		return m, cmd
@@ -157,9 +191,14 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, cmd
 
 	case contextsview.ContextChangedNotification:
-		// Context has changed - show loading view then navigate to stacks
-		// Invalidate snapshot cache so stacks load fresh data for new context
-		docker.InvalidateSnapshot()
+		// Context has changed - rebind the tab, show loading view then
+		// navigate to stacks. Snapshots are cached per context, so the new
+		// context's data never mixes with the old one's.
+		if msg.Name != "" {
+			t := m.tabs[m.activeTab]
+			t.context, t.color = msg.Name, contextColor(msg.Name)
+			docker.SetActiveContext(msg.Name)
+		}
 		cmd := m.replaceView(loadingview.ViewName, map[string]string{
 			"title":   "Loading",
 			"header":  "Fetching cluster info",
@@ -172,6 +211,46 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			loadSnapshotAndNavigateToStacksCmd(),
 		)
 
+	case export.RequestMsg:
+		return m, m.exportCurrentView(msg)
+
+	case export.DoneMsg:
+		return m, m.handleExportDone(msg)
+
+	case docker.StatsCollectorMsg:
+		return m, m.handleStatsCollector(msg)
+
+	case clipboard.RequestMsg:
+		return m, m.copyFromCurrentView(msg)
+
+	case clipboard.DoneMsg:
+		return m, m.handleCopyDone(msg)
+
+	case historypicker.OpenMsg:
+		m.openHistoryPicker()
+		return m, nil
+
+	case historypicker.JumpMsg:
+		return m, m.navigateHistory(msg.Offset)
+
+	case view.OpenTabMsg:
+		return m, m.openTab(msg.Context)
+
+	case view.CloseTabMsg:
+		return m, m.closeTab()
+
+	case view.SplitMsg:
+		return m, m.handleSplitMsg(msg)
+
+	case splitFollowMsg:
+		return m, m.handleSplitFollow(msg)
+
+	case toastExpiredMsg:
+		if msg.id == m.toastID {
+			m.toast = ""
+		}
+		return m, nil
+
 	case loadingview.ErrorDismissedMsg:
 		// Navigate to contexts view from loading error screen
 		cmd := m.replaceView(contextsview.ViewName, nil)
@@ -191,9 +270,15 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m *Model) delegateToCurrentView(msg tea.Msg) tea.Cmd {
 	cmd := m.currentView.Update(msg)
 
+	// The linked pane loads and streams through the same messages
+	var paneCmd tea.Cmd
+	if m.split.pane != nil {
+		paneCmd = m.split.pane.Update(msg)
+	}
+
 	vpCmd := m.updateViewports(msg)
 
-	return tea.Batch(cmd, vpCmd)
+	return tea.Batch(cmd, paneCmd, vpCmd)
 }
 
 func (m *Model) updateForResize(msg tea.WindowSizeMsg) tea.Cmd {
@@ -203,9 +288,9 @@ func (m *Model) updateForResize(msg tea.WindowSizeMsg) tea.Cmd {
 	m.terminalWidth = msg.Width
 	m.terminalHeight = msg.Height
 
-	// Check if current view is in fullscreen mode
+	// Check if the focused view is in fullscreen mode
 	isFullscreen := false
-	if logsView, ok := m.currentView.(interface{ GetFullscreen() bool }); ok {
+	if logsView, ok := m.focusedView().(interface{ GetFullscreen() bool }); ok {
 		isFullscreen = logsView.GetFullscreen()
 	}
 
@@ -239,7 +324,10 @@ func (m *Model) updateForResize(msg tea.WindowSizeMsg) tea.Cmd {
 	// keep header fixed) and reserve 3 lines by reducing usableHeight above.
 	m.viewport.YPosition = systeminfoview.Height
 
-	cmd = handleViewResize(m.currentView, usableWidth, usableHeight, isFullscreen)
+	if isFullscreen {
+		return handleViewResize(m.focusedView(), usableWidth, usableHeight, isFullscreen)
+	}
+	cmd = m.resizeMain(usableWidth, usableHeight)
 	return cmd
 }
 
@@ -269,6 +357,15 @@ func (m *Model) updateViewports(msg tea.Msg) tea.Cmd {
 }
 
 func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// The breadcrumb picker takes all keys while open
+	if m.historyPicker.Visible {
+		return m, m.historyPicker.Update(msg)
+	}
+	// Keys go to the linked pane while it has focus
+	if m.split.pane != nil && m.split.paneFocused {
+		return m.handlePaneKey(msg)
+	}
+
 	// If current view has an active dialog, forward keys to it first
 	if viewWithDialog, ok := m.currentView.(interface{ HasActiveDialog() bool }); ok {
 		if viewWithDialog.HasActiveDialog() {
@@ -296,6 +393,15 @@ func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				return m, cmd
 			}
 		}
+		// Let list views clear their marked rows before navigating back
+		if markView, ok := m.currentView.(interface {
+			HasMarkedItems() bool
+		}); ok {
+			if markView.HasMarkedItems() {
+				cmd := m.currentView.Update(msg)
+				return m, cmd
+			}
+		}
 		// Check if logs view has dialog open
 		if logsView, ok := m.currentView.(interface {
 			GetNodeSelectVisible() bool
@@ -306,6 +412,15 @@ func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				return m, cmd
 			}
 		}
+		// Let the logs view end its line selection
+		if logsView, ok := m.currentView.(interface {
+			HasLineSelection() bool
+		}); ok {
+			if logsView.HasLineSelection() {
+				cmd := m.currentView.Update(msg)
+				return m, cmd
+			}
+		}
 		// Check if logs view is in fullscreen or search mode
 		if logsView, ok := m.currentView.(interface {
 			GetFullscreen() bool
@@ -370,6 +485,45 @@ func (m *Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, cmd
 	}
 
+	// Export the current list (CSV by default; :export picks the format)
+	if msg.String() == "ctrl+e" {
+		if _, ok := m.focusedView().(export.Exporter); ok {
+			return m, m.exportCurrentView(export.RequestMsg{Format: export.CSV})
+		}
+	}
+
+	// Tab keys: alt+1..9 jump, ctrl+pgup/pgdown cycle
+	if !m.viewTakesInput() {
+		if cmd, ok := m.handleTabKey(msg); ok {
+			return m, cmd
+		}
+	}
+
+	// Split keys: | and \ split, tab switches focus, < > = resize
+	if !m.viewTakesInput() {
+		if cmd, ok := m.handleSplitKey(msg); ok {
+			return m, cmd
+		}
+	}
+
+	// Browser-style history: [ back, ] forward, ctrl+b picks a breadcrumb
+	if !m.viewTakesInput() {
+		switch msg.String() {
+		case "[", "alt+left":
+			return m, m.navigateHistory(-1)
+		case "]", "alt+right":
+			return m, m.navigateHistory(1)
+		case "ctrl+b":
+			m.openHistoryPicker()
+			return m, nil
+		}
+	}
+
+	// Copy the selection: y copies names (or the view's main content), Y IDs
+	if k := msg.String(); (k == "y" || k == "Y") && m.canCopy() {
+		return m, m.copyFromCurrentView(clipboard.RequestMsg{Alt: k == "Y"})
+	}
+
 	cmd := m.currentView.Update(msg)
 	return m, cmd
 }
@@ -381,19 +535,6 @@ func (m *Model) goBack() tea.Cmd {
 		return tea.Batch(exitCmd, tea.Quit)
 	}
 
-	// The view being left
-	oldView := m.currentView
-	exitCmd := oldView.OnExit()
-
-	// Pop the previous view
-	m.currentView = m.viewStack.Pop()
-
-	// The view you are returning to
-	enterCmd := m.currentView.OnEnter()
-
-	// Optionally notify the view about terminal size again
-	resizeCmd := handleViewResize(m.currentView, m.viewport.Width, m.viewport.Height, false)
-
-	// Execute all lifecycle commands
-	return tea.Batch(exitCmd, enterCmd, resizeCmd)
+	// The view being left stays in the forward history
+	return m.navigateHistory(-1)
 }
@@ -4,6 +4,8 @@
 package app
 
 import (
+	"swarmcli/core/clipboard"
+	"swarmcli/core/export"
 	"swarmcli/ui"
 	"swarmcli/views/helpbar"
 	systeminfoview "swarmcli/views/systeminfo"
@@ -13,10 +15,11 @@ import (
 )
 
 func (m *Model) View() string {
-	// Check if current view has fullscreen mode enabled
-	if logsView, ok := m.currentView.(interface{ GetFullscreen() bool }); ok && logsView.GetFullscreen() {
-		// Fullscreen mode: show only the current view (no helpbar, no stackbar)
-		return m.currentView.View()
+	// Check if the focused view has fullscreen mode enabled
+	focused := m.focusedView()
+	if logsView, ok := focused.(interface{ GetFullscreen() bool }); ok && logsView.GetFullscreen() {
+		// Fullscreen mode: show only that view (no helpbar, no stackbar)
+		return focused.View()
 	}
 
 	systemInfo := m.systemInfo.View()
@@ -26,12 +29,37 @@ func (m *Model) View() string {
 	if m.currentView.Name() == view.NameHelp {
 		globalHelp = []helpbar.HelpEntry{}
 	}
+	if _, ok := focused.(export.Exporter); ok {
+		globalHelp = append(globalHelp, helpbar.HelpEntry{Key: "ctrl+e", Desc: "Export"})
+	}
+	if m.viewStack.Len() > 0 || m.viewStack.ForwardLen() > 0 {
+		globalHelp = append(globalHelp, helpbar.HelpEntry{Key: "[/]", Desc: "Back/forward"})
+	}
+	if _, ok := focused.(clipboard.Copier); ok {
+		globalHelp = append(globalHelp, helpbar.HelpEntry{Key: "y/Y", Desc: "Copy"})
+	}
+	if len(m.tabs) > 1 {
+		globalHelp = append(globalHelp, helpbar.HelpEntry{Key: "alt+1-9", Desc: "Tabs"})
+	}
+	if m.split.pane != nil {
+		globalHelp = append(globalHelp, helpbar.HelpEntry{Key: "tab", Desc: "Focus pane"})
+	} else if _, ok := paneLinks[m.currentView.Name()]; ok {
+		globalHelp = append(globalHelp, helpbar.HelpEntry{Key: "|", Desc: "Split"})
+	}
 
 	help := helpbar.New(m.viewport.Width, systeminfoview.Height).
 		WithGlobalHelp(globalHelp).
 		WithViewHelp(m.currentView.ShortHelpItems()).
 		View(systemInfo)
 
+	main := m.currentView.View()
+	if m.split.pane != nil {
+		main = m.renderSplit()
+	}
+	if m.historyPicker.Visible {
+		main = ui.OverlayCentered(main, m.historyPicker.View(), m.viewport.Width+4, lipgloss.Height(main))
+	}
+
 	if m.commandInput.Visible() {
 		// Render a framed 3-line command box between the header and main view.
 		// Use the viewport width (which is usable width) and add 4 to match
@@ -48,7 +76,7 @@ func (m *Model) View() string {
 			lipgloss.Left,
 			help,
 			cmdFrame,
-			m.currentView.View(),
+			main,
 			m.renderStackBar(),
 		)
 	}
@@ -56,7 +84,7 @@ func (m *Model) View() string {
 	return lipgloss.JoinVertical(
 		lipgloss.Left,
 		help,
-		m.currentView.View(),
+		main,
 		m.renderStackBar(),
 	)
 }
@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package command
+
+import (
+	"swarmcli/args"
+	"swarmcli/registry"
+	clustersview "swarmcli/views/clusters"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Clusters struct{}
+
+func (Clusters) Name() string { return "clusters" }
+func (Clusters) Description() string {
+	return "clusters [context...]: Health overview across several swarms"
+}
+
+func (Clusters) Execute(ctx any, args args.Args) tea.Cmd {
+	names := append([]string(nil), args.Positionals...)
+	return func() tea.Msg {
+		return view.NavigateToMsg{
+			ViewName: clustersview.ViewName,
+			Payload:  names,
+		}
+	}
+}
+
+func init() {
+	registry.Register(Clusters{})
+}
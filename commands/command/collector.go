@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package command
+
+import (
+	"swarmcli/args"
+	"swarmcli/docker"
+	"swarmcli/registry"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Collector struct{}
+
+func (Collector) Name() string { return "collector" }
+func (Collector) Description() string {
+	return "collector [start|stop|status]: Cluster-wide container stats service"
+}
+
+func (Collector) Execute(ctx any, args args.Args) tea.Cmd {
+	action := "status"
+	if len(args.Positionals) > 0 {
+		action = args.Positionals[0]
+	}
+	return func() tea.Msg {
+		return docker.StatsCollectorAction(action)
+	}
+}
+
+func init() {
+	registry.Register(Collector{})
+}
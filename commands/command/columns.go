@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package command
+
+import (
+	"swarmcli/args"
+	"swarmcli/registry"
+	"swarmcli/views/columnpicker"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Columns struct{}
+
+func (Columns) Name() string { return "columns" }
+func (Columns) Description() string {
+	return "Show, hide, reorder and pin the columns of the current view"
+}
+
+func (Columns) Execute(ctx any, args args.Args) tea.Cmd {
+	return func() tea.Msg {
+		return columnpicker.OpenMsg{}
+	}
+}
+
+var columnsCmd = Columns{}
+
+func init() {
+	registry.Register(columnsCmd)
+	registry.Register(aliasCommand{name: "cols", target: columnsCmd})
+}
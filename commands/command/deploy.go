@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package command
+
+import (
+	"swarmcli/args"
+	"swarmcli/docker"
+	"swarmcli/registry"
+	deployview "swarmcli/views/deploy"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Deploy struct{}
+
+func (Deploy) Name() string { return "deploy" }
+func (Deploy) Description() string {
+	return "deploy <compose.yml> [stack] [--prune] [--with-registry-auth]: Deploy a compose file as a stack"
+}
+
+func (Deploy) Execute(ctx any, args args.Args) tea.Cmd {
+	req := deployview.Request{
+		Options: docker.StackDeployOptions{
+			Prune:            args.Has("prune"),
+			WithRegistryAuth: args.Has("with-registry-auth"),
+		},
+	}
+	if len(args.Positionals) > 0 {
+		req.File = args.Positionals[0]
+	}
+	if len(args.Positionals) > 1 {
+		req.Stack = args.Positionals[1]
+	}
+	return func() tea.Msg {
+		return view.NavigateToMsg{
+			ViewName: deployview.ViewName,
+			Payload:  req,
+		}
+	}
+}
+
+func init() {
+	registry.Register(Deploy{})
+}
@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package command
+
+import (
+	"swarmcli/args"
+	"swarmcli/registry"
+	diffview "swarmcli/views/diff"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Diff struct{}
+
+func (Diff) Name() string { return "diff" }
+func (Diff) Description() string {
+	return "diff <compose.yml> [stack]: Compare a compose file with the deployed stack"
+}
+
+func (Diff) Execute(ctx any, args args.Args) tea.Cmd {
+	var req diffview.Request
+	if len(args.Positionals) > 0 {
+		req.File = args.Positionals[0]
+	}
+	if len(args.Positionals) > 1 {
+		req.Stack = args.Positionals[1]
+	}
+	return func() tea.Msg {
+		return view.NavigateToMsg{
+			ViewName: diffview.ViewName,
+			Payload:  req,
+		}
+	}
+}
+
+func init() {
+	registry.Register(Diff{})
+}
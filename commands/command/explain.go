@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package command
+
+import (
+	"swarmcli/args"
+	"swarmcli/registry"
+	explainview "swarmcli/views/explain"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Explain struct{}
+
+func (Explain) Name() string { return "explain" }
+func (Explain) Description() string {
+	return "explain <service>: Show why each node accepts or rejects the service's tasks"
+}
+
+func (Explain) Execute(ctx any, args args.Args) tea.Cmd {
+	var target explainview.Target
+	if len(args.Positionals) > 0 {
+		target.Service = args.Positionals[0]
+	}
+	return func() tea.Msg {
+		return view.NavigateToMsg{
+			ViewName: explainview.ViewName,
+			Payload:  target,
+		}
+	}
+}
+
+func init() {
+	registry.Register(Explain{})
+}
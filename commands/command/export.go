@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package command
+
+import (
+	"swarmcli/args"
+	"swarmcli/core/export"
+	"swarmcli/registry"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Export struct{}
+
+func (Export) Name() string { return "export" }
+func (Export) Description() string {
+	return "export csv|json|md [path]: Write the current list to a file"
+}
+
+func (Export) Execute(ctx any, args args.Args) tea.Cmd {
+	return func() tea.Msg {
+		format := export.CSV
+		if len(args.Positionals) > 0 {
+			f, err := export.ParseFormat(args.Positionals[0])
+			if err != nil {
+				return export.DoneMsg{Err: err}
+			}
+			format = f
+		}
+		var path string
+		if len(args.Positionals) > 1 {
+			path = args.Positionals[1]
+		}
+		return export.RequestMsg{Format: format, Path: path}
+	}
+}
+
+func init() {
+	registry.Register(Export{})
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package command
+
+import (
+	"swarmcli/args"
+	"swarmcli/registry"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Graph struct{}
+
+func (Graph) Name() string { return "graph" }
+func (Graph) Description() string {
+	return "graph <stack>: Draw what the services of a stack use and share"
+}
+
+func (Graph) Execute(ctx any, args args.Args) tea.Cmd {
+	stack := ""
+	if len(args.Positionals) > 0 {
+		stack = args.Positionals[0]
+	}
+	return func() tea.Msg {
+		return view.NavigateToMsg{
+			ViewName: view.NameGraph,
+			Payload:  stack,
+		}
+	}
+}
+
+func init() {
+	registry.Register(Graph{})
+}
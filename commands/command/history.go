@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package command
+
+import (
+	"swarmcli/args"
+	"swarmcli/registry"
+	"swarmcli/views/historypicker"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type History struct{}
+
+func (History) Name() string { return "history" }
+func (History) Description() string {
+	return "Jump to a view from the navigation history"
+}
+
+func (History) Execute(ctx any, args args.Args) tea.Cmd {
+	return func() tea.Msg {
+		return historypicker.OpenMsg{}
+	}
+}
+
+var historyCmd = History{}
+
+func init() {
+	registry.Register(historyCmd)
+	registry.Register(aliasCommand{name: "hist", target: historyCmd})
+}
@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package command
+
+import (
+	"swarmcli/args"
+	"swarmcli/registry"
+	pulseview "swarmcli/views/pulse"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Pulse struct{}
+
+func (Pulse) Name() string { return "pulse" }
+func (Pulse) Description() string {
+	return "Dashboard of cluster metrics over the session"
+}
+
+func (Pulse) Execute(ctx any, args args.Args) tea.Cmd {
+	return func() tea.Msg {
+		return view.NavigateToMsg{
+			ViewName: pulseview.ViewName,
+			Payload:  nil,
+		}
+	}
+}
+
+func init() {
+	registry.Register(Pulse{})
+}
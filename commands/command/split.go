@@ -0,0 +1,37 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package command
+
+import (
+	"strings"
+	"swarmcli/args"
+	"swarmcli/registry"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Split struct{}
+
+func (Split) Name() string { return "split" }
+func (Split) Description() string {
+	return "split [v|h|off]: Show a linked pane next to the current view"
+}
+
+func (Split) Execute(ctx any, args args.Args) tea.Cmd {
+	mode := ""
+	if len(args.Positionals) > 0 {
+		mode = strings.ToLower(args.Positionals[0])
+	}
+	return func() tea.Msg {
+		return view.SplitMsg{Mode: mode}
+	}
+}
+
+var splitCmd = Split{}
+
+func init() {
+	registry.Register(splitCmd)
+	registry.Register(aliasCommand{name: "sp", target: splitCmd})
+}
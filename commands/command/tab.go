@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package command
+
+import (
+	"swarmcli/args"
+	"swarmcli/registry"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Tab struct{}
+
+func (Tab) Name() string { return "tab" }
+func (Tab) Description() string {
+	return "tab [context]: Open a tab bound to a Docker context"
+}
+
+func (Tab) Execute(ctx any, args args.Args) tea.Cmd {
+	var context string
+	if len(args.Positionals) > 0 {
+		context = args.Positionals[0]
+	}
+	return func() tea.Msg {
+		return view.OpenTabMsg{Context: context}
+	}
+}
+
+type TabClose struct{}
+
+func (TabClose) Name() string { return "tabclose" }
+func (TabClose) Description() string {
+	return "Close the current tab"
+}
+
+func (TabClose) Execute(ctx any, args args.Args) tea.Cmd {
+	return func() tea.Msg {
+		return view.CloseTabMsg{}
+	}
+}
+
+var tabCloseCmd = TabClose{}
+
+func init() {
+	registry.Register(Tab{})
+	registry.Register(tabCloseCmd)
+	registry.Register(aliasCommand{name: "tabc", target: tabCloseCmd})
+}
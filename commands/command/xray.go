@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package command
+
+import (
+	"swarmcli/args"
+	"swarmcli/docker"
+	"swarmcli/registry"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Xray struct{}
+
+func (Xray) Name() string { return "xray" }
+func (Xray) Description() string {
+	return "xray [stack <name>|node <name>]: Tree of services, tasks and containers"
+}
+
+func (Xray) Execute(ctx any, args args.Args) tea.Cmd {
+	var scope docker.XrayScope
+	if len(args.Positionals) > 1 {
+		switch args.Positionals[0] {
+		case "stack":
+			scope.Stack = args.Positionals[1]
+		case "node":
+			scope.Node = args.Positionals[1]
+		}
+	} else if len(args.Positionals) == 1 {
+		// A lone name is a stack, like in the other stack commands
+		scope.Stack = args.Positionals[0]
+	}
+	return func() tea.Msg {
+		return view.NavigateToMsg{
+			ViewName: view.NameXray,
+			Payload:  scope,
+		}
+	}
+}
+
+func init() {
+	registry.Register(Xray{})
+}
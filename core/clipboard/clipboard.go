@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package clipboard copies text out of the TUI. By default it emits an OSC 52
+// escape sequence, which the terminal turns into a clipboard write and which
+// passes through SSH, tmux and screen. The local clipboard command (pbcopy,
+// xclip, xsel, wl-copy, ...) is used instead when the output is not a
+// terminal, or when SWARMCLI_CLIPBOARD asks for it.
+package clipboard
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	local "github.com/atotto/clipboard"
+	"github.com/aymanbagabas/go-osc52/v2"
+	"github.com/charmbracelet/x/term"
+)
+
+// Output is the terminal the TUI runs in. The program renders through it
+// (tea.WithOutput) and OSC 52 sequences are written to it, so a sequence
+// never lands in the middle of a frame.
+var Output = NewTerminal(os.Stdout)
+
+// Terminal serializes writes to a terminal. It embeds the file so Bubble Tea
+// still detects the TTY, reads the window size and switches to raw mode.
+type Terminal struct {
+	*os.File
+	mu sync.Mutex
+}
+
+// NewTerminal wraps f.
+func NewTerminal(f *os.File) *Terminal {
+	return &Terminal{File: f}
+}
+
+func (t *Terminal) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.File.Write(p)
+}
+
+func (t *Terminal) WriteString(s string) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.File.WriteString(s)
+}
+
+// Method is how text reaches the clipboard.
+type Method string
+
+const (
+	// MethodAuto uses OSC 52 when the output is a terminal and the local
+	// clipboard command otherwise.
+	MethodAuto  Method = "auto"
+	MethodOSC52 Method = "osc52"
+	MethodLocal Method = "local"
+)
+
+// Copier is implemented by views that can copy their selection. alt selects
+// the secondary value: the ID instead of the name in lists, everything
+// instead of the visible part in logs.
+type Copier interface {
+	CopyText(alt bool) (what, text string)
+}
+
+// RequestMsg asks the app to copy from the current view.
+type RequestMsg struct {
+	Alt bool
+}
+
+// DoneMsg reports the outcome of a copy.
+type DoneMsg struct {
+	What   string
+	Bytes  int
+	Method Method
+	Err    error
+}
+
+// Resolve returns the method to copy with: the one SWARMCLI_CLIPBOARD names
+// (auto, osc52 or local), with auto settled against the current terminal.
+func Resolve() (Method, error) {
+	switch Method(strings.ToLower(os.Getenv("SWARMCLI_CLIPBOARD"))) {
+	case MethodOSC52:
+		return MethodOSC52, nil
+	case MethodLocal:
+		if local.Unsupported {
+			return "", errors.New("no local clipboard command found")
+		}
+		return MethodLocal, nil
+	}
+	if term.IsTerminal(Output.Fd()) {
+		return MethodOSC52, nil
+	}
+	if !local.Unsupported && !isRemote() {
+		return MethodLocal, nil
+	}
+	return "", errors.New("no clipboard available")
+}
+
+// Sequence returns the OSC 52 sequence that puts text on the clipboard,
+// wrapped for tmux or screen when running inside one.
+func Sequence(text string) string {
+	seq := osc52.New(text)
+	switch {
+	case os.Getenv("TMUX") != "":
+		seq = seq.Tmux()
+	case os.Getenv("STY") != "":
+		seq = seq.Screen()
+	}
+	return seq.String()
+}
+
+// WriteOSC52 writes the OSC 52 sequence for text to Output. Call it from the
+// program's Update, not from a tea.Cmd, so it is ordered with the frames.
+func WriteOSC52(text string) error {
+	if _, err := io.WriteString(Output, Sequence(text)); err != nil {
+		return fmt.Errorf("osc52: %w", err)
+	}
+	return nil
+}
+
+// CopyLocal puts text on the clipboard with the local clipboard command. It
+// runs a process, so call it from a tea.Cmd.
+func CopyLocal(text string) error {
+	if err := local.WriteAll(text); err != nil {
+		return fmt.Errorf("local clipboard: %w", err)
+	}
+	return nil
+}
+
+// isRemote reports whether we run inside an SSH session, where the local
+// clipboard belongs to the server rather than the user.
+func isRemote() bool {
+	return os.Getenv("SSH_TTY") != "" || os.Getenv("SSH_CONNECTION") != ""
+}
+
+// Join returns the values of items, one per line.
+func Join[T any](items []T, value func(T) string) string {
+	lines := make([]string, 0, len(items))
+	for _, it := range items {
+		if v := value(it); v != "" {
+			lines = append(lines, v)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Selection returns the items a list copies: the marked ones when there are
+// any, otherwise the item under the cursor.
+func Selection[T any](marked, filtered []T, cursor int) []T {
+	if len(marked) > 0 {
+		return marked
+	}
+	if cursor < 0 || cursor >= len(filtered) {
+		return nil
+	}
+	return filtered[cursor : cursor+1]
+}
+
+// Describe names what was copied, e.g. "service name" or "3 service names".
+func Describe(n int, what string) string {
+	if n == 1 {
+		return what
+	}
+	return fmt.Sprintf("%d %ss", n, what)
+}
@@ -0,0 +1,616 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package compose reads compose files (version 3, the format of
+// `docker stack deploy`) into plain Go types. It knows the options a swarm
+// stack uses; the others are reported as ignored, like the docker CLI does.
+package compose
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+	"gopkg.in/yaml.v3"
+)
+
+// Project is a loaded compose file.
+type Project struct {
+	Name     string             `yaml:"name,omitempty"`
+	Version  string             `yaml:"version,omitempty"`
+	Services map[string]Service `yaml:"services,omitempty"`
+	Networks map[string]Network `yaml:"networks,omitempty"`
+	Volumes  map[string]Volume  `yaml:"volumes,omitempty"`
+	Configs  map[string]File    `yaml:"configs,omitempty"`
+	Secrets  map[string]File    `yaml:"secrets,omitempty"`
+
+	// Dir is the directory relative paths are resolved against.
+	Dir string `yaml:"-"`
+	// Env is the environment the file was interpolated with; it also
+	// fills environment entries without a value.
+	Env map[string]string `yaml:"-"`
+	// Warnings lists what was ignored while loading.
+	Warnings []string `yaml:"-"`
+}
+
+// Service is a service of the compose file.
+type Service struct {
+	Image       string         `yaml:"image,omitempty"`
+	Command     ShellCommand   `yaml:"command,omitempty"`
+	Entrypoint  ShellCommand   `yaml:"entrypoint,omitempty"`
+	Environment Mapping        `yaml:"environment,omitempty"`
+	EnvFile     StringList     `yaml:"env_file,omitempty"`
+	Labels      Mapping        `yaml:"labels,omitempty"`
+	Ports       []Port         `yaml:"ports,omitempty"`
+	Networks    ServiceNetwork `yaml:"networks,omitempty"`
+	Configs     []FileRef      `yaml:"configs,omitempty"`
+	Secrets     []FileRef      `yaml:"secrets,omitempty"`
+	Volumes     []Mount        `yaml:"volumes,omitempty"`
+	Deploy      Deploy         `yaml:"deploy,omitempty"`
+	Healthcheck *Healthcheck   `yaml:"healthcheck,omitempty"`
+	Logging     *Logging       `yaml:"logging,omitempty"`
+
+	Hostname        string     `yaml:"hostname,omitempty"`
+	User            string     `yaml:"user,omitempty"`
+	WorkingDir      string     `yaml:"working_dir,omitempty"`
+	StopGracePeriod *Duration  `yaml:"stop_grace_period,omitempty"`
+	StopSignal      string     `yaml:"stop_signal,omitempty"`
+	Tty             bool       `yaml:"tty,omitempty"`
+	StdinOpen       bool       `yaml:"stdin_open,omitempty"`
+	ReadOnly        bool       `yaml:"read_only,omitempty"`
+	Init            *bool      `yaml:"init,omitempty"`
+	DNS             StringList `yaml:"dns,omitempty"`
+	DNSSearch       StringList `yaml:"dns_search,omitempty"`
+	ExtraHosts      HostList   `yaml:"extra_hosts,omitempty"`
+	CapAdd          []string   `yaml:"cap_add,omitempty"`
+	CapDrop         []string   `yaml:"cap_drop,omitempty"`
+}
+
+// Deploy is the swarm part of a service.
+type Deploy struct {
+	// Mode is replicated (the default), global, replicated-job or
+	// global-job.
+	Mode           string         `yaml:"mode,omitempty"`
+	Replicas       *uint64        `yaml:"replicas,omitempty"`
+	Labels         Mapping        `yaml:"labels,omitempty"`
+	Resources      Resources      `yaml:"resources,omitempty"`
+	RestartPolicy  *RestartPolicy `yaml:"restart_policy,omitempty"`
+	Placement      Placement      `yaml:"placement,omitempty"`
+	UpdateConfig   *UpdateConfig  `yaml:"update_config,omitempty"`
+	RollbackConfig *UpdateConfig  `yaml:"rollback_config,omitempty"`
+	// EndpointMode is vip (the default) or dnsrr.
+	EndpointMode string `yaml:"endpoint_mode,omitempty"`
+}
+
+// Resources are the limits and reservations of a service.
+type Resources struct {
+	Limits       *Resource `yaml:"limits,omitempty"`
+	Reservations *Resource `yaml:"reservations,omitempty"`
+}
+
+// Resource is an amount of CPUs and memory.
+type Resource struct {
+	CPUs   CPUs  `yaml:"cpus,omitempty"`
+	Memory Bytes `yaml:"memory,omitempty"`
+	Pids   int64 `yaml:"pids,omitempty"`
+}
+
+type RestartPolicy struct {
+	Condition   string    `yaml:"condition,omitempty"`
+	Delay       *Duration `yaml:"delay,omitempty"`
+	MaxAttempts *uint64   `yaml:"max_attempts,omitempty"`
+	Window      *Duration `yaml:"window,omitempty"`
+}
+
+type Placement struct {
+	Constraints []string `yaml:"constraints,omitempty"`
+	Preferences []struct {
+		Spread string `yaml:"spread,omitempty"`
+	} `yaml:"preferences,omitempty"`
+	MaxReplicas uint64 `yaml:"max_replicas_per_node,omitempty"`
+}
+
+type UpdateConfig struct {
+	Parallelism     *uint64  `yaml:"parallelism,omitempty"`
+	Delay           Duration `yaml:"delay,omitempty"`
+	FailureAction   string   `yaml:"failure_action,omitempty"`
+	Monitor         Duration `yaml:"monitor,omitempty"`
+	MaxFailureRatio float32  `yaml:"max_failure_ratio,omitempty"`
+	Order           string   `yaml:"order,omitempty"`
+}
+
+type Healthcheck struct {
+	// Test is a command as a list ([CMD, …], [CMD-SHELL, …] or [NONE]),
+	// or a string run by the shell.
+	Test        StringList `yaml:"test,omitempty"`
+	Interval    *Duration  `yaml:"interval,omitempty"`
+	Timeout     *Duration  `yaml:"timeout,omitempty"`
+	StartPeriod *Duration  `yaml:"start_period,omitempty"`
+	Retries     *uint64    `yaml:"retries,omitempty"`
+	Disable     bool       `yaml:"disable,omitempty"`
+}
+
+type Logging struct {
+	Driver  string            `yaml:"driver,omitempty"`
+	Options map[string]string `yaml:"options,omitempty"`
+}
+
+// Network is a network of the compose file.
+type Network struct {
+	Name       string            `yaml:"name,omitempty"`
+	Driver     string            `yaml:"driver,omitempty"`
+	DriverOpts map[string]string `yaml:"driver_opts,omitempty"`
+	External   External          `yaml:"external,omitempty"`
+	Attachable bool              `yaml:"attachable,omitempty"`
+	Internal   bool              `yaml:"internal,omitempty"`
+	Labels     Mapping           `yaml:"labels,omitempty"`
+	IPAM       struct {
+		Driver string `yaml:"driver,omitempty"`
+		Config []struct {
+			Subnet string `yaml:"subnet,omitempty"`
+		} `yaml:"config,omitempty"`
+	} `yaml:"ipam,omitempty"`
+}
+
+// Volume is a named volume of the compose file.
+type Volume struct {
+	Name       string            `yaml:"name,omitempty"`
+	Driver     string            `yaml:"driver,omitempty"`
+	DriverOpts map[string]string `yaml:"driver_opts,omitempty"`
+	External   External          `yaml:"external,omitempty"`
+	Labels     Mapping           `yaml:"labels,omitempty"`
+}
+
+// File is a config or secret of the compose file.
+type File struct {
+	Name     string   `yaml:"name,omitempty"`
+	File     string   `yaml:"file,omitempty"`
+	External External `yaml:"external,omitempty"`
+	Labels   Mapping  `yaml:"labels,omitempty"`
+}
+
+// External marks an object created outside the stack; the legacy form
+// `external: {name: x}` names it.
+type External struct {
+	External bool
+	Name     string
+}
+
+func (e *External) UnmarshalYAML(n *yaml.Node) error {
+	if n.Kind == yaml.MappingNode {
+		var v struct {
+			Name string `yaml:"name,omitempty"`
+		}
+		if err := n.Decode(&v); err != nil {
+			return err
+		}
+		e.External, e.Name = true, v.Name
+		return nil
+	}
+	return n.Decode(&e.External)
+}
+
+// MarshalYAML writes `external: true`; the name goes to the name field.
+func (e External) MarshalYAML() (any, error) {
+	return e.External, nil
+}
+
+// StringList is a string or a list of strings.
+type StringList []string
+
+func (s *StringList) UnmarshalYAML(n *yaml.Node) error {
+	if n.Kind == yaml.ScalarNode {
+		*s = StringList{n.Value}
+		return nil
+	}
+	var list []string
+	if err := n.Decode(&list); err != nil {
+		return err
+	}
+	*s = list
+	return nil
+}
+
+// ShellCommand is a command given as a list, or as a string split like a
+// shell would.
+type ShellCommand []string
+
+func (c *ShellCommand) UnmarshalYAML(n *yaml.Node) error {
+	if n.Kind == yaml.ScalarNode {
+		words, err := splitWords(n.Value)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", n.Line, err)
+		}
+		*c = words
+		return nil
+	}
+	var list []string
+	if err := n.Decode(&list); err != nil {
+		return err
+	}
+	*c = list
+	return nil
+}
+
+// Mapping is a KEY: value map, or a list of KEY=value. A key without a
+// value (nil) is taken from the environment, when set there.
+type Mapping map[string]*string
+
+func (m *Mapping) UnmarshalYAML(n *yaml.Node) error {
+	out := Mapping{}
+	switch n.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k, v := n.Content[i], n.Content[i+1]
+			if v.ShortTag() == "!!null" {
+				out[k.Value] = nil
+				continue
+			}
+			if v.Kind != yaml.ScalarNode {
+				return fmt.Errorf("line %d: %s must be a string", v.Line, k.Value)
+			}
+			value := v.Value
+			out[k.Value] = &value
+		}
+	case yaml.SequenceNode:
+		for _, item := range n.Content {
+			k, v, ok := strings.Cut(item.Value, "=")
+			if !ok {
+				out[k] = nil
+				continue
+			}
+			out[k] = &v
+		}
+	default:
+		return fmt.Errorf("line %d: expected a mapping or a list", n.Line)
+	}
+	*m = out
+	return nil
+}
+
+// Values returns the mapping with every value set; lookup resolves the
+// keys without one, and drops them when it returns false.
+func (m Mapping) Values(lookup func(string) (string, bool)) map[string]string {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		switch {
+		case v != nil:
+			out[k] = *v
+		case lookup != nil:
+			if value, ok := lookup(k); ok {
+				out[k] = value
+			}
+		}
+	}
+	return out
+}
+
+// HostList is extra_hosts: a list of "host:ip" or a host: ip mapping.
+type HostList []string
+
+func (h *HostList) UnmarshalYAML(n *yaml.Node) error {
+	if n.Kind == yaml.MappingNode {
+		var m Mapping
+		if err := n.Decode(&m); err != nil {
+			return err
+		}
+		for host, ip := range m.Values(nil) {
+			*h = append(*h, host+":"+ip)
+		}
+		return nil
+	}
+	var list []string
+	if err := n.Decode(&list); err != nil {
+		return err
+	}
+	*h = list
+	return nil
+}
+
+// ServiceNetwork lists the networks of a service, with their aliases.
+type ServiceNetwork map[string][]string
+
+func (s *ServiceNetwork) UnmarshalYAML(n *yaml.Node) error {
+	out := ServiceNetwork{}
+	if n.Kind == yaml.SequenceNode {
+		var names []string
+		if err := n.Decode(&names); err != nil {
+			return err
+		}
+		for _, name := range names {
+			out[name] = nil
+		}
+		*s = out
+		return nil
+	}
+	var m map[string]*struct {
+		Aliases []string `yaml:"aliases,omitempty"`
+	}
+	if err := n.Decode(&m); err != nil {
+		return err
+	}
+	for name, v := range m {
+		if v != nil {
+			out[name] = v.Aliases
+		} else {
+			out[name] = nil
+		}
+	}
+	*s = out
+	return nil
+}
+
+// MarshalYAML writes a list of names, or a mapping when a network has
+// aliases.
+func (s ServiceNetwork) MarshalYAML() (any, error) {
+	aliased := false
+	for _, aliases := range s {
+		aliased = aliased || len(aliases) > 0
+	}
+	names := slices.Sorted(maps.Keys(s))
+	if !aliased {
+		return names, nil
+	}
+	type network struct {
+		Aliases []string `yaml:"aliases,omitempty"`
+	}
+	out := make(map[string]network, len(s))
+	for _, name := range names {
+		out[name] = network{Aliases: s[name]}
+	}
+	return out, nil
+}
+
+// Port is a published port: "8080:80/udp" or the long syntax.
+type Port struct {
+	Target    uint32 `yaml:"target,omitempty"`
+	Published uint32 `yaml:"published,omitempty"`
+	Protocol  string `yaml:"protocol,omitempty"`
+	// Mode is ingress (the default) or host.
+	Mode string `yaml:"mode,omitempty"`
+}
+
+func (p *Port) UnmarshalYAML(n *yaml.Node) error {
+	if n.Kind == yaml.MappingNode {
+		type plain Port
+		return n.Decode((*plain)(p))
+	}
+	spec, proto, _ := strings.Cut(n.Value, "/")
+	p.Protocol = proto
+	parts := strings.Split(spec, ":")
+	if len(parts) > 3 {
+		return fmt.Errorf("line %d: invalid port %q", n.Line, n.Value)
+	}
+	// An IP prefix (ip:published:target) does not apply to swarm
+	target := parts[len(parts)-1]
+	if strings.Contains(target, "-") {
+		return fmt.Errorf("line %d: port ranges are not supported (%q)", n.Line, n.Value)
+	}
+	t, err := strconv.ParseUint(target, 10, 16)
+	if err != nil {
+		return fmt.Errorf("line %d: invalid port %q", n.Line, n.Value)
+	}
+	p.Target = uint32(t)
+	if len(parts) > 1 && parts[len(parts)-2] != "" {
+		pub, err := strconv.ParseUint(parts[len(parts)-2], 10, 16)
+		if err != nil {
+			return fmt.Errorf("line %d: invalid port %q", n.Line, n.Value)
+		}
+		p.Published = uint32(pub)
+	}
+	return nil
+}
+
+// MarshalYAML uses the short syntax unless the port is published in host
+// mode.
+func (p Port) MarshalYAML() (any, error) {
+	if p.Mode != "" && p.Mode != "ingress" {
+		type plain Port
+		return plain(p), nil
+	}
+	s := strconv.FormatUint(uint64(p.Target), 10)
+	if p.Published != 0 {
+		s = strconv.FormatUint(uint64(p.Published), 10) + ":" + s
+	}
+	if p.Protocol != "" && p.Protocol != "tcp" {
+		s += "/" + p.Protocol
+	}
+	return s, nil
+}
+
+// FileRef is a config or secret used by a service: its name, or the long
+// syntax.
+type FileRef struct {
+	Source string  `yaml:"source,omitempty"`
+	Target string  `yaml:"target,omitempty"`
+	UID    string  `yaml:"uid,omitempty"`
+	GID    string  `yaml:"gid,omitempty"`
+	Mode   *uint32 `yaml:"mode,omitempty"`
+}
+
+func (f *FileRef) UnmarshalYAML(n *yaml.Node) error {
+	if n.Kind == yaml.ScalarNode {
+		f.Source = n.Value
+		return nil
+	}
+	type plain FileRef
+	return n.Decode((*plain)(f))
+}
+
+// MarshalYAML writes the name alone when nothing else is set.
+func (f FileRef) MarshalYAML() (any, error) {
+	if f == (FileRef{Source: f.Source}) {
+		return f.Source, nil
+	}
+	type plain FileRef
+	return plain(f), nil
+}
+
+// Mount is a volume of a service: "source:target:ro" or the long syntax.
+type Mount struct {
+	// Type is volume, bind or tmpfs.
+	Type     string `yaml:"type,omitempty"`
+	Source   string `yaml:"source,omitempty"`
+	Target   string `yaml:"target,omitempty"`
+	ReadOnly bool   `yaml:"read_only,omitempty"`
+}
+
+func (m *Mount) UnmarshalYAML(n *yaml.Node) error {
+	if n.Kind == yaml.MappingNode {
+		type plain Mount
+		return n.Decode((*plain)(m))
+	}
+	parts := strings.Split(n.Value, ":")
+	switch len(parts) {
+	case 1:
+		m.Target = parts[0]
+	case 2, 3:
+		m.Source, m.Target = parts[0], parts[1]
+		if len(parts) == 3 {
+			for _, opt := range strings.Split(parts[2], ",") {
+				switch opt {
+				case "ro":
+					m.ReadOnly = true
+				case "rw", "z", "Z", "nocopy":
+				default:
+					return fmt.Errorf("line %d: unsupported volume option %q", n.Line, opt)
+				}
+			}
+		}
+	default:
+		return fmt.Errorf("line %d: invalid volume %q", n.Line, n.Value)
+	}
+	switch {
+	case m.Source == "":
+		m.Type = "volume"
+	case strings.HasPrefix(m.Source, "/"), strings.HasPrefix(m.Source, "."), strings.HasPrefix(m.Source, "~"):
+		m.Type = "bind"
+	default:
+		m.Type = "volume"
+	}
+	return nil
+}
+
+// MarshalYAML uses the short syntax for volumes and binds.
+func (m Mount) MarshalYAML() (any, error) {
+	if m.Type == "tmpfs" {
+		type plain Mount
+		return plain(m), nil
+	}
+	s := m.Target
+	if m.Source != "" {
+		s = m.Source + ":" + s
+	}
+	if m.ReadOnly {
+		s += ":ro"
+	}
+	return s, nil
+}
+
+// Duration is a duration such as "1m30s".
+type Duration time.Duration
+
+func (d *Duration) UnmarshalYAML(n *yaml.Node) error {
+	v, err := time.ParseDuration(n.Value)
+	if err != nil {
+		return fmt.Errorf("line %d: invalid duration %q", n.Line, n.Value)
+	}
+	*d = Duration(v)
+	return nil
+}
+
+func (d Duration) MarshalYAML() (any, error) {
+	return time.Duration(d).String(), nil
+}
+
+// CPUs is a number of CPUs, in nano CPUs.
+type CPUs int64
+
+func (c *CPUs) UnmarshalYAML(n *yaml.Node) error {
+	f, err := strconv.ParseFloat(n.Value, 64)
+	if err != nil || f < 0 {
+		return fmt.Errorf("line %d: invalid cpus %q", n.Line, n.Value)
+	}
+	*c = CPUs(f * 1e9)
+	return nil
+}
+
+func (c CPUs) MarshalYAML() (any, error) {
+	return strconv.FormatFloat(float64(c)/1e9, 'f', -1, 64), nil
+}
+
+// Bytes is an amount of memory: a number of bytes or "512M".
+type Bytes int64
+
+func (b *Bytes) UnmarshalYAML(n *yaml.Node) error {
+	v, err := units.RAMInBytes(n.Value)
+	if err != nil {
+		return fmt.Errorf("line %d: invalid memory %q", n.Line, n.Value)
+	}
+	*b = Bytes(v)
+	return nil
+}
+
+// MarshalYAML writes the largest unit the amount is a whole number of.
+func (b Bytes) MarshalYAML() (any, error) {
+	v := int64(b)
+	for _, unit := range []struct {
+		suffix string
+		size   int64
+	}{{"G", units.GiB}, {"M", units.MiB}, {"K", units.KiB}} {
+		if v != 0 && v%unit.size == 0 {
+			return strconv.FormatInt(v/unit.size, 10) + unit.suffix, nil
+		}
+	}
+	return v, nil
+}
+
+// splitWords splits a command like a POSIX shell: on blanks, honouring
+// single and double quotes and backslash escapes.
+func splitWords(s string) ([]string, error) {
+	var words []string
+	var cur strings.Builder
+	inWord := false
+	var quote rune
+	escaped := false
+	for _, r := range s {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped, inWord = true, true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord = r, true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inWord {
+				words = append(words, cur.String())
+				cur.Reset()
+				inWord = false
+			}
+		default:
+			cur.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote in %q", s)
+	}
+	if inWord {
+		words = append(words, cur.String())
+	}
+	return words, nil
+}
@@ -0,0 +1,326 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package compose
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// supportedServiceKeys are the service options a stack deploy uses.
+var supportedServiceKeys = []string{
+	"image", "command", "entrypoint", "environment", "env_file", "labels",
+	"ports", "networks", "configs", "secrets", "volumes", "deploy",
+	"healthcheck", "logging", "hostname", "user", "working_dir",
+	"stop_grace_period", "stop_signal", "tty", "stdin_open", "read_only",
+	"init", "dns", "dns_search", "extra_hosts", "cap_add", "cap_drop",
+}
+
+// Load reads a compose file, interpolating ${VAR} references from the
+// environment and the .env file next to it.
+func Load(path string) (*Project, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Dir(abs)
+
+	env, err := readEnvFile(filepath.Join(dir, ".env"))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	for _, kv := range os.Environ() {
+		k, v, _ := strings.Cut(kv, "=")
+		env[k] = v
+	}
+	return Parse(data, dir, env)
+}
+
+// Parse reads a compose document; relative paths resolve against dir and
+// ${VAR} references against env.
+func Parse(data []byte, dir string, env map[string]string) (*Project, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("empty compose file")
+	}
+	root := doc.Content[0]
+	if err := interpolateNode(root, env); err != nil {
+		return nil, err
+	}
+
+	var p Project
+	if err := root.Decode(&p); err != nil {
+		return nil, err
+	}
+	if len(p.Services) == 0 {
+		return nil, fmt.Errorf("no services in compose file")
+	}
+	p.Dir = dir
+	p.Env = env
+	p.Warnings = ignoredOptions(root)
+	return &p, nil
+}
+
+// ignoredOptions lists the service options a stack has no use for, as
+// `docker stack deploy` prints them.
+func ignoredOptions(root *yaml.Node) []string {
+	services := mappingValue(root, "services")
+	if services == nil || services.Kind != yaml.MappingNode {
+		return nil
+	}
+	var warnings []string
+	for i := 0; i+1 < len(services.Content); i += 2 {
+		name, svc := services.Content[i].Value, services.Content[i+1]
+		if svc.Kind != yaml.MappingNode {
+			continue
+		}
+		var ignored []string
+		for j := 0; j+1 < len(svc.Content); j += 2 {
+			key := svc.Content[j].Value
+			if key == "<<" || strings.HasPrefix(key, "x-") || slices.Contains(supportedServiceKeys, key) {
+				continue
+			}
+			ignored = append(ignored, key)
+		}
+		if len(ignored) > 0 {
+			slices.Sort(ignored)
+			warnings = append(warnings, fmt.Sprintf("%s: ignoring unsupported options: %s", name, strings.Join(ignored, ", ")))
+		}
+	}
+	return warnings
+}
+
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	if n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// interpolateNode substitutes variables in every value (not key) of the
+// document. Plain scalars get their type resolved again afterwards, so
+// `replicas: ${REPLICAS}` is a number.
+func interpolateNode(n *yaml.Node, env map[string]string) error {
+	switch n.Kind {
+	case yaml.ScalarNode:
+		v, err := Interpolate(n.Value, env)
+		if err != nil {
+			return fmt.Errorf("line %d: %w", n.Line, err)
+		}
+		if v != n.Value {
+			n.Value = v
+			if n.Style == 0 {
+				n.Tag = ""
+			}
+		}
+	case yaml.MappingNode:
+		for i := 1; i < len(n.Content); i += 2 {
+			if err := interpolateNode(n.Content[i], env); err != nil {
+				return err
+			}
+		}
+	case yaml.SequenceNode, yaml.DocumentNode:
+		for _, c := range n.Content {
+			if err := interpolateNode(c, env); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Interpolate substitutes $VAR, ${VAR}, ${VAR:-default}, ${VAR-default},
+// ${VAR:?error} and ${VAR?error} in s; $$ is a literal $.
+func Interpolate(s string, env map[string]string) (string, error) {
+	if !strings.Contains(s, "$") {
+		return s, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+1 == len(s) {
+			b.WriteByte('$')
+			continue
+		}
+		switch next := s[i+1]; {
+		case next == '$':
+			b.WriteByte('$')
+			i++
+		case next == '{':
+			end := closingBrace(s, i+1)
+			if end == -1 {
+				return "", fmt.Errorf("unterminated variable in %q", s)
+			}
+			v, err := expand(s[i+2:end], env)
+			if err != nil {
+				return "", err
+			}
+			b.WriteString(v)
+			i = end
+		case isNameByte(next, true):
+			j := i + 1
+			for j < len(s) && isNameByte(s[j], j == i+1) {
+				j++
+			}
+			b.WriteString(env[s[i+1:j]])
+			i = j - 1
+		default:
+			b.WriteByte('$')
+		}
+	}
+	return b.String(), nil
+}
+
+// closingBrace returns the index of the } closing the { at open, skipping
+// the ${…} nested in a default or error message, or -1.
+func closingBrace(s string, open int) int {
+	depth := 0
+	for i := open; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+func isNameByte(c byte, first bool) bool {
+	switch {
+	case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		return true
+	case c >= '0' && c <= '9':
+		return !first
+	}
+	return false
+}
+
+// expand resolves the inside of ${…}: a variable name, optionally followed
+// by an operator and its argument, which may itself hold variables.
+func expand(expr string, env map[string]string) (string, error) {
+	n := 0
+	for n < len(expr) && isNameByte(expr[n], n == 0) {
+		n++
+	}
+	name, rest := expr[:n], expr[n:]
+	if name == "" {
+		return "", fmt.Errorf("invalid variable ${%s}", expr)
+	}
+	if rest == "" {
+		return env[name], nil
+	}
+	for _, op := range []string{":-", ":?", "-", "?"} {
+		arg, ok := strings.CutPrefix(rest, op)
+		if !ok {
+			continue
+		}
+		value, set := env[name]
+		empty := !set || (strings.HasPrefix(op, ":") && value == "")
+		switch {
+		case !empty:
+			return value, nil
+		case strings.HasSuffix(op, "-"):
+			return Interpolate(arg, env)
+		default:
+			if arg == "" {
+				arg = "required variable is not set"
+			}
+			arg, err := Interpolate(arg, env)
+			if err != nil {
+				return "", err
+			}
+			return "", fmt.Errorf("%s: %s", name, arg)
+		}
+	}
+	return "", fmt.Errorf("invalid variable ${%s}", expr)
+}
+
+// readEnvFile reads KEY=value lines; blank lines and # comments are
+// skipped and values may be quoted.
+func readEnvFile(path string) (map[string]string, error) {
+	env := map[string]string{}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return env, err
+	}
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		k, v, ok := strings.Cut(strings.TrimPrefix(line, "export "), "=")
+		if !ok {
+			return env, fmt.Errorf("%s:%d: expected KEY=value", path, n)
+		}
+		v = strings.TrimSpace(v)
+		if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+			v = v[1 : len(v)-1]
+		}
+		env[strings.TrimSpace(k)] = v
+	}
+	return env, sc.Err()
+}
+
+// ReadEnvFiles reads the env_file entries of a service, relative to the
+// project directory, later files overriding earlier ones.
+func (p *Project) ReadEnvFiles(svc Service) (map[string]string, error) {
+	env := map[string]string{}
+	for _, f := range svc.EnvFile {
+		vars, err := readEnvFile(p.Path(f))
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range vars {
+			env[k] = v
+		}
+	}
+	return env, nil
+}
+
+// Path resolves a path of the compose file against its directory.
+func (p *Project) Path(path string) string {
+	if strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, path[2:])
+		}
+	}
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(p.Dir, path)
+}
+
+// Lookup finds a variable of the environment the file was loaded with.
+func (p *Project) Lookup(name string) (string, bool) {
+	v, ok := p.Env[name]
+	return v, ok
+}
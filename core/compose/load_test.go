@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package compose
+
+import (
+	"maps"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var testEnv = map[string]string{
+	"TAG":      "1.27",
+	"EMPTY":    "",
+	"REGISTRY": "registry.example.com",
+	"PORT":     "8080",
+}
+
+func TestInterpolate(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"no variables", "nginx:latest", "nginx:latest"},
+		{"bare variable", "nginx:$TAG", "nginx:1.27"},
+		{"braced variable", "nginx:${TAG}", "nginx:1.27"},
+		{"bare name ends at a non-name byte", "$REGISTRY/web", "registry.example.com/web"},
+		{"unset variable is empty", "x${MISSING}x", "xx"},
+		{"escaped dollar", "price: $$5", "price: $5"},
+		{"trailing dollar", "cost$", "cost$"},
+		{"dollar before a non-name byte", "a $ b", "a $ b"},
+		{"default when unset", "${MISSING:-latest}", "latest"},
+		{"default when empty", "${EMPTY:-latest}", "latest"},
+		{"set value wins over default", "${TAG:-latest}", "1.27"},
+		{"dash default keeps empty", "${EMPTY-latest}", ""},
+		{"dash default when unset", "${MISSING-latest}", "latest"},
+		{"nested default", "${MISSING:-${TAG}}", "1.27"},
+		{"nested default of a nested default", "${MISSING:-${ALSO_MISSING:-${PORT}}}", "8080"},
+		{"text after a nested variable", "${MISSING:-${TAG}}-alpine", "1.27-alpine"},
+		{"several variables", "${REGISTRY}/web:${TAG}", "registry.example.com/web:1.27"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Interpolate(tt.in, testEnv)
+			if err != nil {
+				t.Fatalf("Interpolate(%q) failed: %v", tt.in, err)
+			}
+			if got != tt.want {
+				t.Errorf("Interpolate(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestInterpolateErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		wantErr string
+	}{
+		{"unterminated", "${TAG", `unterminated variable in "${TAG"`},
+		{"unterminated nested", "${MISSING:-${TAG}", `unterminated variable in "${MISSING:-${TAG}"`},
+		{"required when unset", "${MISSING:?set MISSING}", "MISSING: set MISSING"},
+		{"required when empty", "${EMPTY:?must not be empty}", "EMPTY: must not be empty"},
+		{"required without message", "${MISSING?}", "MISSING: required variable is not set"},
+		{"required message is interpolated", "${MISSING:?needs ${TAG}}", "MISSING: needs 1.27"},
+		{"empty name", "${}", "invalid variable ${}"},
+		{"invalid operator", "${TAG+x}", "invalid variable ${TAG+x}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Interpolate(tt.in, testEnv)
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("Interpolate(%q) error = %v, want %q", tt.in, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExpand(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"TAG", "1.27"},
+		{"MISSING", ""},
+		{"MISSING:-a-b", "a-b"},
+		{"MISSING:-a:b?c", "a:b?c"},
+		{"EMPTY?unused", ""},
+		{"TAG:?unused", "1.27"},
+		{"MISSING-${EMPTY:-x}", "x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := expand(tt.expr, testEnv)
+			if err != nil {
+				t.Fatalf("expand(%q) failed: %v", tt.expr, err)
+			}
+			if got != tt.want {
+				t.Errorf("expand(%q) = %q, want %q", tt.expr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadEnvFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    map[string]string
+		wantErr bool
+	}{
+		{"empty", "", map[string]string{}, false},
+		{"plain", "TAG=1.27\nPORT=8080\n", map[string]string{"TAG": "1.27", "PORT": "8080"}, false},
+		{"comments and blank lines", "# tag\n\nTAG=1.27\n  # indented\n", map[string]string{"TAG": "1.27"}, false},
+		{"export prefix", "export TAG=1.27", map[string]string{"TAG": "1.27"}, false},
+		{"spaces around", "  TAG = 1.27  ", map[string]string{"TAG": "1.27"}, false},
+		{"double quotes", `MSG="hello world"`, map[string]string{"MSG": "hello world"}, false},
+		{"single quotes", `MSG='a # b'`, map[string]string{"MSG": "a # b"}, false},
+		{"mismatched quotes are kept", `MSG="a'`, map[string]string{"MSG": `"a'`}, false},
+		{"value with equals", "URL=a=b", map[string]string{"URL": "a=b"}, false},
+		{"empty value", "EMPTY=", map[string]string{"EMPTY": ""}, false},
+		{"later lines win", "TAG=1\nTAG=2", map[string]string{"TAG": "2"}, false},
+		{"missing equals", "TAG=1\nnot a pair\n", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), ".env")
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			got, err := readEnvFile(path)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("readEnvFile(%q) succeeded, want an error", tt.content)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("readEnvFile(%q) failed: %v", tt.content, err)
+			}
+			if !maps.Equal(got, tt.want) {
+				t.Errorf("readEnvFile(%q) = %v, want %v", tt.content, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReadEnvFileMissing(t *testing.T) {
+	env, err := readEnvFile(filepath.Join(t.TempDir(), ".env"))
+	if !os.IsNotExist(err) {
+		t.Errorf("readEnvFile of a missing file: error = %v, want not exist", err)
+	}
+	if env == nil {
+		t.Error("readEnvFile of a missing file returned a nil map")
+	}
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package diff compares two texts line by line, e.g. two service specs
+// rendered as YAML.
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v3"
+)
+
+// Op says whether a line is kept, removed from the old text or added by the
+// new one.
+type Op byte
+
+const (
+	Equal  Op = ' '
+	Delete Op = '-'
+	Insert Op = '+'
+)
+
+// Line is one line of a diff.
+type Line struct {
+	Op   Op
+	Text string
+}
+
+// Lines diffs old against new, keeping every line of both.
+func Lines(old, new string) []Line {
+	a := splitLines(old)
+	b := splitLines(new)
+	var out []Line
+	for _, op := range difflib.NewMatcher(a, b).GetOpCodes() {
+		switch op.Tag {
+		case 'e':
+			for _, s := range a[op.I1:op.I2] {
+				out = append(out, Line{Equal, s})
+			}
+		case 'd':
+			for _, s := range a[op.I1:op.I2] {
+				out = append(out, Line{Delete, s})
+			}
+		case 'i':
+			for _, s := range b[op.J1:op.J2] {
+				out = append(out, Line{Insert, s})
+			}
+		case 'r':
+			for _, s := range a[op.I1:op.I2] {
+				out = append(out, Line{Delete, s})
+			}
+			for _, s := range b[op.J1:op.J2] {
+				out = append(out, Line{Insert, s})
+			}
+		}
+	}
+	return out
+}
+
+func splitLines(s string) []string {
+	s = strings.TrimRight(s, "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+// Changed reports whether a diff has any added or removed line.
+func Changed(lines []Line) bool {
+	for _, l := range lines {
+		if l.Op != Equal {
+			return true
+		}
+	}
+	return false
+}
+
+// Stats counts the added and removed lines.
+func Stats(lines []Line) (added, removed int) {
+	for _, l := range lines {
+		switch l.Op {
+		case Insert:
+			added++
+		case Delete:
+			removed++
+		}
+	}
+	return added, removed
+}
+
+// YAML renders v as YAML through its JSON encoding, so that empty fields
+// tagged omitempty are left out and keys come out sorted: two values then
+// diff on what actually differs.
+func YAML(v any) (string, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return "", fmt.Errorf("encoding: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var generic any
+	if err := dec.Decode(&generic); err != nil {
+		return "", fmt.Errorf("decoding: %w", err)
+	}
+	if generic == nil {
+		return "", nil
+	}
+	out, err := yaml.Marshal(numbers(generic))
+	if err != nil {
+		return "", fmt.Errorf("rendering YAML: %w", err)
+	}
+	return string(out), nil
+}
+
+// numbers turns decoded JSON numbers back into integers where they are, so
+// that 536870912 does not render as 5.36870912e+08.
+func numbers(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			v[k] = numbers(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = numbers(e)
+		}
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return n
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return v
+}
@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package editor opens a document in the user's $EDITOR from a temp file and
+// hands the edited bytes back to the TUI.
+package editor
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// EditTempFileCmd writes initialData to a temp file named after pattern (as
+// in os.CreateTemp, e.g. "web-*.yaml"), suspends the TUI to open it in the
+// user's editor, and calls onDone with the edited bytes once the editor exits
+// successfully. On any error (creating or writing the temp file, running the
+// editor or reading the file back) onErr is called instead, so callers can
+// return their own message types. The temp file is removed when the editor
+// exits.
+func EditTempFileCmd(pattern string, initialData []byte, onDone func([]byte) tea.Msg, onErr func(error) tea.Msg) tea.Cmd {
+	tmp, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return func() tea.Msg { return onErr(fmt.Errorf("failed to create temp file: %w", err)) }
+	}
+	defer func(tmp *os.File) {
+		_ = tmp.Close()
+	}(tmp)
+
+	if _, err := tmp.Write(initialData); err != nil {
+		_ = os.Remove(tmp.Name())
+		return func() tea.Msg { return onErr(fmt.Errorf("failed to write temp file: %w", err)) }
+	}
+
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "nano"
+	}
+	cmd := exec.Command(editor, tmp.Name())
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		defer func(name string) {
+			_ = os.Remove(name)
+		}(tmp.Name())
+
+		if err != nil {
+			return onErr(fmt.Errorf("editor failed: %w", err))
+		}
+		newData, err := os.ReadFile(tmp.Name())
+		if err != nil {
+			return onErr(fmt.Errorf("failed to read edited file: %w", err))
+		}
+		return onDone(newData)
+	})
+}
@@ -0,0 +1,183 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package export writes the rows of a list view as CSV, JSON or Markdown.
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Format is an export file format.
+type Format string
+
+const (
+	CSV      Format = "csv"
+	JSON     Format = "json"
+	Markdown Format = "md"
+)
+
+// ParseFormat accepts "csv", "json" and "md"/"markdown" (case-insensitive).
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "csv":
+		return CSV, nil
+	case "json":
+		return JSON, nil
+	case "md", "markdown":
+		return Markdown, nil
+	}
+	return "", fmt.Errorf("unknown export format %q (use csv, json or md)", s)
+}
+
+// Table is the exported data: one value per column for every row.
+type Table struct {
+	Columns []string
+	Rows    [][]string
+}
+
+// Exporter is implemented by views whose rows can be exported. The table
+// holds the filtered rows in their current order, with every column the view
+// knows about, including hidden ones.
+type Exporter interface {
+	ExportTable() Table
+}
+
+// RequestMsg asks the app to export the current view. An empty Path writes
+// to DefaultPath.
+type RequestMsg struct {
+	Format Format
+	Path   string
+}
+
+// DoneMsg reports the outcome of an export.
+type DoneMsg struct {
+	Path string
+	Rows int
+	Err  error
+}
+
+// Write encodes the table in the given format.
+func Write(w io.Writer, format Format, t Table) error {
+	switch format {
+	case CSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write(t.Columns); err != nil {
+			return err
+		}
+		if err := cw.WriteAll(t.Rows); err != nil {
+			return err
+		}
+		return cw.Error()
+	case JSON:
+		return writeJSON(w, t)
+	case Markdown:
+		return writeMarkdown(w, t)
+	}
+	return fmt.Errorf("unknown export format %q", format)
+}
+
+// writeJSON writes an array of objects whose keys keep the column order.
+func writeJSON(w io.Writer, t Table) error {
+	var b strings.Builder
+	b.WriteString("[")
+	for i, row := range t.Rows {
+		if i > 0 {
+			b.WriteString(",")
+		}
+		b.WriteString("\n  {")
+		for j, col := range t.Columns {
+			if j > 0 {
+				b.WriteString(",")
+			}
+			v := ""
+			if j < len(row) {
+				v = row[j]
+			}
+			k, _ := json.Marshal(col)
+			val, _ := json.Marshal(v)
+			fmt.Fprintf(&b, "\n    %s: %s", k, val)
+		}
+		b.WriteString("\n  }")
+	}
+	if len(t.Rows) > 0 {
+		b.WriteString("\n")
+	}
+	b.WriteString("]\n")
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func writeMarkdown(w io.Writer, t Table) error {
+	cell := func(s string) string {
+		s = strings.ReplaceAll(s, "|", `\|`)
+		return strings.ReplaceAll(s, "\n", " ")
+	}
+	var b strings.Builder
+	b.WriteString("|")
+	for _, c := range t.Columns {
+		b.WriteString(" " + cell(c) + " |")
+	}
+	b.WriteString("\n|")
+	for range t.Columns {
+		b.WriteString(" --- |")
+	}
+	b.WriteString("\n")
+	for _, row := range t.Rows {
+		b.WriteString("|")
+		for j := range t.Columns {
+			v := ""
+			if j < len(row) {
+				v = row[j]
+			}
+			b.WriteString(" " + cell(v) + " |")
+		}
+		b.WriteString("\n")
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// DefaultPath returns a timestamped file name for a view's export inside dir,
+// e.g. <dir>/exports/services-20261018-142530.csv.
+func DefaultPath(dir, view string, format Format, now time.Time) string {
+	name := fmt.Sprintf("%s-%s.%s", view, now.Format("20060102-150405"), format)
+	return filepath.Join(dir, "exports", name)
+}
+
+// WriteFile writes the table to path, creating parent directories. A leading
+// "~/" is expanded to the home directory.
+func WriteFile(path string, format Format, t Table) (string, error) {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		if home, err := os.UserHomeDir(); err == nil {
+			path = filepath.Join(home, rest)
+		}
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return path, err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return path, err
+	}
+	if err := Write(f, format, t); err != nil {
+		f.Close()
+		return path, err
+	}
+	return path, f.Close()
+}
+
+// Time formats a timestamp for export, leaving zero times empty.
+func Time(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,177 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package fuzzy implements a small fzf-style subsequence matcher used by the
+// command input suggestions and the filterable lists.
+package fuzzy
+
+import (
+	"sort"
+	"unicode"
+)
+
+const (
+	scoreMatch       = 16
+	bonusConsecutive = 12
+	bonusBoundary    = 10
+	bonusFirstRune   = 8
+	bonusExact       = 32
+	penaltyGapStart  = 3
+	penaltyGapExtend = 1
+)
+
+// Result describes a successful match. Positions holds the rune indices of
+// text that matched the pattern, in ascending order.
+type Result struct {
+	Score     int
+	Positions []int
+}
+
+// Match reports whether every rune of pattern appears in text in order
+// (case-insensitively) and scores the match. Matches on word boundaries,
+// consecutive runs and the start of text score higher; gaps score lower.
+// An empty pattern matches everything with a zero score.
+func Match(pattern, text string) (Result, bool) {
+	p := []rune(pattern)
+	if len(p) == 0 {
+		return Result{}, true
+	}
+	t := []rune(text)
+	if len(p) > len(t) {
+		return Result{}, false
+	}
+	for i := range p {
+		p[i] = unicode.ToLower(p[i])
+	}
+	lower := make([]rune, len(t))
+	for i, r := range t {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	// Forward pass: find the earliest position where the whole pattern
+	// has been consumed.
+	pi, end := 0, -1
+	for ti := 0; ti < len(lower); ti++ {
+		if lower[ti] == p[pi] {
+			pi++
+			if pi == len(p) {
+				end = ti
+				break
+			}
+		}
+	}
+	if end < 0 {
+		return Result{}, false
+	}
+
+	// Backward pass: walk back from end to find the shortest window that
+	// still contains the pattern, which gives tighter, more intuitive
+	// highlights ("svc" in "my-service" picks "s-v-c" of "service").
+	start := end
+	pi = len(p) - 1
+	for ti := end; ti >= 0; ti-- {
+		if lower[ti] == p[pi] {
+			pi--
+			if pi < 0 {
+				start = ti
+				break
+			}
+		}
+	}
+
+	positions := make([]int, 0, len(p))
+	pi = 0
+	for ti := start; ti <= end && pi < len(p); ti++ {
+		if lower[ti] == p[pi] {
+			positions = append(positions, ti)
+			pi++
+		}
+	}
+
+	return Result{Score: score(t, positions, len(p) == len(t)), Positions: positions}, true
+}
+
+func score(t []rune, positions []int, exact bool) int {
+	s := 0
+	for i, pos := range positions {
+		s += scoreMatch
+		if pos == 0 {
+			s += bonusFirstRune
+		}
+		if isBoundary(t, pos) {
+			s += bonusBoundary
+		}
+		if i > 0 {
+			gap := pos - positions[i-1] - 1
+			if gap == 0 {
+				s += bonusConsecutive
+			} else {
+				s -= penaltyGapStart + (gap-1)*penaltyGapExtend
+			}
+		}
+	}
+	if exact {
+		s += bonusExact
+	}
+	// Prefer shorter candidates when everything else is equal.
+	return s*8 - len(t)/8
+}
+
+func isBoundary(t []rune, pos int) bool {
+	if pos == 0 {
+		return true
+	}
+	prev, cur := t[pos-1], t[pos]
+	switch prev {
+	case ' ', '-', '_', '.', '/', ':', '@', '=':
+		return true
+	}
+	return unicode.IsLower(prev) && unicode.IsUpper(cur)
+}
+
+// Best matches pattern against each field and returns the highest-scoring
+// result along with the index of the field it came from.
+func Best(pattern string, fields ...string) (Result, int, bool) {
+	var best Result
+	bestIdx := -1
+	for i, f := range fields {
+		r, ok := Match(pattern, f)
+		if !ok {
+			continue
+		}
+		if bestIdx < 0 || r.Score > best.Score {
+			best, bestIdx = r, i
+		}
+	}
+	return best, bestIdx, bestIdx >= 0
+}
+
+// Score is a convenience wrapper around Best for callers that only need to
+// rank items and do not render highlights.
+func Score(pattern string, fields ...string) (int, bool) {
+	r, _, ok := Best(pattern, fields...)
+	return r.Score, ok
+}
+
+// Rank filters candidates by pattern and orders them by descending score.
+// Ties keep the candidates' original relative order.
+func Rank(pattern string, candidates []string) []string {
+	type scored struct {
+		s     string
+		score int
+	}
+	var matched []scored
+	for _, c := range candidates {
+		if r, ok := Match(pattern, c); ok {
+			matched = append(matched, scored{c, r.Score})
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool {
+		return matched[i].score > matched[j].score
+	})
+	out := make([]string, len(matched))
+	for i, m := range matched {
+		out[i] = m.s
+	}
+	return out
+}
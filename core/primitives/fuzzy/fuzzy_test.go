@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package fuzzy
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		pattern   string
+		text      string
+		wantOK    bool
+		positions []int
+	}{
+		{"empty pattern", "", "web", true, nil},
+		{"exact", "web", "web", true, []int{0, 1, 2}},
+		{"prefix", "we", "web_api", true, []int{0, 1}},
+		{"subsequence", "wa", "web_api", true, []int{0, 4}},
+		{"case insensitive", "WEB", "Web_Api", true, []int{0, 1, 2}},
+		{"shortest window", "svc", "my-service", true, []int{3, 6, 8}},
+		{"out of order", "bew", "web", false, nil},
+		{"missing rune", "webx", "web_api", false, nil},
+		{"pattern longer than text", "webapi", "web", false, nil},
+		{"unicode", "çé", "façade-é", true, []int{2, 7}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, ok := Match(tt.pattern, tt.text)
+			if ok != tt.wantOK {
+				t.Fatalf("Match(%q, %q) ok = %v, want %v", tt.pattern, tt.text, ok, tt.wantOK)
+			}
+			if !slices.Equal(r.Positions, tt.positions) {
+				t.Errorf("Match(%q, %q) positions = %v, want %v", tt.pattern, tt.text, r.Positions, tt.positions)
+			}
+		})
+	}
+}
+
+func TestMatchScoreOrdering(t *testing.T) {
+	tests := []struct {
+		name          string
+		pattern       string
+		better, worse string
+	}{
+		{"exact beats prefix", "web", "web", "webapp"},
+		{"consecutive beats gapped", "api", "api-gw", "a-p-i"},
+		{"boundary beats mid-word", "gw", "api-gw", "bigwig"},
+		{"start beats middle", "web", "web-proxy", "my-web-proxy-x"},
+		{"camel case boundary", "sa", "serviceAccount", "snapshotsaver"},
+		{"shorter beats longer", "db", "db-1", "db-1-replica-old"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, ok := Match(tt.pattern, tt.better)
+			if !ok {
+				t.Fatalf("Match(%q, %q) did not match", tt.pattern, tt.better)
+			}
+			w, ok := Match(tt.pattern, tt.worse)
+			if !ok {
+				t.Fatalf("Match(%q, %q) did not match", tt.pattern, tt.worse)
+			}
+			if b.Score <= w.Score {
+				t.Errorf("score(%q) = %d, want > score(%q) = %d", tt.better, b.Score, tt.worse, w.Score)
+			}
+		})
+	}
+}
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		fields  []string
+		wantOK  bool
+		wantIdx int
+	}{
+		{"no fields", "web", nil, false, -1},
+		{"no match", "xyz", []string{"web", "api"}, false, -1},
+		{"second field", "f3a", []string{"web", "f3a9c2"}, true, 1},
+		{"best field wins", "api", []string{"a-p-i", "api"}, true, 1},
+		{"tie keeps first", "web", []string{"web", "web"}, true, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, idx, ok := Best(tt.pattern, tt.fields...)
+			if ok != tt.wantOK || idx != tt.wantIdx {
+				t.Fatalf("Best(%q, %q) = %d, %v, want %d, %v", tt.pattern, tt.fields, idx, ok, tt.wantIdx, tt.wantOK)
+			}
+			score, ok := Score(tt.pattern, tt.fields...)
+			if ok != tt.wantOK || score != r.Score {
+				t.Errorf("Score(%q, %q) = %d, %v, want %d, %v", tt.pattern, tt.fields, score, ok, r.Score, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestRank(t *testing.T) {
+	tests := []struct {
+		name       string
+		pattern    string
+		candidates []string
+		want       []string
+	}{
+		{"empty pattern keeps order", "", []string{"b", "a", "c"}, []string{"b", "a", "c"}},
+		{"filters non-matches", "net", []string{"networks", "nodes", "secrets"}, []string{"networks"}},
+		{"best first", "sec", []string{"nodes", "services", "secrets"}, []string{"secrets", "services"}},
+		{"ties keep order", "x", []string{"xa", "xb", "xc"}, []string{"xa", "xb", "xc"}},
+		{"nothing matches", "zz", []string{"stacks", "tasks"}, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Rank(tt.pattern, tt.candidates)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Rank(%q, %q) = %q, want %q", tt.pattern, tt.candidates, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package pulse records cluster metrics over the session in ring buffers,
+// one recorder per Docker context, for the :pulse dashboard. It is fed from
+// the app's periodic status ticks and the Docker event stream.
+package pulse
+
+import (
+	"swarmcli/docker"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// Capacity is the number of samples kept per metric: an hour at the
+// five-second tick.
+const Capacity = 720
+
+// Metric names a recorded time series.
+type Metric string
+
+const (
+	TasksRunning Metric = "tasks_running"
+	TasksDesired Metric = "tasks_desired"
+	// Transitions is the number of task state changes per minute.
+	Transitions Metric = "transitions"
+	// Failures is the number of tasks failing or rejected per minute.
+	Failures   Metric = "failures"
+	NodesReady Metric = "nodes_ready"
+	NodesTotal Metric = "nodes_total"
+	CPU        Metric = "cpu"
+	Memory     Metric = "memory"
+	// Events is the number of Docker events per minute.
+	Events Metric = "events"
+)
+
+// SampledMsg is sent after a new sample was recorded, so an open dashboard
+// redraws.
+type SampledMsg struct{}
+
+// ring is a fixed-size buffer of samples, oldest first.
+type ring struct {
+	values []float64
+	start  int
+	n      int
+}
+
+func (r *ring) add(v float64) {
+	if r.values == nil {
+		r.values = make([]float64, Capacity)
+	}
+	if r.n < len(r.values) {
+		r.values[(r.start+r.n)%len(r.values)] = v
+		r.n++
+		return
+	}
+	r.values[r.start] = v
+	r.start = (r.start + 1) % len(r.values)
+}
+
+// last returns up to n of the newest values, oldest first.
+func (r *ring) last(n int) []float64 {
+	n = min(n, r.n)
+	out := make([]float64, n)
+	for i := range out {
+		out[i] = r.values[(r.start+r.n-n+i)%len(r.values)]
+	}
+	return out
+}
+
+// Recorder holds the metrics of one context.
+type Recorder struct {
+	mu      sync.Mutex
+	series  map[Metric]*ring
+	started time.Time
+
+	// previous snapshot, to count transitions between samples
+	fetched time.Time
+	states  map[string]swarm.TaskState
+
+	// events seen since the last snapshot sample
+	events   int
+	eventsAt time.Time
+}
+
+var (
+	recordersMu sync.Mutex
+	recorders   = map[string]*Recorder{}
+)
+
+// For returns the recorder of a context, creating it on first use.
+func For(context string) *Recorder {
+	recordersMu.Lock()
+	defer recordersMu.Unlock()
+	r, ok := recorders[context]
+	if !ok {
+		r = &Recorder{series: map[Metric]*ring{}, started: time.Now()}
+		recorders[context] = r
+	}
+	return r
+}
+
+func (r *Recorder) addLocked(m Metric, v float64) {
+	s, ok := r.series[m]
+	if !ok {
+		s = &ring{}
+		r.series[m] = s
+	}
+	s.add(v)
+}
+
+// RecordSnapshot samples task and node metrics from a snapshot. A snapshot
+// already recorded is ignored. It reports whether a sample was added.
+func (r *Recorder) RecordSnapshot(snap *docker.SwarmSnapshot) bool {
+	if snap == nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !snap.Fetched.After(r.fetched) {
+		return false
+	}
+
+	var running, desired float64
+	for _, svc := range snap.Services {
+		switch {
+		case svc.Spec.Mode.Replicated != nil && svc.Spec.Mode.Replicated.Replicas != nil:
+			desired += float64(*svc.Spec.Mode.Replicated.Replicas)
+		case svc.Spec.Mode.Global != nil:
+			desired += float64(len(snap.Nodes))
+		}
+	}
+
+	states := make(map[string]swarm.TaskState, len(snap.Tasks))
+	var transitions, failures float64
+	for _, t := range snap.Tasks {
+		states[t.ID] = t.Status.State
+		if t.Status.State == swarm.TaskStateRunning && t.DesiredState == swarm.TaskStateRunning {
+			running++
+		}
+		if r.states == nil {
+			continue
+		}
+		if prev, ok := r.states[t.ID]; ok && prev == t.Status.State {
+			continue
+		}
+		transitions++
+		if t.Status.State == swarm.TaskStateFailed || t.Status.State == swarm.TaskStateRejected {
+			failures++
+		}
+	}
+
+	var ready float64
+	for _, n := range snap.Nodes {
+		if n.Status.State == swarm.NodeStateReady {
+			ready++
+		}
+	}
+
+	r.addLocked(TasksRunning, running)
+	r.addLocked(TasksDesired, desired)
+	r.addLocked(NodesReady, ready)
+	r.addLocked(NodesTotal, float64(len(snap.Nodes)))
+
+	// Rates need a previous sample to measure the interval against
+	if !r.fetched.IsZero() {
+		minutes := snap.Fetched.Sub(r.fetched).Minutes()
+		r.addLocked(Transitions, transitions/minutes)
+		r.addLocked(Failures, failures/minutes)
+	}
+	if !r.eventsAt.IsZero() {
+		r.addLocked(Events, float64(r.events)/snap.Fetched.Sub(r.eventsAt).Minutes())
+	}
+	r.events, r.eventsAt = 0, snap.Fetched
+
+	r.fetched = snap.Fetched
+	r.states = states
+	return true
+}
+
+// RecordEvent counts a Docker event.
+func (r *Recorder) RecordEvent() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events++
+}
+
+// RecordUsage samples the CPU and memory usage percentages.
+func (r *Recorder) RecordUsage(cpu, mem float64) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.addLocked(CPU, cpu)
+	r.addLocked(Memory, mem)
+}
+
+// Values returns up to n of the newest samples of a metric, oldest first.
+func (r *Recorder) Values(m Metric, n int) []float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	s, ok := r.series[m]
+	if !ok {
+		return nil
+	}
+	return s.last(n)
+}
+
+// Last returns the newest sample of a metric.
+func (r *Recorder) Last(m Metric) (float64, bool) {
+	v := r.Values(m, 1)
+	if len(v) == 0 {
+		return 0, false
+	}
+	return v[0], true
+}
+
+// Started returns when recording began.
+func (r *Recorder) Started() time.Time {
+	return r.started
+}
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// Allocation is an amount of a resource set against what a node offers.
+// CPU amounts are in NanoCPUs, memory in bytes, generic resources in units.
+type Allocation struct {
+	Capacity int64
+	Reserved int64
+	Limits   int64
+}
+
+// ReservedPercent is the share of the capacity reserved; 0 without
+// capacity.
+func (a Allocation) ReservedPercent() float64 {
+	if a.Capacity <= 0 {
+		return 0
+	}
+	return float64(a.Reserved) / float64(a.Capacity) * 100
+}
+
+// LimitsPercent is the share of the capacity the limits add up to, which
+// can exceed 100% as limits may overcommit.
+func (a Allocation) LimitsPercent() float64 {
+	if a.Capacity <= 0 {
+		return 0
+	}
+	return float64(a.Limits) / float64(a.Capacity) * 100
+}
+
+func (a *Allocation) add(o Allocation) {
+	a.Capacity += o.Capacity
+	a.Reserved += o.Reserved
+	a.Limits += o.Limits
+}
+
+// TaskAllocation is what one task scheduled on a node reserves and may use.
+type TaskAllocation struct {
+	Name        string
+	CPUReserved int64
+	MemReserved int64
+	CPULimit    int64
+	MemLimit    int64
+}
+
+// NodeResources compares a node's resources with the reservations and
+// limits of the tasks scheduled on it. The scheduler places a task only
+// where its reservations fit in what is left, so a node with little left
+// rejects tasks with "insufficient resources".
+type NodeResources struct {
+	CPU    Allocation
+	Memory Allocation
+	// Generic maps each generic resource kind (e.g. "gpu") to its
+	// allocation; named resources count one unit each.
+	Generic map[string]Allocation
+	// Tasks are the node's active tasks, biggest reservations first.
+	Tasks []TaskAllocation
+}
+
+// GenericSummary lists the generic resources as "kind reserved/capacity",
+// e.g. "gpu 1/4"; "-" when there are none.
+func (r NodeResources) GenericSummary() string {
+	if len(r.Generic) == 0 {
+		return "-"
+	}
+	kinds := make([]string, 0, len(r.Generic))
+	for k := range r.Generic {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+	parts := make([]string, len(kinds))
+	for i, k := range kinds {
+		a := r.Generic[k]
+		parts[i] = fmt.Sprintf("%s %d/%d", k, a.Reserved, a.Capacity)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Add sums other into r, for cluster totals. Tasks are not carried over.
+func (r *NodeResources) Add(other NodeResources) {
+	r.CPU.add(other.CPU)
+	r.Memory.add(other.Memory)
+	for k, a := range other.Generic {
+		if r.Generic == nil {
+			r.Generic = map[string]Allocation{}
+		}
+		g := r.Generic[k]
+		g.add(a)
+		r.Generic[k] = g
+	}
+}
+
+// activeOnNode reports whether a task holds resources on its node: the
+// scheduler assigned it and it has not stopped.
+func activeOnNode(t swarm.Task) bool {
+	if t.NodeID == "" || t.DesiredState != swarm.TaskStateRunning {
+		return false
+	}
+	switch t.Status.State {
+	case swarm.TaskStateComplete, swarm.TaskStateFailed, swarm.TaskStateRejected,
+		swarm.TaskStateShutdown, swarm.TaskStateOrphaned, swarm.TaskStateRemove:
+		return false
+	}
+	return true
+}
+
+// genericUnits sums generic resources per kind.
+func genericUnits(resources []swarm.GenericResource) map[string]int64 {
+	out := map[string]int64{}
+	for _, g := range resources {
+		switch {
+		case g.DiscreteResourceSpec != nil:
+			out[g.DiscreteResourceSpec.Kind] += g.DiscreteResourceSpec.Value
+		case g.NamedResourceSpec != nil:
+			out[g.NamedResourceSpec.Kind]++
+		}
+	}
+	return out
+}
+
+// NodeResources computes the resources of every node, keyed by node ID.
+func (s SwarmSnapshot) NodeResources() map[string]NodeResources {
+	out := make(map[string]NodeResources, len(s.Nodes))
+	for _, n := range s.Nodes {
+		res := n.Description.Resources
+		r := NodeResources{
+			CPU:    Allocation{Capacity: res.NanoCPUs},
+			Memory: Allocation{Capacity: res.MemoryBytes},
+		}
+		for kind, units := range genericUnits(res.GenericResources) {
+			if r.Generic == nil {
+				r.Generic = map[string]Allocation{}
+			}
+			r.Generic[kind] = Allocation{Capacity: units}
+		}
+		out[n.ID] = r
+	}
+
+	names := make(map[string]string, len(s.Services))
+	for _, svc := range s.Services {
+		names[svc.ID] = svc.Spec.Name
+	}
+
+	for _, t := range s.Tasks {
+		if !activeOnNode(t) {
+			continue
+		}
+		r, ok := out[t.NodeID]
+		if !ok {
+			continue
+		}
+
+		ta := TaskAllocation{Name: fmt.Sprintf("%s.%d", names[t.ServiceID], t.Slot)}
+		if t.Slot == 0 {
+			ta.Name = names[t.ServiceID]
+		}
+		if req := t.Spec.Resources; req != nil {
+			if res := req.Reservations; res != nil {
+				ta.CPUReserved, ta.MemReserved = res.NanoCPUs, res.MemoryBytes
+				for kind, units := range genericUnits(res.GenericResources) {
+					if r.Generic == nil {
+						r.Generic = map[string]Allocation{}
+					}
+					g := r.Generic[kind]
+					g.Reserved += units
+					r.Generic[kind] = g
+				}
+			}
+			if lim := req.Limits; lim != nil {
+				ta.CPULimit, ta.MemLimit = lim.NanoCPUs, lim.MemoryBytes
+			}
+		}
+		r.CPU.Reserved += ta.CPUReserved
+		r.CPU.Limits += ta.CPULimit
+		r.Memory.Reserved += ta.MemReserved
+		r.Memory.Limits += ta.MemLimit
+		r.Tasks = append(r.Tasks, ta)
+		out[t.NodeID] = r
+	}
+
+	for id, r := range out {
+		sort.SliceStable(r.Tasks, func(i, j int) bool {
+			a, b := r.Tasks[i], r.Tasks[j]
+			if a.MemReserved != b.MemReserved {
+				return a.MemReserved > b.MemReserved
+			}
+			if a.CPUReserved != b.CPUReserved {
+				return a.CPUReserved > b.CPUReserved
+			}
+			return a.Name < b.Name
+		})
+		out[id] = r
+	}
+	return out
+}
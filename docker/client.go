@@ -11,8 +11,10 @@ import (
 	"os/exec"
 	"path/filepath"
 	swarmlog "swarmcli/utils/log"
+	"sync"
 	"time"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/docker/docker/client"
 )
 
@@ -32,16 +34,88 @@ type dockerContext struct {
 	} `json:"Storage"`
 }
 
+var (
+	activeMu sync.RWMutex
+	// active is the context chosen by the app (the current tab); empty
+	// means resolve it from the environment on every call.
+	active string
+)
+
+// SetActiveContext binds the package to a Docker context: clients and the
+// snapshot cache use it instead of DOCKER_CONTEXT or `docker context show`.
+func SetActiveContext(name string) {
+	activeMu.Lock()
+	defer activeMu.Unlock()
+	active = name
+}
+
+// ActiveContext returns the context set with SetActiveContext, falling back
+// to GetContextFromEnv.
+func ActiveContext() (string, error) {
+	activeMu.RLock()
+	name := active
+	activeMu.RUnlock()
+	if name != "" {
+		return name, nil
+	}
+	return GetContextFromEnv()
+}
+
+// ContextRequest is returned by a tea.Cmd that must run against the Docker
+// context of the tab that issued it rather than the active one, which may
+// have changed by the time the command runs. The app calls it with that
+// tab's context.
+type ContextRequest func(ctxName string) tea.Msg
+
+// ContextCmd returns a command that runs fn with the Docker context of the
+// tab that issued it.
+func ContextCmd(fn func(ctxName string) tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return ContextRequest(fn)
+	}
+}
+
+type contextNameKey struct{}
+
+// WithContextName binds ctx to the named Docker context: functions taking
+// ctx use it instead of the active one.
+func WithContextName(ctx context.Context, ctxName string) context.Context {
+	return context.WithValue(ctx, contextNameKey{}, ctxName)
+}
+
+// contextName returns the Docker context bound to ctx with WithContextName,
+// falling back to ActiveContext.
+func contextName(ctx context.Context) (string, error) {
+	if name, ok := ctx.Value(contextNameKey{}).(string); ok && name != "" {
+		return name, nil
+	}
+	return ActiveContext()
+}
+
+// clientFor returns a client for the Docker context bound to ctx.
+func clientFor(ctx context.Context) (*client.Client, error) {
+	ctxName, err := contextName(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ClientForContext(ctxName)
+}
+
 // GetClient returns a Docker SDK client configured based on the current Docker context.
 func GetClient() (*client.Client, error) {
-	// Determine the Docker context to use. Prefer the `DOCKER_CONTEXT`
-	// environment variable (useful for CI/dev), otherwise fall back to the
+	// Determine the Docker context to use: the one bound by the app, else the
+	// `DOCKER_CONTEXT` environment variable (useful for CI/dev), otherwise the
 	// currently active Docker context reported by `docker context show`.
-	ctxName, err := GetContextFromEnv()
+	ctxName, err := ActiveContext()
 	if err != nil {
 		return nil, err
 	}
+	return ClientForContext(ctxName)
+}
 
+// ClientForContext returns a Docker SDK client for the named context,
+// regardless of the active one.
+func ClientForContext(ctxName string) (*client.Client, error) {
 	inspectOut, err := exec.Command("docker", "context", "inspect", ctxName).Output()
 	if err != nil {
 		return nil, fmt.Errorf("failed to inspect context: %w", err)
@@ -70,7 +144,7 @@ func GetClient() (*client.Client, error) {
 	cert := filepath.Join(tlsPath, "cert.pem")
 	key := filepath.Join(tlsPath, "key.pem")
 
-	l().Infof("[GetClient] host=%q tlsPath=%q skipVerify=%v", host, tlsPath, skipVerify)
+	l().Infof("[GetClient] context=%q host=%q tlsPath=%q skipVerify=%v", ctxName, host, tlsPath, skipVerify)
 	l().Infof("[GetClient] certs present: ca=%t cert=%t key=%t",
 		fileExists(ca), fileExists(cert), fileExists(key))
 
@@ -0,0 +1,203 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// failedTaskWindow is how far back ClusterSummary counts failed tasks.
+const failedTaskWindow = time.Hour
+
+// ClusterSummary is the health overview of one swarm, as shown by the
+// federated clusters view.
+type ClusterSummary struct {
+	Context string
+	Host    string
+	Err     error
+
+	Nodes      int
+	NodesReady int
+	NodesDown  int
+
+	Managers          int
+	ManagersReachable int
+
+	Services          int
+	ServicesConverged int
+
+	// FailedTasks counts tasks that failed or were rejected in the last hour.
+	FailedTasks int
+
+	// EngineVersions maps each engine version to the number of nodes on it.
+	EngineVersions map[string]int
+
+	Fetched time.Time
+}
+
+// Quorum is the number of reachable managers the cluster needs.
+func (c ClusterSummary) Quorum() int {
+	return c.Managers/2 + 1
+}
+
+// HasQuorum reports whether enough managers are reachable.
+func (c ClusterSummary) HasQuorum() bool {
+	return c.Managers > 0 && c.ManagersReachable >= c.Quorum()
+}
+
+// Versions lists the engine versions, most common first, e.g.
+// "27.3.1 (3), 26.1.4 (1)".
+func (c ClusterSummary) Versions() string {
+	versions := make([]string, 0, len(c.EngineVersions))
+	for v := range c.EngineVersions {
+		versions = append(versions, v)
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		a, b := c.EngineVersions[versions[i]], c.EngineVersions[versions[j]]
+		if a != b {
+			return a > b
+		}
+		return versions[i] > versions[j]
+	})
+	if len(versions) == 1 {
+		return versions[0]
+	}
+	parts := make([]string, len(versions))
+	for i, v := range versions {
+		parts[i] = fmt.Sprintf("%s (%d)", v, c.EngineVersions[v])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// Summarize computes the health overview of a snapshot.
+func (s SwarmSnapshot) Summarize(now time.Time) ClusterSummary {
+	sum := ClusterSummary{
+		Nodes:          len(s.Nodes),
+		Services:       len(s.Services),
+		EngineVersions: map[string]int{},
+		Fetched:        s.Fetched,
+	}
+
+	for _, n := range s.ToNodeEntries() {
+		switch swarm.NodeState(n.State) {
+		case swarm.NodeStateReady:
+			sum.NodesReady++
+		case swarm.NodeStateDown, swarm.NodeStateDisconnected:
+			sum.NodesDown++
+		}
+		sum.EngineVersions[n.Version]++
+	}
+	for _, n := range s.Nodes {
+		if n.ManagerStatus == nil {
+			continue
+		}
+		sum.Managers++
+		if n.ManagerStatus.Reachability == swarm.ReachabilityReachable {
+			sum.ManagersReachable++
+		}
+	}
+
+	for _, svc := range s.Services {
+		if s.converged(svc) {
+			sum.ServicesConverged++
+		}
+	}
+
+	for _, t := range s.Tasks {
+		if t.Status.State != swarm.TaskStateFailed && t.Status.State != swarm.TaskStateRejected {
+			continue
+		}
+		if now.Sub(t.Status.Timestamp) <= failedTaskWindow {
+			sum.FailedTasks++
+		}
+	}
+
+	return sum
+}
+
+// converged reports whether a service runs every task it wants. It trusts
+// the manager's ServiceStatus, which the snapshot lists services with, and
+// otherwise counts the running tasks against the replicas, or for a global
+// service against the ready, active nodes its constraints allow.
+func (s *SwarmSnapshot) converged(svc swarm.Service) bool {
+	if st := svc.ServiceStatus; st != nil {
+		return st.RunningTasks >= st.DesiredTasks
+	}
+
+	running := 0
+	for _, t := range s.Tasks {
+		if t.ServiceID == svc.ID && t.Status.State == swarm.TaskStateRunning {
+			running++
+		}
+	}
+	if svc.Spec.Mode.Global == nil {
+		_, desired := getServiceStackAndDesired(svc, s)
+		return running >= desired
+	}
+	return running >= s.globalTargets(svc)
+}
+
+// globalTargets counts the nodes a global service should run a task on:
+// ready nodes with active availability that pass its constraints.
+func (s *SwarmSnapshot) globalTargets(svc swarm.Service) int {
+	var constraints []placementConstraint
+	if p := svc.Spec.TaskTemplate.Placement; p != nil {
+		for _, expr := range p.Constraints {
+			// Swarm rejects services with invalid constraints, so skipping
+			// one only matters for specs it would not have accepted
+			if c, err := parseConstraint(expr); err == nil {
+				constraints = append(constraints, c)
+			}
+		}
+	}
+
+	n := 0
+nodes:
+	for _, node := range s.Nodes {
+		if node.Status.State != swarm.NodeStateReady {
+			continue
+		}
+		if a := node.Spec.Availability; a != "" && a != swarm.NodeAvailabilityActive {
+			continue
+		}
+		for _, c := range constraints {
+			if ok, err := c.matches(node); err != nil || !ok {
+				continue nodes
+			}
+		}
+		n++
+	}
+	return n
+}
+
+// LoadClusterSummaries refreshes the snapshots of the given contexts in
+// parallel and summarizes each. The result keeps the order of contexts; a
+// context that cannot be reached has Err set.
+func LoadClusterSummaries(contexts []ContextInfo) []ClusterSummary {
+	out := make([]ClusterSummary, len(contexts))
+	var wg sync.WaitGroup
+	for i, c := range contexts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			snap, err := RefreshSnapshotFor(c.Name)
+			if err != nil {
+				l().Warnf("[LoadClusterSummaries] %s: %v", c.Name, err)
+				out[i] = ClusterSummary{Err: err}
+			} else {
+				out[i] = snap.Summarize(time.Now())
+			}
+			out[i].Context = c.Name
+			out[i].Host = c.DockerHost
+		}()
+	}
+	wg.Wait()
+	return out
+}
@@ -20,7 +20,7 @@ import (
 
 // ListServicesUsingConfigID returns all services that reference a config by ID
 func ListServicesUsingConfigID(ctx context.Context, configID string) ([]swarm.Service, error) {
-	client, err := GetClient()
+	client, err := clientFor(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +97,7 @@ func (cfg *ConfigWithDecodedData) PrettyJSON() ([]byte, error) {
 func ListConfigs(ctx context.Context) ([]swarm.Config, error) {
 	l().Debug("[ListConfigs] Listing all configs")
 
-	cli, err := GetClient()
+	cli, err := clientFor(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +141,7 @@ func closeCli(cli *client.Client) {
 func InspectConfig(ctx context.Context, nameOrID string) (*ConfigWithDecodedData, error) {
 	l().Debugf("[InspectConfig] Inspecting config: %s", nameOrID)
 
-	cli, err := GetClient()
+	cli, err := clientFor(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -202,7 +202,7 @@ func CreateConfig(ctx context.Context, name string, data []byte, labels map[stri
 // and returns the populated swarm.Config or an error. The caller may pass a
 // prefix for logging context (e.g. "[CreateConfigVersion]").
 func createConfigWithSpec(ctx context.Context, spec swarm.ConfigSpec, logPrefix string) (swarm.Config, error) {
-	cli, err := GetClient()
+	cli, err := clientFor(ctx)
 	if err != nil {
 		return swarm.Config{}, err
 	}
@@ -233,7 +233,7 @@ func RotateConfigInServices(ctx context.Context, oldCfg *swarm.Config, newCfg sw
 		return fmt.Errorf("new config must have a valid ID")
 	}
 
-	client, err := GetClient()
+	client, err := clientFor(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get docker client: %w", err)
 	}
@@ -284,7 +284,7 @@ func DeleteConfig(ctx context.Context, nameOrID string) error {
 		return err
 	}
 
-	cli, err := GetClient()
+	cli, err := clientFor(ctx)
 	if err != nil {
 		return err
 	}
@@ -325,11 +325,8 @@ func listServicesUsingConfig(ctx context.Context, client *client.Client, configI
 	}
 	var filtered []swarm.Service
 	for _, s := range services {
-		for _, c := range s.Spec.TaskTemplate.ContainerSpec.Configs {
-			if c.ConfigID == configID {
-				filtered = append(filtered, s)
-				break
-			}
+		if serviceUsesConfig(s, configID, "") {
+			filtered = append(filtered, s)
 		}
 	}
 	return filtered, nil
@@ -337,7 +334,7 @@ func listServicesUsingConfig(ctx context.Context, client *client.Client, configI
 
 // ListServicesUsingConfigName returns all services that reference a config by name
 func ListServicesUsingConfigName(ctx context.Context, name string) ([]swarm.Service, error) {
-	client, err := GetClient()
+	client, err := clientFor(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -351,12 +348,24 @@ func listServicesUsingConfigName(ctx context.Context, client *client.Client, nam
 	}
 	var filtered []swarm.Service
 	for _, s := range services {
-		for _, c := range s.Spec.TaskTemplate.ContainerSpec.Configs {
-			if c.ConfigName == name {
-				filtered = append(filtered, s)
-				break
-			}
+		if serviceUsesConfig(s, "", name) {
+			filtered = append(filtered, s)
 		}
 	}
 	return filtered, nil
 }
+
+// serviceUsesConfig reports whether a service references a config by ID or
+// by name; an empty ID or name matches nothing.
+func serviceUsesConfig(s swarm.Service, id, name string) bool {
+	cs := s.Spec.TaskTemplate.ContainerSpec
+	if cs == nil {
+		return false
+	}
+	for _, c := range cs.Configs {
+		if (id != "" && c.ConfigID == id) || (name != "" && c.ConfigName == name) {
+			return true
+		}
+	}
+	return false
+}
@@ -52,6 +52,12 @@ func ListContexts() ([]ContextInfo, error) {
 		return nil, fmt.Errorf("failed to list contexts: %w", err)
 	}
 
+	// With a context bound by the app, "current" is that one rather than
+	// the Docker CLI's
+	activeMu.RLock()
+	bound := active
+	activeMu.RUnlock()
+
 	var contexts []ContextInfo
 	scanner := bufio.NewScanner(bytes.NewReader(output))
 
@@ -61,9 +67,13 @@ func ListContexts() ([]ContextInfo, error) {
 			return nil, fmt.Errorf("failed to parse context JSON: %w", err)
 		}
 
+		current := item.Current
+		if bound != "" {
+			current = item.Name == bound
+		}
 		contexts = append(contexts, ContextInfo{
 			Name:        item.Name,
-			Current:     item.Current,
+			Current:     current,
 			Description: item.Description,
 			DockerHost:  item.DockerEndpoint,
 			TLS:         checkContextTLS(item.Name),
@@ -142,24 +152,12 @@ func UseContext(contextName string) error {
 	return nil
 }
 
-// ValidateContext checks if a context switch would succeed by attempting to connect
+// ValidateContext checks that a context is reachable and, if so, makes it
+// the Docker CLI's current context.
 func ValidateContext(contextName string) error {
-	// Save current context
-	currentCtx, err := GetCurrentContext()
-	if err != nil {
-		return fmt.Errorf("failed to get current context: %w", err)
-	}
-
-	// Try switching to the new context
-	if err := UseContext(contextName); err != nil {
-		return err
-	}
-
 	// Try to create a client and ping
-	cli, err := GetClient()
+	cli, err := ClientForContext(contextName)
 	if err != nil {
-		// Switch back to original context
-		_ = UseContext(currentCtx)
 		return fmt.Errorf("failed to connect to context %s: %w", contextName, err)
 	}
 	defer func() { _ = cli.Close() }()
@@ -167,12 +165,10 @@ func ValidateContext(contextName string) error {
 	// Verify connection with ping
 	ctx := context.Background()
 	if _, err := cli.Ping(ctx); err != nil {
-		// Switch back to original context
-		_ = UseContext(currentCtx)
 		return fmt.Errorf("failed to ping context %s: %w", contextName, err)
 	}
 
-	return nil
+	return UseContext(contextName)
 }
 
 // InspectContext returns the detailed JSON inspection of a Docker context
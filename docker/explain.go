@@ -0,0 +1,368 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/docker/docker/api/types/swarm"
+	"github.com/docker/go-units"
+)
+
+// NodePlacement tells whether a node could take a new task of a service,
+// and why not.
+type NodePlacement struct {
+	NodeID   string
+	Hostname string
+	// Reasons lists every filter the node fails; empty means eligible.
+	Reasons []string
+	// Spread is the node's value for the service's spread preferences,
+	// e.g. "zone=eu-1".
+	Spread string
+}
+
+// Eligible reports whether the node passes every filter.
+func (p NodePlacement) Eligible() bool {
+	return len(p.Reasons) == 0
+}
+
+// PlacementReport explains where the scheduler can place a service's tasks.
+// It replays the scheduler's filters (node state, constraints, platform,
+// max replicas per node, resources and host-mode ports) on the snapshot.
+type PlacementReport struct {
+	ServiceID string
+	Service   string
+	// Requirements describe what the service asks of a node.
+	Requirements []string
+	// Pending counts the service's tasks waiting for a node.
+	Pending int
+	// Errors are the distinct scheduler messages of the pending tasks, or
+	// of the explained task only.
+	Errors []string
+	Nodes  []NodePlacement
+}
+
+// Eligible counts the nodes that could take a new task.
+func (r PlacementReport) Eligible() int {
+	n := 0
+	for _, p := range r.Nodes {
+		if p.Eligible() {
+			n++
+		}
+	}
+	return n
+}
+
+// placementConstraint is a parsed "key==value" or "key!=value" expression.
+type placementConstraint struct {
+	expr  string
+	key   string
+	value string
+	equal bool
+}
+
+func parseConstraint(expr string) (placementConstraint, error) {
+	for _, op := range []string{"==", "!="} {
+		if key, value, ok := strings.Cut(expr, op); ok {
+			return placementConstraint{
+				expr:  strings.TrimSpace(expr),
+				key:   strings.TrimSpace(key),
+				value: strings.TrimSpace(value),
+				equal: op == "==",
+			}, nil
+		}
+	}
+	return placementConstraint{}, fmt.Errorf("invalid constraint %q", expr)
+}
+
+// nodeValue returns the node attribute a constraint key refers to; ok is
+// false when the node does not have it (e.g. a missing label).
+func nodeValue(n swarm.Node, key string) (value string, ok bool, err error) {
+	switch {
+	case strings.EqualFold(key, "node.id"):
+		return n.ID, true, nil
+	case strings.EqualFold(key, "node.hostname"):
+		return n.Description.Hostname, true, nil
+	case strings.EqualFold(key, "node.role"):
+		return string(n.Spec.Role), true, nil
+	case strings.EqualFold(key, "node.platform.os"):
+		return n.Description.Platform.OS, true, nil
+	case strings.EqualFold(key, "node.platform.arch"):
+		return n.Description.Platform.Architecture, true, nil
+	}
+	if label, found := cutPrefixFold(key, "node.labels."); found {
+		value, ok = n.Spec.Labels[label]
+		return value, ok, nil
+	}
+	if label, found := cutPrefixFold(key, "engine.labels."); found {
+		value, ok = n.Description.Engine.Labels[label]
+		return value, ok, nil
+	}
+	return "", false, fmt.Errorf("unsupported constraint key %q", key)
+}
+
+// cutPrefixFold is strings.CutPrefix with the prefix matched case
+// insensitively; the rest, a label name, keeps its case.
+func cutPrefixFold(s, prefix string) (string, bool) {
+	if len(s) < len(prefix) || !strings.EqualFold(s[:len(prefix)], prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
+
+// matches applies the constraint like swarm does: values compare case
+// insensitively and a missing attribute only satisfies "!=".
+func (c placementConstraint) matches(n swarm.Node) (bool, error) {
+	value, ok, err := nodeValue(n, c.key)
+	if err != nil {
+		return false, err
+	}
+	equal := ok && strings.EqualFold(value, c.value)
+	return equal == c.equal, nil
+}
+
+// normalizeArch maps the architecture names engines report to the ones
+// images use.
+func normalizeArch(arch string) string {
+	switch strings.ToLower(arch) {
+	case "x86_64", "x86-64":
+		return "amd64"
+	case "aarch64":
+		return "arm64"
+	}
+	return strings.ToLower(arch)
+}
+
+func platformString(p swarm.Platform) string {
+	if p.Architecture == "" {
+		return p.OS
+	}
+	return p.OS + "/" + p.Architecture
+}
+
+func formatCPUs(nano int64) string {
+	return fmt.Sprintf("%.2f", float64(nano)/1e9)
+}
+
+func formatMemory(n int64) string {
+	return units.CustomSize("%.1f%s", float64(n), 1024.0, []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB"})
+}
+
+// hostPorts lists the ports published in host mode, e.g. "8080/tcp".
+func hostPorts(svc swarm.Service) []string {
+	var ports []string
+	if svc.Spec.EndpointSpec == nil {
+		return nil
+	}
+	for _, p := range svc.Spec.EndpointSpec.Ports {
+		if p.PublishMode == swarm.PortConfigPublishModeHost && p.PublishedPort != 0 {
+			ports = append(ports, fmt.Sprintf("%d/%s", p.PublishedPort, p.Protocol))
+		}
+	}
+	return ports
+}
+
+// isPending reports whether a task waits for the scheduler to find a node.
+func isPending(t swarm.Task) bool {
+	return t.DesiredState == swarm.TaskStateRunning && t.NodeID == "" ||
+		t.Status.State == swarm.TaskStatePending
+}
+
+// ExplainPlacement checks every node of the snapshot against what the
+// service needs. With a taskID (or its prefix), Errors holds that task's
+// message only.
+func ExplainPlacement(snap *SwarmSnapshot, serviceID, taskID string) (PlacementReport, error) {
+	svc := snap.FindService(serviceID)
+	if svc == nil {
+		return PlacementReport{}, fmt.Errorf("service %s not found", serviceID)
+	}
+
+	report := PlacementReport{ServiceID: svc.ID, Service: svc.Spec.Name}
+	placement := svc.Spec.TaskTemplate.Placement
+	if placement == nil {
+		placement = &swarm.Placement{}
+	}
+
+	var constraints []placementConstraint
+	for _, expr := range placement.Constraints {
+		c, err := parseConstraint(expr)
+		if err != nil {
+			return PlacementReport{}, err
+		}
+		constraints = append(constraints, c)
+		report.Requirements = append(report.Requirements, "constraint "+c.expr)
+	}
+
+	var spreads []string
+	for _, p := range placement.Preferences {
+		if p.Spread != nil {
+			spreads = append(spreads, p.Spread.SpreadDescriptor)
+			report.Requirements = append(report.Requirements, "spread over "+p.Spread.SpreadDescriptor)
+		}
+	}
+
+	if len(placement.Platforms) > 0 {
+		names := make([]string, len(placement.Platforms))
+		for i, p := range placement.Platforms {
+			names[i] = platformString(p)
+		}
+		report.Requirements = append(report.Requirements, "platform "+strings.Join(names, " or "))
+	}
+
+	if placement.MaxReplicas > 0 {
+		report.Requirements = append(report.Requirements, fmt.Sprintf("at most %d replicas per node", placement.MaxReplicas))
+	}
+
+	var reserved swarm.Resources
+	if r := svc.Spec.TaskTemplate.Resources; r != nil && r.Reservations != nil {
+		reserved = *r.Reservations
+	}
+	if reserved.NanoCPUs > 0 {
+		report.Requirements = append(report.Requirements, fmt.Sprintf("reserves %s CPUs", formatCPUs(reserved.NanoCPUs)))
+	}
+	if reserved.MemoryBytes > 0 {
+		report.Requirements = append(report.Requirements, "reserves "+formatMemory(reserved.MemoryBytes)+" memory")
+	}
+	neededGeneric := genericUnits(reserved.GenericResources)
+	kinds := make([]string, 0, len(neededGeneric))
+	for kind := range neededGeneric {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		report.Requirements = append(report.Requirements, fmt.Sprintf("reserves %d %s", neededGeneric[kind], kind))
+	}
+
+	ports := hostPorts(*svc)
+	if len(ports) > 0 {
+		report.Requirements = append(report.Requirements, "publishes host port "+strings.Join(ports, ", "))
+	}
+
+	// What each node already runs: replicas of this service, and host ports
+	// held by any task
+	services := make(map[string]swarm.Service, len(snap.Services))
+	for _, s := range snap.Services {
+		services[s.ID] = s
+	}
+	replicas := map[string]uint64{}
+	usedPorts := map[string]map[string]string{}
+	errors := map[string]bool{}
+	for _, t := range snap.Tasks {
+		if t.ServiceID == svc.ID && isPending(t) {
+			report.Pending++
+		}
+		if t.ServiceID == svc.ID && (taskID == "" && isPending(t) || taskID != "" && strings.HasPrefix(t.ID, taskID)) {
+			for _, msg := range []string{t.Status.Err, t.Status.Message} {
+				if msg = strings.TrimSpace(msg); msg != "" && !errors[msg] {
+					errors[msg] = true
+					report.Errors = append(report.Errors, msg)
+				}
+			}
+		}
+		if !activeOnNode(t) {
+			continue
+		}
+		if t.ServiceID == svc.ID {
+			replicas[t.NodeID]++
+		}
+		for _, p := range hostPorts(services[t.ServiceID]) {
+			if usedPorts[t.NodeID] == nil {
+				usedPorts[t.NodeID] = map[string]string{}
+			}
+			name := services[t.ServiceID].Spec.Name
+			if t.Slot != 0 {
+				name = fmt.Sprintf("%s.%d", name, t.Slot)
+			}
+			usedPorts[t.NodeID][p] = name
+		}
+	}
+
+	resources := snap.NodeResources()
+	for _, n := range snap.Nodes {
+		p := NodePlacement{NodeID: n.ID, Hostname: n.Description.Hostname}
+
+		if n.Status.State != swarm.NodeStateReady {
+			p.Reasons = append(p.Reasons, "node is "+string(n.Status.State))
+		}
+		if n.Spec.Availability != "" && n.Spec.Availability != swarm.NodeAvailabilityActive {
+			p.Reasons = append(p.Reasons, "availability is "+string(n.Spec.Availability))
+		}
+
+		for _, c := range constraints {
+			ok, err := c.matches(n)
+			switch {
+			case err != nil:
+				p.Reasons = append(p.Reasons, err.Error())
+			case !ok:
+				p.Reasons = append(p.Reasons, c.expr+" not satisfied")
+			}
+		}
+
+		if len(placement.Platforms) > 0 {
+			os, arch := n.Description.Platform.OS, normalizeArch(n.Description.Platform.Architecture)
+			fits := false
+			for _, want := range placement.Platforms {
+				if (want.OS == "" || strings.EqualFold(want.OS, os)) &&
+					(want.Architecture == "" || normalizeArch(want.Architecture) == arch) {
+					fits = true
+					break
+				}
+			}
+			if !fits {
+				p.Reasons = append(p.Reasons, fmt.Sprintf("platform %s/%s not supported by the image", os, arch))
+			}
+		}
+
+		if limit := placement.MaxReplicas; limit > 0 && replicas[n.ID] >= limit {
+			p.Reasons = append(p.Reasons, fmt.Sprintf("already runs %d of max %d replicas", replicas[n.ID], limit))
+		}
+
+		r := resources[n.ID]
+		if free := r.CPU.Capacity - r.CPU.Reserved; reserved.NanoCPUs > free {
+			p.Reasons = append(p.Reasons, fmt.Sprintf("needs %s CPUs, %s free", formatCPUs(reserved.NanoCPUs), formatCPUs(max(free, 0))))
+		}
+		if free := r.Memory.Capacity - r.Memory.Reserved; reserved.MemoryBytes > free {
+			p.Reasons = append(p.Reasons, fmt.Sprintf("needs %s memory, %s free", formatMemory(reserved.MemoryBytes), formatMemory(max(free, 0))))
+		}
+		for _, kind := range kinds {
+			need, g := neededGeneric[kind], r.Generic[kind]
+			if free := g.Capacity - g.Reserved; need > free {
+				p.Reasons = append(p.Reasons, fmt.Sprintf("needs %d %s, %d free", need, kind, max(free, 0)))
+			}
+		}
+
+		for _, port := range ports {
+			if holder, ok := usedPorts[n.ID][port]; ok {
+				p.Reasons = append(p.Reasons, fmt.Sprintf("host port %s used by %s", port, holder))
+			}
+		}
+
+		var spread []string
+		for _, d := range spreads {
+			value, ok, err := nodeValue(n, d)
+			if err != nil || !ok {
+				value = "-"
+			}
+			name, found := cutPrefixFold(d, "node.labels.")
+			if !found {
+				name, _ = cutPrefixFold(d, "engine.labels.")
+			}
+			spread = append(spread, name+"="+value)
+		}
+		p.Spread = strings.Join(spread, ",")
+
+		report.Nodes = append(report.Nodes, p)
+	}
+
+	sort.SliceStable(report.Nodes, func(i, j int) bool {
+		a, b := report.Nodes[i], report.Nodes[j]
+		if a.Eligible() != b.Eligible() {
+			return a.Eligible()
+		}
+		return a.Hostname < b.Hostname
+	})
+	return report, nil
+}
@@ -0,0 +1,312 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/docker/docker/api/types/swarm"
+)
+
+const gib = 1 << 30
+
+func testNode(id string, role swarm.NodeRole, arch string, cpus, memory int64, labels map[string]string) swarm.Node {
+	n := swarm.Node{ID: id}
+	n.Spec.Role = role
+	n.Spec.Availability = swarm.NodeAvailabilityActive
+	n.Spec.Labels = labels
+	n.Status.State = swarm.NodeStateReady
+	n.Description.Hostname = id
+	n.Description.Platform = swarm.Platform{OS: "linux", Architecture: arch}
+	n.Description.Resources = swarm.Resources{NanoCPUs: cpus * 1e9, MemoryBytes: memory}
+	n.Description.Engine.Labels = map[string]string{"storage": "ssd"}
+	return n
+}
+
+func runningTask(id, serviceID, nodeID string, slot int, reserved *swarm.Resources) swarm.Task {
+	t := swarm.Task{ID: id, ServiceID: serviceID, NodeID: nodeID, Slot: slot, DesiredState: swarm.TaskStateRunning}
+	t.Status.State = swarm.TaskStateRunning
+	if reserved != nil {
+		t.Spec.Resources = &swarm.ResourceRequirements{Reservations: reserved}
+	}
+	return t
+}
+
+func hostPort(port uint32) *swarm.EndpointSpec {
+	return &swarm.EndpointSpec{Ports: []swarm.PortConfig{{
+		Protocol: swarm.PortConfigProtocolTCP, PublishedPort: port, PublishMode: swarm.PortConfigPublishModeHost,
+	}}}
+}
+
+// testSnapshot has a worker (w1: 2 CPUs, 4GiB, a gpu, zone eu-1, running
+// proxy.1 which reserves 0.5 CPUs and 1GiB and holds host port 80) and a
+// manager (m1: arm, 1 CPU, 1GiB, zone us-1, running web.1).
+func testSnapshot(web swarm.ServiceSpec) *SwarmSnapshot {
+	w1 := testNode("w1", swarm.NodeRoleWorker, "x86_64", 2, 4*gib, map[string]string{"zone": "eu-1"})
+	w1.Description.Resources.GenericResources = []swarm.GenericResource{
+		{DiscreteResourceSpec: &swarm.DiscreteGenericResource{Kind: "gpu", Value: 1}},
+	}
+	m1 := testNode("m1", swarm.NodeRoleManager, "aarch64", 1, 1*gib, map[string]string{"zone": "us-1"})
+
+	web.Name = "web"
+	proxy := swarm.ServiceSpec{Annotations: swarm.Annotations{Name: "proxy"}, EndpointSpec: hostPort(80)}
+	return &SwarmSnapshot{
+		Nodes: []swarm.Node{w1, m1},
+		Services: []swarm.Service{
+			{ID: "web", Spec: web},
+			{ID: "proxy", Spec: proxy},
+		},
+		Tasks: []swarm.Task{
+			runningTask("proxy1", "proxy", "w1", 1, &swarm.Resources{NanoCPUs: 5e8, MemoryBytes: 1 * gib}),
+			runningTask("web1", "web", "m1", 1, nil),
+		},
+	}
+}
+
+func TestParseConstraint(t *testing.T) {
+	tests := []struct {
+		expr    string
+		want    placementConstraint
+		wantErr bool
+	}{
+		{"node.role==worker", placementConstraint{"node.role==worker", "node.role", "worker", true}, false},
+		{" node.labels.zone != eu-1 ", placementConstraint{"node.labels.zone != eu-1", "node.labels.zone", "eu-1", false}, false},
+		{"node.role=worker", placementConstraint{}, true},
+		{"node.role", placementConstraint{}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := parseConstraint(tt.expr)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseConstraint(%q) = %+v, want an error", tt.expr, got)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("parseConstraint(%q) = %+v, %v, want %+v", tt.expr, got, err, tt.want)
+			}
+		})
+	}
+}
+
+func TestNodeValue(t *testing.T) {
+	n := testNode("w1", swarm.NodeRoleWorker, "x86_64", 2, 4*gib, map[string]string{"zone": "eu-1"})
+	tests := []struct {
+		key     string
+		want    string
+		wantOK  bool
+		wantErr bool
+	}{
+		{"node.id", "w1", true, false},
+		{"node.hostname", "w1", true, false},
+		{"Node.Role", "worker", true, false},
+		{"node.platform.os", "linux", true, false},
+		{"node.platform.arch", "x86_64", true, false},
+		{"node.labels.zone", "eu-1", true, false},
+		{"Node.Labels.zone", "eu-1", true, false},
+		{"node.labels.Zone", "", false, false},
+		{"node.labels.rack", "", false, false},
+		{"engine.labels.storage", "ssd", true, false},
+		{"ENGINE.LABELS.storage", "ssd", true, false},
+		{"node.labels", "", false, true},
+		{"node.ip", "", false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			got, ok, err := nodeValue(n, tt.key)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("nodeValue(%q) error = %v, wantErr %v", tt.key, err, tt.wantErr)
+			}
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("nodeValue(%q) = %q, %v, want %q, %v", tt.key, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestExplainPlacement(t *testing.T) {
+	tests := []struct {
+		name string
+		edit func(*swarm.ServiceSpec)
+		// want holds the reasons of each node, by hostname
+		want map[string][]string
+	}{
+		{
+			name: "no requirements",
+			edit: func(*swarm.ServiceSpec) {},
+			want: map[string][]string{"w1": nil, "m1": nil},
+		},
+		{
+			name: "equality constraint",
+			edit: withConstraints("node.role==worker"),
+			want: map[string][]string{"w1": nil, "m1": {"node.role==worker not satisfied"}},
+		},
+		{
+			name: "inequality constraint",
+			edit: withConstraints("node.labels.zone!=eu-1"),
+			want: map[string][]string{"w1": {"node.labels.zone!=eu-1 not satisfied"}, "m1": nil},
+		},
+		{
+			name: "constraint prefix and value ignore case",
+			edit: withConstraints("Node.Labels.zone==EU-1"),
+			want: map[string][]string{"w1": nil, "m1": {"Node.Labels.zone==EU-1 not satisfied"}},
+		},
+		{
+			name: "missing label only satisfies inequality",
+			edit: withConstraints("node.labels.rack!=r1", "node.labels.rack==r1"),
+			want: map[string][]string{
+				"w1": {"node.labels.rack==r1 not satisfied"},
+				"m1": {"node.labels.rack==r1 not satisfied"},
+			},
+		},
+		{
+			name: "unsupported constraint key",
+			edit: withConstraints("node.ip==10.0.0.1"),
+			want: map[string][]string{
+				"w1": {`unsupported constraint key "node.ip"`},
+				"m1": {`unsupported constraint key "node.ip"`},
+			},
+		},
+		{
+			name: "platform mismatch",
+			edit: withPlacement(func(p *swarm.Placement) { p.Platforms = []swarm.Platform{{OS: "linux", Architecture: "amd64"}} }),
+			want: map[string][]string{"w1": nil, "m1": {"platform linux/arm64 not supported by the image"}},
+		},
+		{
+			name: "max replicas per node",
+			edit: withPlacement(func(p *swarm.Placement) { p.MaxReplicas = 1 }),
+			want: map[string][]string{"w1": nil, "m1": {"already runs 1 of max 1 replicas"}},
+		},
+		{
+			name: "CPU shortfall counts what tasks reserve",
+			edit: withReservations(swarm.Resources{NanoCPUs: 1.75e9}),
+			want: map[string][]string{"w1": {"needs 1.75 CPUs, 1.50 free"}, "m1": {"needs 1.75 CPUs, 1.00 free"}},
+		},
+		{
+			name: "memory shortfall",
+			edit: withReservations(swarm.Resources{MemoryBytes: 2 * gib}),
+			want: map[string][]string{"w1": nil, "m1": {"needs 2.0GiB memory, 1.0GiB free"}},
+		},
+		{
+			name: "generic resource shortfall",
+			edit: withReservations(swarm.Resources{GenericResources: []swarm.GenericResource{
+				{DiscreteResourceSpec: &swarm.DiscreteGenericResource{Kind: "gpu", Value: 1}},
+			}}),
+			want: map[string][]string{"w1": nil, "m1": {"needs 1 gpu, 0 free"}},
+		},
+		{
+			name: "host port held by another service or an own replica",
+			edit: func(s *swarm.ServiceSpec) { s.EndpointSpec = hostPort(80) },
+			want: map[string][]string{"w1": {"host port 80/tcp used by proxy.1"}, "m1": {"host port 80/tcp used by web.1"}},
+		},
+		{
+			name: "free host port",
+			edit: func(s *swarm.ServiceSpec) { s.EndpointSpec = hostPort(8080) },
+			want: map[string][]string{"w1": nil, "m1": {"host port 8080/tcp used by web.1"}},
+		},
+		{
+			name: "every failing filter is listed",
+			edit: func(s *swarm.ServiceSpec) {
+				withConstraints("node.role==worker")(s)
+				withReservations(swarm.Resources{MemoryBytes: 2 * gib})(s)
+			},
+			want: map[string][]string{"w1": nil, "m1": {"node.role==worker not satisfied", "needs 2.0GiB memory, 1.0GiB free"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var spec swarm.ServiceSpec
+			tt.edit(&spec)
+			report, err := ExplainPlacement(testSnapshot(spec), "web", "")
+			if err != nil {
+				t.Fatalf("ExplainPlacement failed: %v", err)
+			}
+			if len(report.Nodes) != len(tt.want) {
+				t.Fatalf("report has %d nodes, want %d", len(report.Nodes), len(tt.want))
+			}
+			for _, p := range report.Nodes {
+				if want := tt.want[p.Hostname]; !slices.Equal(p.Reasons, want) {
+					t.Errorf("%s reasons = %q, want %q", p.Hostname, p.Reasons, want)
+				}
+			}
+		})
+	}
+}
+
+func TestExplainPlacementReport(t *testing.T) {
+	var spec swarm.ServiceSpec
+	withConstraints("node.role==manager")(&spec)
+	withPlacement(func(p *swarm.Placement) {
+		p.Preferences = []swarm.PlacementPreference{{Spread: &swarm.SpreadOver{SpreadDescriptor: "Node.Labels.zone"}}}
+	})(&spec)
+	snap := testSnapshot(spec)
+	pending := swarm.Task{ID: "web2", ServiceID: "web", Slot: 2, DesiredState: swarm.TaskStateRunning}
+	pending.Status = swarm.TaskStatus{State: swarm.TaskStatePending, Err: "no suitable node"}
+	snap.Tasks = append(snap.Tasks, pending)
+	down := testNode("d1", swarm.NodeRoleManager, "x86_64", 1, gib, nil)
+	down.Status.State = swarm.NodeStateDown
+	drained := testNode("a1", swarm.NodeRoleManager, "x86_64", 1, gib, nil)
+	drained.Spec.Availability = swarm.NodeAvailabilityDrain
+	snap.Nodes = append(snap.Nodes, down, drained)
+
+	report, err := ExplainPlacement(snap, "web", "")
+	if err != nil {
+		t.Fatalf("ExplainPlacement failed: %v", err)
+	}
+	if want := []string{"constraint node.role==manager", "spread over Node.Labels.zone"}; !slices.Equal(report.Requirements, want) {
+		t.Errorf("Requirements = %q, want %q", report.Requirements, want)
+	}
+	if report.Pending != 1 || !slices.Equal(report.Errors, []string{"no suitable node"}) {
+		t.Errorf("Pending = %d, Errors = %q, want 1 and the pending task's error", report.Pending, report.Errors)
+	}
+	if report.Eligible() != 1 {
+		t.Errorf("Eligible() = %d, want 1", report.Eligible())
+	}
+
+	// Eligible nodes first, then by hostname
+	var order, spreads []string
+	for _, p := range report.Nodes {
+		order = append(order, p.Hostname)
+		spreads = append(spreads, p.Spread)
+	}
+	if want := []string{"m1", "a1", "d1", "w1"}; !slices.Equal(order, want) {
+		t.Errorf("node order = %q, want %q", order, want)
+	}
+	if got, want := report.Nodes[1].Reasons, []string{"availability is drain"}; !slices.Equal(got, want) {
+		t.Errorf("a1 reasons = %q, want %q", got, want)
+	}
+	if got, want := report.Nodes[2].Reasons, []string{"node is down"}; !slices.Equal(got, want) {
+		t.Errorf("d1 reasons = %q, want %q", got, want)
+	}
+	if want := []string{"zone=us-1", "zone=-", "zone=-", "zone=eu-1"}; !slices.Equal(spreads, want) {
+		t.Errorf("spreads = %q, want %q", spreads, want)
+	}
+}
+
+func TestExplainPlacementUnknownService(t *testing.T) {
+	if _, err := ExplainPlacement(testSnapshot(swarm.ServiceSpec{}), "db", ""); err == nil {
+		t.Error("ExplainPlacement of an unknown service succeeded, want an error")
+	}
+}
+
+func withPlacement(edit func(*swarm.Placement)) func(*swarm.ServiceSpec) {
+	return func(s *swarm.ServiceSpec) {
+		if s.TaskTemplate.Placement == nil {
+			s.TaskTemplate.Placement = &swarm.Placement{}
+		}
+		edit(s.TaskTemplate.Placement)
+	}
+}
+
+func withConstraints(constraints ...string) func(*swarm.ServiceSpec) {
+	return withPlacement(func(p *swarm.Placement) { p.Constraints = append(p.Constraints, constraints...) })
+}
+
+func withReservations(r swarm.Resources) func(*swarm.ServiceSpec) {
+	return func(s *swarm.ServiceSpec) {
+		s.TaskTemplate.Resources = &swarm.ResourceRequirements{Reservations: &r}
+	}
+}
@@ -6,6 +6,7 @@ package docker
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -100,8 +101,18 @@ func GetSwarmMemCapacity() (int64, error) {
 	return totalMem, nil
 }
 
-// GetSwarmCPUUsage returns actual CPU usage across running containers.
+// GetSwarmCPUUsage returns actual CPU usage across running containers. It
+// covers the whole cluster when the stats collector is deployed, otherwise
+// only the daemon of the current context.
 func GetSwarmCPUUsage() (string, error) {
+	if stats, err := ClusterStats(); err == nil {
+		result := fmt.Sprintf("%.1f%%", stats.Total().CPU)
+		l().Infof("GetSwarmCPUUsage: Cluster result: %s (from %d containers)", result, len(stats.Containers))
+		return result, nil
+	} else if !errors.Is(err, ErrNoStatsCollector) {
+		l().Infof("GetSwarmCPUUsage: ClusterStats error, using local daemon: %v", err)
+	}
+
 	c, err := GetClient()
 	if err != nil {
 		l().Infof("GetSwarmCPUUsage: GetClient error: %v", err)
@@ -194,7 +205,9 @@ func GetSwarmCPUUsage() (string, error) {
 	return result, nil
 }
 
-// GetSwarmMemUsage returns actual memory usage across running containers.
+// GetSwarmMemUsage returns actual memory usage across running containers. It
+// covers the whole cluster when the stats collector is deployed, otherwise
+// only the daemon of the current context.
 func GetSwarmMemUsage() (string, error) {
 	c, err := GetClient()
 	if err != nil {
@@ -209,6 +222,15 @@ func GetSwarmMemUsage() (string, error) {
 		return "N/A", err
 	}
 
+	if stats, err := ClusterStats(); err == nil {
+		used := stats.Total().MemUsed
+		result := fmt.Sprintf("%.1f%%", float64(used)/float64(totalCapacity)*100.0)
+		l().Infof("GetSwarmMemUsage: Cluster result: %s (from %d containers)", result, len(stats.Containers))
+		return result, nil
+	} else if !errors.Is(err, ErrNoStatsCollector) {
+		l().Infof("GetSwarmMemUsage: ClusterStats error, using local daemon: %v", err)
+	}
+
 	ctx := context.Background()
 	containers, err := c.ContainerList(ctx, container.ListOptions{})
 	if err != nil {
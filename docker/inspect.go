@@ -19,11 +19,12 @@ const (
 	InspectService   InspectType = "service"
 	InspectContainer InspectType = "container"
 	InspectStack     InspectType = "stack"
+	InspectTask      InspectType = "task"
 )
 
 // Inspect fetches and returns structured JSON for any Docker object.
 func Inspect(ctx context.Context, t InspectType, id string) (string, error) {
-	cli, err := GetClient()
+	cli, err := clientFor(ctx)
 	if err != nil {
 		return "", fmt.Errorf("docker client: %w", err)
 	}
@@ -52,6 +53,13 @@ func Inspect(ctx context.Context, t InspectType, id string) (string, error) {
 		}
 		obj = ctr
 
+	case InspectTask:
+		task, _, err := cli.TaskInspectWithRaw(ctx, id)
+		if err != nil {
+			return "", fmt.Errorf("task inspect: %w", err)
+		}
+		obj = task
+
 	case InspectStack:
 		// Fetch all services and filter by stack label
 		services, err := cli.ServiceList(ctx, swarm.ServiceListOptions{})
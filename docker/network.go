@@ -15,7 +15,7 @@ import (
 
 // ListNetworks returns all networks in the swarm
 func ListNetworks(ctx context.Context) ([]network.Summary, error) {
-	client, err := GetClient()
+	client, err := clientFor(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -24,7 +24,7 @@ func ListNetworks(ctx context.Context) ([]network.Summary, error) {
 
 // InspectNetwork returns detailed information about a network
 func InspectNetwork(ctx context.Context, networkID string) (network.Inspect, error) {
-	client, err := GetClient()
+	client, err := clientFor(ctx)
 	if err != nil {
 		return network.Inspect{}, err
 	}
@@ -33,7 +33,7 @@ func InspectNetwork(ctx context.Context, networkID string) (network.Inspect, err
 
 // RemoveNetwork removes a network
 func RemoveNetwork(ctx context.Context, networkID string) error {
-	client, err := GetClient()
+	client, err := clientFor(ctx)
 	if err != nil {
 		return err
 	}
@@ -43,7 +43,7 @@ func RemoveNetwork(ctx context.Context, networkID string) error {
 // CreateNetwork creates a new Docker network.
 // Returns the created network ID and any daemon warnings.
 func CreateNetwork(ctx context.Context, name string, opts network.CreateOptions) (string, []string, error) {
-	client, err := GetClient()
+	client, err := clientFor(ctx)
 	if err != nil {
 		return "", nil, err
 	}
@@ -63,7 +63,7 @@ func CreateNetwork(ctx context.Context, name string, opts network.CreateOptions)
 
 // PruneNetworks removes all unused networks
 func PruneNetworks(ctx context.Context) (network.PruneReport, error) {
-	client, err := GetClient()
+	client, err := clientFor(ctx)
 	if err != nil {
 		return network.PruneReport{}, err
 	}
@@ -74,7 +74,7 @@ func PruneNetworks(ctx context.Context) (network.PruneReport, error) {
 // ListServicesUsingNetwork returns all services that are connected to a network.
 // In Swarm, service network targets can be specified by ID or by name.
 func ListServicesUsingNetwork(ctx context.Context, networkID, networkName string) ([]string, error) {
-	client, err := GetClient()
+	client, err := clientFor(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -86,16 +86,24 @@ func ListServicesUsingNetwork(ctx context.Context, networkID, networkName string
 
 	var connectedServices []string
 	for _, svc := range services {
-		for _, net := range svc.Spec.TaskTemplate.Networks {
-			if net.Target == networkID || (networkName != "" && net.Target == networkName) {
-				connectedServices = append(connectedServices, svc.Spec.Name)
-				break
-			}
+		if serviceUsesNetwork(svc, networkID, networkName) {
+			connectedServices = append(connectedServices, svc.Spec.Name)
 		}
 	}
 	return connectedServices, nil
 }
 
+// serviceUsesNetwork reports whether a service is attached to a network,
+// which its spec targets by ID or by name.
+func serviceUsesNetwork(svc swarm.Service, networkID, networkName string) bool {
+	for _, net := range svc.Spec.TaskTemplate.Networks {
+		if net.Target == networkID || (networkName != "" && net.Target == networkName) {
+			return true
+		}
+	}
+	return false
+}
+
 // NetworkWithUsage is a helper struct that includes usage information
 type NetworkWithUsage struct {
 	Network  network.Summary
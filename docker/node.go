@@ -31,7 +31,7 @@ func GetNodeIDToHostnameMapFromDocker() (map[string]string, error) {
 
 // DemoteNode sets the node role to worker (demotes a manager).
 func DemoteNode(ctx context.Context, nodeID string) error {
-	c, err := GetClient()
+	c, err := clientFor(ctx)
 	if err != nil {
 		return err
 	}
@@ -56,7 +56,7 @@ func DemoteNode(ctx context.Context, nodeID string) error {
 
 // PromoteNode sets the node role to manager (promotes a worker).
 func PromoteNode(ctx context.Context, nodeID string) error {
-	c, err := GetClient()
+	c, err := clientFor(ctx)
 	if err != nil {
 		return err
 	}
@@ -81,7 +81,7 @@ func PromoteNode(ctx context.Context, nodeID string) error {
 
 // SetNodeAvailability sets the availability of a node (active, pause, drain).
 func SetNodeAvailability(ctx context.Context, nodeID string, availability swarm.NodeAvailability) error {
-	c, err := GetClient()
+	c, err := clientFor(ctx)
 	if err != nil {
 		return err
 	}
@@ -106,7 +106,7 @@ func SetNodeAvailability(ctx context.Context, nodeID string, availability swarm.
 
 // AddNodeLabel adds or updates a label on a node.
 func AddNodeLabel(ctx context.Context, nodeID string, key string, value string) error {
-	c, err := GetClient()
+	c, err := clientFor(ctx)
 	if err != nil {
 		return err
 	}
@@ -134,7 +134,7 @@ func AddNodeLabel(ctx context.Context, nodeID string, key string, value string)
 
 // RemoveNodeLabel removes a label from a node
 func RemoveNodeLabel(ctx context.Context, nodeID string, key string) error {
-	c, err := GetClient()
+	c, err := clientFor(ctx)
 	if err != nil {
 		return err
 	}
@@ -161,7 +161,7 @@ func RemoveNodeLabel(ctx context.Context, nodeID string, key string) error {
 
 // RemoveNode removes a node from the swarm.
 func RemoveNode(ctx context.Context, nodeID string, force bool) error {
-	c, err := GetClient()
+	c, err := clientFor(ctx)
 	if err != nil {
 		return err
 	}
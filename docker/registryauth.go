@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/distribution/reference"
+	"github.com/docker/docker/api/types/registry"
+)
+
+// dockerHubAuthKey is the key the docker CLI stores Docker Hub logins under.
+const dockerHubAuthKey = "https://index.docker.io/v1/"
+
+// dockerConfigFile is the part of the docker CLI's config.json holding
+// registry logins.
+type dockerConfigFile struct {
+	Auths map[string]struct {
+		Auth          string `json:"auth"`
+		IdentityToken string `json:"identitytoken"`
+	} `json:"auths"`
+	CredsStore  string            `json:"credsStore"`
+	CredHelpers map[string]string `json:"credHelpers"`
+}
+
+func dockerConfigPath() string {
+	if dir := os.Getenv("DOCKER_CONFIG"); dir != "" {
+		return filepath.Join(dir, "config.json")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".docker", "config.json")
+}
+
+// RegistryAuth encodes the login `docker login` stored for the registry of
+// image, to send along with a service like `--with-registry-auth`. It
+// returns "" when there is none.
+func RegistryAuth(image string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", fmt.Errorf("invalid image %q: %w", image, err)
+	}
+	host := reference.Domain(named)
+	key := host
+	if host == "docker.io" {
+		key = dockerHubAuthKey
+	}
+
+	data, err := os.ReadFile(dockerConfigPath())
+	if errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	var cfg dockerConfigFile
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return "", fmt.Errorf("parsing %s: %w", dockerConfigPath(), err)
+	}
+
+	auth := registry.AuthConfig{ServerAddress: key}
+	helper := cfg.CredHelpers[host]
+	if helper == "" {
+		helper = cfg.CredsStore
+	}
+	if helper != "" {
+		found, err := credentialsFromHelper(helper, key, &auth)
+		if err != nil || !found {
+			return "", err
+		}
+		return registry.EncodeAuthConfig(auth)
+	}
+
+	entry, ok := cfg.Auths[key]
+	if !ok {
+		entry, ok = cfg.Auths["https://"+host]
+	}
+	if !ok {
+		return "", nil
+	}
+	if entry.Auth != "" {
+		raw, err := base64.StdEncoding.DecodeString(entry.Auth)
+		if err != nil {
+			return "", fmt.Errorf("invalid login for %s: %w", key, err)
+		}
+		auth.Username, auth.Password, _ = strings.Cut(string(raw), ":")
+	}
+	auth.IdentityToken = entry.IdentityToken
+	return registry.EncodeAuthConfig(auth)
+}
+
+// credentialsFromHelper asks a docker-credential-* helper for the login of
+// a registry; found is false when it has none.
+func credentialsFromHelper(helper, serverURL string, auth *registry.AuthConfig) (found bool, err error) {
+	cmd := exec.Command("docker-credential-"+helper, "get")
+	cmd.Stdin = strings.NewReader(serverURL)
+	out, err := cmd.Output()
+	if err != nil {
+		if strings.Contains(string(out), "credentials not found") {
+			return false, nil
+		}
+		return false, fmt.Errorf("docker-credential-%s: %w", helper, err)
+	}
+	var creds struct {
+		Username string `json:"Username"`
+		Secret   string `json:"Secret"`
+	}
+	if err := json.Unmarshal(out, &creds); err != nil {
+		return false, fmt.Errorf("docker-credential-%s: %w", helper, err)
+	}
+	if creds.Username == "<token>" {
+		auth.IdentityToken = creds.Secret
+	} else {
+		auth.Username, auth.Password = creds.Username, creds.Secret
+	}
+	return true, nil
+}
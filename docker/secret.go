@@ -19,7 +19,7 @@ import (
 
 // ListServicesUsingSecretID returns all services that reference a secret by ID
 func ListServicesUsingSecretID(ctx context.Context, secretID string) ([]swarm.Service, error) {
-	client, err := GetClient()
+	client, err := clientFor(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +68,7 @@ func (sec *SecretWithDecodedData) PrettyJSON() ([]byte, error) {
 func ListSecrets(ctx context.Context) ([]swarm.Secret, error) {
 	l().Debug("[ListSecrets] Listing all secrets")
 
-	cli, err := GetClient()
+	cli, err := clientFor(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -106,7 +106,7 @@ func ListSecrets(ctx context.Context) ([]swarm.Secret, error) {
 func InspectSecret(ctx context.Context, nameOrID string) (*SecretWithDecodedData, error) {
 	l().Debugf("[InspectSecret] Inspecting secret: %s", nameOrID)
 
-	cli, err := GetClient()
+	cli, err := clientFor(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -168,7 +168,7 @@ func CreateSecret(ctx context.Context, name string, data []byte, labels map[stri
 // and returns the populated swarm.Secret or an error. The caller may pass a
 // prefix for logging context (e.g. "[CreateSecretVersion]").
 func createSecretWithSpec(ctx context.Context, spec swarm.SecretSpec, logPrefix string) (swarm.Secret, error) {
-	cli, err := GetClient()
+	cli, err := clientFor(ctx)
 	if err != nil {
 		return swarm.Secret{}, err
 	}
@@ -199,7 +199,7 @@ func RotateSecretInServices(ctx context.Context, oldSec *swarm.Secret, newSec sw
 		return fmt.Errorf("new secret must have a valid ID")
 	}
 
-	client, err := GetClient()
+	client, err := clientFor(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get docker client: %w", err)
 	}
@@ -250,7 +250,7 @@ func DeleteSecret(ctx context.Context, nameOrID string) error {
 		return err
 	}
 
-	cli, err := GetClient()
+	cli, err := clientFor(ctx)
 	if err != nil {
 		return err
 	}
@@ -291,11 +291,8 @@ func listServicesUsingSecret(ctx context.Context, client *client.Client, secretI
 	}
 	var filtered []swarm.Service
 	for _, s := range services {
-		for _, sec := range s.Spec.TaskTemplate.ContainerSpec.Secrets {
-			if sec.SecretID == secretID {
-				filtered = append(filtered, s)
-				break
-			}
+		if serviceUsesSecret(s, secretID, "") {
+			filtered = append(filtered, s)
 		}
 	}
 	return filtered, nil
@@ -303,7 +300,7 @@ func listServicesUsingSecret(ctx context.Context, client *client.Client, secretI
 
 // ListServicesUsingSecretName returns all services that reference a secret by name
 func ListServicesUsingSecretName(ctx context.Context, name string) ([]swarm.Service, error) {
-	client, err := GetClient()
+	client, err := clientFor(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -317,12 +314,24 @@ func listServicesUsingSecretName(ctx context.Context, client *client.Client, nam
 	}
 	var filtered []swarm.Service
 	for _, s := range services {
-		for _, sec := range s.Spec.TaskTemplate.ContainerSpec.Secrets {
-			if sec.SecretName == name {
-				filtered = append(filtered, s)
-				break
-			}
+		if serviceUsesSecret(s, "", name) {
+			filtered = append(filtered, s)
 		}
 	}
 	return filtered, nil
 }
+
+// serviceUsesSecret reports whether a service references a secret by ID or
+// by name; an empty ID or name matches nothing.
+func serviceUsesSecret(s swarm.Service, id, name string) bool {
+	cs := s.Spec.TaskTemplate.ContainerSpec
+	if cs == nil {
+		return false
+	}
+	for _, sec := range cs.Secrets {
+		if (id != "" && sec.SecretID == id) || (name != "" && sec.SecretName == name) {
+			return true
+		}
+	}
+	return false
+}
@@ -95,16 +95,25 @@ func restartServiceCommon(ctx context.Context, c *client.Client, svc *swarm.Serv
 // ─── Public API ─────────────────────────────────────────────────────────────────
 //
 
+// ListServices lists the services of the swarm.
+func ListServices(ctx context.Context) ([]swarm.Service, error) {
+	c, err := clientFor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	return c.ServiceList(ctx, swarm.ServiceListOptions{})
+}
+
 // ScaleService updates the replica count of a service by ID.
-func ScaleService(serviceID string, replicas uint64) error {
-	c, err := GetClient()
+func ScaleService(ctx context.Context, serviceID string, replicas uint64) error {
+	c, err := clientFor(ctx)
 	if err != nil {
 		return fmt.Errorf("docker client: %w", err)
 	}
 	defer closeCli(c)
 
-	ctx := context.Background()
-
 	svc, _, err := c.ServiceInspectWithRaw(ctx, serviceID, swarm.ServiceInspectOptions{})
 	if err != nil {
 		return fmt.Errorf("inspect service %s: %w", serviceID, err)
@@ -129,14 +138,13 @@ func ScaleServiceByName(serviceName string, replicas uint64) error {
 }
 
 // RestartService performs a rolling restart (like `docker service update --force`).
-func RestartService(serviceName string) error {
-	c, err := GetClient()
+func RestartService(ctx context.Context, serviceName string) error {
+	c, err := clientFor(ctx)
 	if err != nil {
 		return fmt.Errorf("docker client: %w", err)
 	}
 	defer closeCli(c)
 
-	ctx := context.Background()
 	svc, err := findServiceByName(ctx, c, serviceName)
 	if err != nil {
 		return err
@@ -145,14 +153,13 @@ func RestartService(serviceName string) error {
 }
 
 // RemoveService removes a service by name.
-func RemoveService(serviceName string) error {
-	c, err := GetClient()
+func RemoveService(ctx context.Context, serviceName string) error {
+	c, err := clientFor(ctx)
 	if err != nil {
 		return fmt.Errorf("docker client: %w", err)
 	}
 	defer closeCli(c)
 
-	ctx := context.Background()
 	svc, err := findServiceByName(ctx, c, serviceName)
 	if err != nil {
 		return err
@@ -167,14 +174,13 @@ func RemoveService(serviceName string) error {
 }
 
 // RollbackService rolls back a service to its previous configuration.
-func RollbackService(serviceName string) error {
-	c, err := GetClient()
+func RollbackService(ctx context.Context, serviceName string) error {
+	c, err := clientFor(ctx)
 	if err != nil {
 		return fmt.Errorf("docker client: %w", err)
 	}
 	defer closeCli(c)
 
-	ctx := context.Background()
 	svc, err := findServiceByName(ctx, c, serviceName)
 	if err != nil {
 		return err
@@ -218,7 +224,7 @@ func RestartServiceWithProgress(ctx context.Context, serviceName string, progres
 }
 
 func restartServiceAndWaitInternal(ctx context.Context, serviceName string, progressCh chan<- ProgressUpdate) error {
-	cli, err := GetClient()
+	cli, err := clientFor(ctx)
 	if err != nil {
 		return fmt.Errorf("docker client: %w", err)
 	}
@@ -367,12 +373,32 @@ type ServiceEntry struct {
 	Mode           string
 	Image          string
 	Ports          string
+	Labels         map[string]string
+	NodeNames      []string // hostnames of nodes running the service's tasks
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
+
+	// Restarts counts the service's exited tasks (on the node, for
+	// LoadNodeServices).
+	Restarts int
+
+	// Usage summed over the measured tasks, set by AttachServiceUsage.
+	Measured int
+	CPU      float64
+	MemUsed  int64
+	// MemLimit is 0 when a measured task has no limit.
+	MemLimit int64
 }
 
+// LoadNodeServices returns the services with tasks on the node, from the
+// snapshot of the active context.
 func LoadNodeServices(nodeID string) []ServiceEntry {
-	snap, err := GetOrRefreshSnapshot()
+	return LoadNodeServicesFor(activeKey(), nodeID)
+}
+
+// LoadNodeServicesFor is LoadNodeServices for the named context.
+func LoadNodeServicesFor(ctxName, nodeID string) []ServiceEntry {
+	snap, err := GetOrRefreshSnapshotFor(ctxName)
 	if err != nil {
 		l().Infof("failed to get snapshot:", err)
 		return nil
@@ -401,8 +427,11 @@ func LoadNodeServices(nodeID string) []ServiceEntry {
 			Mode:           getServiceMode(svc),
 			Image:          getServiceImage(svc),
 			Ports:          getServicePorts(svc),
+			Labels:         svc.Spec.Labels,
+			NodeNames:      serviceNodeNames(svc.ID, snap),
 			CreatedAt:      svc.CreatedAt,
 			UpdatedAt:      svc.UpdatedAt,
+			Restarts:       countRestarts(svc.ID, nodeID, snap),
 		})
 	}
 
@@ -410,8 +439,15 @@ func LoadNodeServices(nodeID string) []ServiceEntry {
 	return entries
 }
 
+// LoadStackServices returns the services of the stack, from the snapshot of
+// the active context.
 func LoadStackServices(stackName string) []ServiceEntry {
-	snap, err := GetOrRefreshSnapshot()
+	return LoadStackServicesFor(activeKey(), stackName)
+}
+
+// LoadStackServicesFor is LoadStackServices for the named context.
+func LoadStackServicesFor(ctxName, stackName string) []ServiceEntry {
+	snap, err := GetOrRefreshSnapshotFor(ctxName)
 	if err != nil {
 		l().Infof("failed to get snapshot:", err)
 		return nil
@@ -438,8 +474,11 @@ func LoadStackServices(stackName string) []ServiceEntry {
 			Mode:           getServiceMode(svc),
 			Image:          getServiceImage(svc),
 			Ports:          getServicePorts(svc),
+			Labels:         svc.Spec.Labels,
+			NodeNames:      serviceNodeNames(svc.ID, snap),
 			CreatedAt:      svc.CreatedAt,
 			UpdatedAt:      svc.UpdatedAt,
+			Restarts:       countRestarts(svc.ID, "", snap),
 		})
 	}
 
@@ -485,6 +524,30 @@ func countTasksForNode(serviceID, nodeID string, snap *SwarmSnapshot) int {
 	return count
 }
 
+// serviceNodeNames returns the sorted, de-duplicated hostnames of the nodes
+// running tasks of a service.
+func serviceNodeNames(serviceID string, snap *SwarmSnapshot) []string {
+	hostnames := make(map[string]string, len(snap.Nodes))
+	for _, n := range snap.Nodes {
+		hostnames[n.ID] = n.Description.Hostname
+	}
+	seen := make(map[string]bool)
+	var names []string
+	for _, t := range snap.Tasks {
+		if t.ServiceID != serviceID || t.DesiredState != swarm.TaskStateRunning {
+			continue
+		}
+		name := hostnames[t.NodeID]
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // sortEntries sorts entries by stack name then service name
 func sortEntries(entries []ServiceEntry) {
 	sort.Slice(entries, func(i, j int) bool {
@@ -520,7 +583,7 @@ func getServiceStatus(svc swarm.Service) string {
 
 // GetServiceLogs fetches and returns the logs from a service
 func GetServiceLogs(ctx context.Context, serviceID string) (string, error) {
-	client, err := GetClient()
+	client, err := clientFor(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get docker client: %w", err)
 	}
@@ -643,7 +706,7 @@ func CreateSecretRevealServiceWithImage(serviceName, imageOverride, secretID, se
 // GetServiceTaskDiagnostics returns a human-readable summary of tasks for a service.
 // This is useful when a service produces no logs (e.g., image pull errors).
 func GetServiceTaskDiagnostics(ctx context.Context, serviceID string) (string, error) {
-	cli, err := GetClient()
+	cli, err := clientFor(ctx)
 	if err != nil {
 		return "", fmt.Errorf("docker client: %w", err)
 	}
@@ -685,7 +748,7 @@ func GetServiceTaskDiagnostics(ctx context.Context, serviceID string) (string, e
 
 // CreateService creates a service with the given spec and returns the service ID
 func CreateService(ctx context.Context, spec swarm.ServiceSpec) (string, error) {
-	client, err := GetClient()
+	client, err := clientFor(ctx)
 	if err != nil {
 		return "", fmt.Errorf("failed to get Docker client: %w", err)
 	}
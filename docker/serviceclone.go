@@ -0,0 +1,226 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"swarmcli/core/diff"
+
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// ClonedFromLabel marks a cloned service with the name of its source.
+const ClonedFromLabel = "swarmcli.cloned-from"
+
+// stackNamespaceLabel ties a service to its stack; a clone is not part of
+// it, so `docker stack rm` leaves it alone.
+const stackNamespaceLabel = "com.docker.stack.namespace"
+
+// ServiceClone is a copy of a service waiting for its name, image,
+// replicas, ports and labels to be set.
+type ServiceClone struct {
+	Source swarm.Service
+	// Spec is the source spec, renamed, without the published ports other
+	// services use.
+	Spec swarm.ServiceSpec
+	Form ServiceCreateForm
+	// Removed lists the published ports dropped because they are taken.
+	Removed []string
+}
+
+func formatPorts(ports []swarm.PortConfig) string {
+	items := make([]string, len(ports))
+	for i, p := range ports {
+		item := strconv.FormatUint(uint64(p.TargetPort), 10)
+		if p.PublishedPort != 0 {
+			item = fmt.Sprintf("%d:%s", p.PublishedPort, item)
+		}
+		if p.Protocol != "" && p.Protocol != swarm.PortConfigProtocolTCP {
+			item += "/" + string(p.Protocol)
+		}
+		items[i] = item
+	}
+	return FormatList(items)
+}
+
+type publishedPort struct {
+	port     uint32
+	protocol swarm.PortConfigProtocol
+}
+
+func portKey(p swarm.PortConfig) publishedPort {
+	proto := p.Protocol
+	if proto == "" {
+		proto = swarm.PortConfigProtocolTCP
+	}
+	return publishedPort{p.PublishedPort, proto}
+}
+
+// cloneName returns the first of name-clone, name-clone-2, … not taken.
+func cloneName(name string, taken map[string]bool) string {
+	clone := name + "-clone"
+	for i := 2; taken[clone]; i++ {
+		clone = fmt.Sprintf("%s-clone-%d", name, i)
+	}
+	return clone
+}
+
+// newServiceClone copies the spec of src under a free name, dropping the
+// published ports in use and the stack membership.
+func newServiceClone(src swarm.Service, services []swarm.Service) (*ServiceClone, error) {
+	spec, err := copySpec(src.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("copying spec: %w", err)
+	}
+
+	taken := make(map[string]bool, len(services))
+	used := map[publishedPort]bool{}
+	for _, svc := range services {
+		taken[svc.Spec.Name] = true
+		for _, p := range svc.Endpoint.Ports {
+			if p.PublishedPort != 0 {
+				used[portKey(p)] = true
+			}
+		}
+		if svc.Spec.EndpointSpec != nil {
+			for _, p := range svc.Spec.EndpointSpec.Ports {
+				if p.PublishedPort != 0 {
+					used[portKey(p)] = true
+				}
+			}
+		}
+	}
+
+	clone := &ServiceClone{Source: src}
+	spec.Name = cloneName(src.Spec.Name, taken)
+	spec.TaskTemplate.ForceUpdate = 0
+	delete(spec.Labels, stackNamespaceLabel)
+	if cs := spec.TaskTemplate.ContainerSpec; cs != nil {
+		delete(cs.Labels, stackNamespaceLabel)
+	}
+	if ep := spec.EndpointSpec; ep != nil {
+		var kept []swarm.PortConfig
+		for _, p := range ep.Ports {
+			if p.PublishedPort != 0 && used[portKey(p)] {
+				clone.Removed = append(clone.Removed, fmt.Sprintf("%d/%s", p.PublishedPort, portKey(p).protocol))
+				continue
+			}
+			kept = append(kept, p)
+		}
+		ep.Ports = kept
+	}
+	clone.Spec = spec
+
+	clone.Form = ServiceCreateForm{
+		Name:   spec.Name,
+		Labels: FormatMap(spec.Labels),
+	}
+	if cs := spec.TaskTemplate.ContainerSpec; cs != nil {
+		clone.Form.Image = stripDigest(cs.Image)
+	}
+	if spec.Mode.Replicated != nil && spec.Mode.Replicated.Replicas != nil {
+		clone.Form.Replicas = strconv.FormatUint(*spec.Mode.Replicated.Replicas, 10)
+	}
+	if spec.EndpointSpec != nil {
+		clone.Form.Ports = formatPorts(spec.EndpointSpec.Ports)
+	}
+	return clone, nil
+}
+
+// LoadServiceClone inspects a service and prepares its copy.
+func LoadServiceClone(ctx context.Context, serviceID string) (*ServiceClone, error) {
+	c, err := clientFor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	src, _, err := c.ServiceInspectWithRaw(ctx, serviceID, swarm.ServiceInspectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("inspect service %s: %w", serviceID, err)
+	}
+	services, err := c.ServiceList(ctx, swarm.ServiceListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing services: %w", err)
+	}
+	return newServiceClone(src, services)
+}
+
+// apply returns the clone's spec with the form's values and the
+// ClonedFromLabel. Fields the form shows unchanged keep their exact value,
+// e.g. the image digest or host mode ports.
+func (c *ServiceClone) apply(f ServiceCreateForm) (swarm.ServiceSpec, error) {
+	spec, err := copySpec(c.Spec)
+	if err != nil {
+		return spec, fmt.Errorf("copying spec: %w", err)
+	}
+
+	spec.Name = strings.TrimSpace(f.Name)
+	if !serviceNamePattern.MatchString(spec.Name) {
+		return spec, fmt.Errorf("invalid name %q (letters, digits, '_', '.' and '-')", spec.Name)
+	}
+	if spec.Name == c.Source.Spec.Name {
+		return spec, fmt.Errorf("the clone needs a name of its own")
+	}
+
+	if image := strings.TrimSpace(f.Image); image != c.Form.Image {
+		if image == "" {
+			return spec, fmt.Errorf("image cannot be empty")
+		}
+		if spec.TaskTemplate.ContainerSpec == nil {
+			spec.TaskTemplate.ContainerSpec = &swarm.ContainerSpec{}
+		}
+		spec.TaskTemplate.ContainerSpec.Image = image
+	}
+
+	if replicas := strings.TrimSpace(f.Replicas); replicas != c.Form.Replicas {
+		if spec.Mode.Replicated == nil {
+			return spec, fmt.Errorf("only replicated services have a replica count")
+		}
+		n, err := strconv.ParseUint(replicas, 10, 64)
+		if err != nil {
+			return spec, fmt.Errorf("invalid replicas %q", replicas)
+		}
+		spec.Mode.Replicated.Replicas = &n
+	}
+
+	if f.Ports != c.Form.Ports {
+		ports, err := parsePorts(f.Ports)
+		if err != nil {
+			return spec, fmt.Errorf("ports: %w", err)
+		}
+		if spec.EndpointSpec == nil {
+			spec.EndpointSpec = &swarm.EndpointSpec{Mode: swarm.ResolutionModeVIP}
+		}
+		spec.EndpointSpec.Ports = ports
+	}
+
+	if f.Labels != c.Form.Labels {
+		if spec.Labels, err = parseMap(f.Labels); err != nil {
+			return spec, fmt.Errorf("labels: %w", err)
+		}
+	}
+	if spec.Labels == nil {
+		spec.Labels = map[string]string{}
+	}
+	spec.Labels[ClonedFromLabel] = c.Source.Spec.Name
+	return spec, nil
+}
+
+// PrepareServiceClone builds the spec of the clone the form describes.
+func PrepareServiceClone(clone *ServiceClone, form ServiceCreateForm) (*ServiceCreate, error) {
+	spec, err := clone.apply(form)
+	if err != nil {
+		return nil, err
+	}
+	text, err := diff.YAML(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceCreate{Spec: spec, YAML: text}, nil
+}
@@ -0,0 +1,337 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"swarmcli/core/diff"
+
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// Service modes the create form accepts, as `docker service create --mode`.
+const (
+	ModeReplicated    = "replicated"
+	ModeGlobal        = "global"
+	ModeReplicatedJob = "replicated-job"
+	ModeGlobalJob     = "global-job"
+)
+
+// ServiceModes lists the modes in the order the create form cycles them.
+var ServiceModes = []string{ModeReplicated, ModeGlobal, ModeReplicatedJob, ModeGlobalJob}
+
+// serviceNamePattern is the name swarm accepts for a service.
+var serviceNamePattern = regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_.-]*$`)
+
+// ServiceCreateForm holds the fields of a new service as text, with the
+// list syntax of ServiceForm.
+type ServiceCreateForm struct {
+	Name  string
+	Image string
+	// Mode is one of ServiceModes; empty means replicated.
+	Mode     string
+	Replicas string
+	// Ports list "target" or "published:target", with an optional
+	// "/udp" or "/sctp"; they are published on the ingress mesh.
+	Ports    string
+	Networks string
+	// Configs and Secrets list "name" or "name:target".
+	Configs     string
+	Secrets     string
+	Env         string
+	Constraints string
+	Labels      string
+}
+
+// ServiceCreate is the spec of a new service, ready to be created.
+type ServiceCreate struct {
+	Spec swarm.ServiceSpec
+	// YAML is the spec as shown for review.
+	YAML string
+}
+
+// CreateChoices are the names the create form offers for its networks,
+// configs and secrets.
+type CreateChoices struct {
+	Networks []string
+	Configs  []string
+	Secrets  []string
+}
+
+func parsePorts(s string) ([]swarm.PortConfig, error) {
+	items, err := parseList(s)
+	if err != nil {
+		return nil, err
+	}
+	var ports []swarm.PortConfig
+	for _, item := range items {
+		port := swarm.PortConfig{Protocol: swarm.PortConfigProtocolTCP, PublishMode: swarm.PortConfigPublishModeIngress}
+		spec, proto, ok := strings.Cut(item, "/")
+		if ok {
+			switch p := swarm.PortConfigProtocol(strings.ToLower(strings.TrimSpace(proto))); p {
+			case swarm.PortConfigProtocolTCP, swarm.PortConfigProtocolUDP, swarm.PortConfigProtocolSCTP:
+				port.Protocol = p
+			default:
+				return nil, fmt.Errorf("invalid protocol in %q (use tcp, udp or sctp)", item)
+			}
+		}
+		published, target, ok := strings.Cut(spec, ":")
+		if !ok {
+			published, target = "", published
+		}
+		t, err := strconv.ParseUint(strings.TrimSpace(target), 10, 16)
+		if err != nil || t == 0 {
+			return nil, fmt.Errorf("invalid port %q (expected target or published:target)", item)
+		}
+		port.TargetPort = uint32(t)
+		if published = strings.TrimSpace(published); published != "" {
+			p, err := strconv.ParseUint(published, 10, 16)
+			if err != nil || p == 0 {
+				return nil, fmt.Errorf("invalid published port in %q", item)
+			}
+			port.PublishedPort = uint32(p)
+		}
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
+
+// spec builds the spec of the new service the form describes, the way
+// `docker service create` would.
+func (f ServiceCreateForm) spec(resolve resolver) (swarm.ServiceSpec, error) {
+	var spec swarm.ServiceSpec
+	var err error
+
+	spec.Name = strings.TrimSpace(f.Name)
+	if !serviceNamePattern.MatchString(spec.Name) {
+		return spec, fmt.Errorf("invalid name %q (letters, digits, '_', '.' and '-')", spec.Name)
+	}
+	cs := &swarm.ContainerSpec{Image: strings.TrimSpace(f.Image)}
+	if cs.Image == "" {
+		return spec, fmt.Errorf("image cannot be empty")
+	}
+	spec.TaskTemplate.ContainerSpec = cs
+
+	mode := strings.ToLower(strings.TrimSpace(f.Mode))
+	replicas := strings.TrimSpace(f.Replicas)
+	var n uint64 = 1
+	if replicas != "" {
+		if mode == ModeGlobal || mode == ModeGlobalJob {
+			return spec, fmt.Errorf("%s services have no replica count", mode)
+		}
+		if n, err = strconv.ParseUint(replicas, 10, 64); err != nil {
+			return spec, fmt.Errorf("invalid replicas %q", replicas)
+		}
+	}
+	switch mode {
+	case "", ModeReplicated:
+		spec.Mode.Replicated = &swarm.ReplicatedService{Replicas: &n}
+	case ModeGlobal:
+		spec.Mode.Global = &swarm.GlobalService{}
+	case ModeReplicatedJob:
+		spec.Mode.ReplicatedJob = &swarm.ReplicatedJob{MaxConcurrent: &n, TotalCompletions: &n}
+	case ModeGlobalJob:
+		spec.Mode.GlobalJob = &swarm.GlobalJob{}
+	default:
+		return spec, fmt.Errorf("invalid mode %q (use %s)", f.Mode, strings.Join(ServiceModes, ", "))
+	}
+	if spec.Mode.ReplicatedJob != nil || spec.Mode.GlobalJob != nil {
+		// Jobs run to completion; swarm rejects the default "any" policy
+		spec.TaskTemplate.RestartPolicy = &swarm.RestartPolicy{Condition: swarm.RestartPolicyConditionOnFailure}
+	}
+
+	ports, err := parsePorts(f.Ports)
+	if err != nil {
+		return spec, fmt.Errorf("ports: %w", err)
+	}
+	if len(ports) > 0 {
+		spec.EndpointSpec = &swarm.EndpointSpec{Mode: swarm.ResolutionModeVIP, Ports: ports}
+	}
+
+	networks, err := parseList(f.Networks)
+	if err != nil {
+		return spec, fmt.Errorf("networks: %w", err)
+	}
+	for _, name := range networks {
+		id, err := resolve("network", name)
+		if err != nil {
+			return spec, err
+		}
+		spec.TaskTemplate.Networks = append(spec.TaskTemplate.Networks, swarm.NetworkAttachmentConfig{Target: id})
+	}
+
+	configs, err := parseRefs(f.Configs, configTarget)
+	if err != nil {
+		return spec, fmt.Errorf("configs: %w", err)
+	}
+	for _, r := range configs {
+		id, err := resolve("config", r.name)
+		if err != nil {
+			return spec, err
+		}
+		cs.Configs = append(cs.Configs, &swarm.ConfigReference{
+			ConfigID:   id,
+			ConfigName: r.name,
+			File:       &swarm.ConfigReferenceFileTarget{Name: r.target, UID: "0", GID: "0", Mode: 0o444},
+		})
+	}
+
+	secrets, err := parseRefs(f.Secrets, secretTarget)
+	if err != nil {
+		return spec, fmt.Errorf("secrets: %w", err)
+	}
+	for _, r := range secrets {
+		id, err := resolve("secret", r.name)
+		if err != nil {
+			return spec, err
+		}
+		cs.Secrets = append(cs.Secrets, &swarm.SecretReference{
+			SecretID:   id,
+			SecretName: r.name,
+			File:       &swarm.SecretReferenceFileTarget{Name: r.target, UID: "0", GID: "0", Mode: 0o444},
+		})
+	}
+
+	if cs.Env, err = parseList(f.Env); err != nil {
+		return spec, fmt.Errorf("env: %w", err)
+	}
+	if spec.Labels, err = parseMap(f.Labels); err != nil {
+		return spec, fmt.Errorf("labels: %w", err)
+	}
+
+	constraints, err := parseList(f.Constraints)
+	if err != nil {
+		return spec, fmt.Errorf("constraints: %w", err)
+	}
+	for _, c := range constraints {
+		if _, err := parseConstraint(c); err != nil {
+			return spec, err
+		}
+	}
+	if len(constraints) > 0 {
+		spec.TaskTemplate.Placement = &swarm.Placement{Constraints: constraints}
+	}
+	return spec, nil
+}
+
+// PrepareServiceCreate builds the spec of the new service the form
+// describes, resolving networks, configs and secrets by name.
+func PrepareServiceCreate(ctx context.Context, form ServiceCreateForm) (*ServiceCreate, error) {
+	c, err := clientFor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	spec, err := form.spec(newResolver(ctx, c))
+	if err != nil {
+		return nil, err
+	}
+	text, err := diff.YAML(spec)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceCreate{Spec: spec, YAML: text}, nil
+}
+
+// CreateServiceFromSpec creates a service like `docker service create`,
+// pinning the image to its digest, and returns its ID and the daemon's
+// warnings.
+func CreateServiceFromSpec(ctx context.Context, spec swarm.ServiceSpec) (string, []string, error) {
+	c, err := clientFor(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	resp, err := c.ServiceCreate(ctx, spec, swarm.ServiceCreateOptions{QueryRegistry: true})
+	if err != nil {
+		return "", nil, fmt.Errorf("creating service %s: %w", spec.Name, err)
+	}
+	for _, w := range resp.Warnings {
+		l().Warnf("⚠️  Warning for service %s: %s\n", spec.Name, w)
+	}
+	l().Infof("✨ Service %s created (%s)\n", spec.Name, resp.ID)
+	return resp.ID, resp.Warnings, nil
+}
+
+// LoadCreateChoices lists the swarm networks services can attach to, and
+// the configs and secrets, by name.
+func LoadCreateChoices(ctx context.Context) (CreateChoices, error) {
+	var choices CreateChoices
+	c, err := clientFor(ctx)
+	if err != nil {
+		return choices, fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	networks, err := c.NetworkList(ctx, network.ListOptions{})
+	if err != nil {
+		return choices, fmt.Errorf("listing networks: %w", err)
+	}
+	for _, n := range networks {
+		if n.Scope == "swarm" && !n.Ingress {
+			choices.Networks = append(choices.Networks, n.Name)
+		}
+	}
+	configs, err := c.ConfigList(ctx, swarm.ConfigListOptions{})
+	if err != nil {
+		return choices, fmt.Errorf("listing configs: %w", err)
+	}
+	for _, cfg := range configs {
+		choices.Configs = append(choices.Configs, cfg.Spec.Name)
+	}
+	secrets, err := c.SecretList(ctx, swarm.SecretListOptions{})
+	if err != nil {
+		return choices, fmt.Errorf("listing secrets: %w", err)
+	}
+	for _, s := range secrets {
+		choices.Secrets = append(choices.Secrets, s.Spec.Name)
+	}
+	slices.Sort(choices.Networks)
+	slices.Sort(choices.Configs)
+	slices.Sort(choices.Secrets)
+	return choices, nil
+}
+
+// ToggleListItem adds an item to a form list, or removes it when the list
+// already has it (with or without a ":target").
+func ToggleListItem(list, item string) (string, error) {
+	items, err := parseList(list)
+	if err != nil {
+		return list, err
+	}
+	names, _ := ParseListNames(list)
+	if i := slices.Index(names, item); i >= 0 {
+		items = slices.Delete(items, i, i+1)
+	} else {
+		items = append(items, item)
+	}
+	return FormatList(items), nil
+}
+
+// ParseListNames returns the names in a form list of references, without
+// their ":target".
+func ParseListNames(list string) ([]string, error) {
+	items, err := parseList(list)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(items))
+	for i, item := range items {
+		name, _, _ := strings.Cut(item, ":")
+		names[i] = strings.TrimSpace(name)
+	}
+	return names, nil
+}
@@ -0,0 +1,668 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"swarmcli/core/diff"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/swarm"
+	"github.com/docker/docker/client"
+	"github.com/docker/go-units"
+)
+
+// ServiceForm holds the fields of a service the update wizard edits, as
+// text. Lists are comma separated; an item containing a comma is quoted,
+// e.g. "JAVA_OPTS=-Xms1g,-Xmx2g" in double quotes.
+type ServiceForm struct {
+	Image    string
+	Replicas string
+	// Env and Labels are KEY=VALUE lists.
+	Env    string
+	Labels string
+	// CPUs are counted in cores ("0.5"), memory in bytes with a unit
+	// ("512MiB"); empty means none.
+	CPULimit          string
+	MemoryLimit       string
+	CPUReservation    string
+	MemoryReservation string
+	Constraints       string
+	// Configs and Secrets list "name" or "name:target".
+	Configs string
+	Secrets string
+	// UpdateConfig and RollbackConfig are key=value lists with the keys of
+	// the --update-* flags, e.g. "parallelism=1,delay=10s,order=start-first".
+	UpdateConfig   string
+	RollbackConfig string
+}
+
+// ServiceUpdate is a new spec for a service, ready to be applied.
+type ServiceUpdate struct {
+	// Service is the service as loaded; its version guards the update
+	// against concurrent changes.
+	Service swarm.Service
+	Spec    swarm.ServiceSpec
+	Diff    []diff.Line
+}
+
+// Changed reports whether the update changes anything.
+func (u ServiceUpdate) Changed() bool {
+	return diff.Changed(u.Diff)
+}
+
+// quoteItem quotes a list item that would otherwise split, CSV style.
+func quoteItem(s string) string {
+	if strings.ContainsAny(s, `,"`) {
+		return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+	}
+	return s
+}
+
+// FormatList joins items as a form list, quoting those that would split.
+func FormatList(items []string) string {
+	quoted := make([]string, len(items))
+	for i, s := range items {
+		quoted[i] = quoteItem(s)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// parseList splits a comma separated list; double quotes protect commas.
+func parseList(s string) ([]string, error) {
+	var items []string
+	var cur strings.Builder
+	quoted, wasQuoted := false, false
+	flush := func() {
+		item := cur.String()
+		if !wasQuoted {
+			item = strings.TrimSpace(item)
+		}
+		if item != "" {
+			items = append(items, item)
+		}
+		cur.Reset()
+		wasQuoted = false
+	}
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case r == '"' && quoted && i+1 < len(runes) && runes[i+1] == '"':
+			cur.WriteRune('"')
+			i++
+		case r == '"' && quoted:
+			quoted = false
+		case r == '"' && strings.TrimSpace(cur.String()) == "":
+			cur.Reset()
+			quoted, wasQuoted = true, true
+		case r == ',' && !quoted:
+			flush()
+		case wasQuoted && !quoted:
+			if r != ' ' {
+				return nil, fmt.Errorf("unexpected %q after quoted item", r)
+			}
+		default:
+			cur.WriteRune(r)
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unterminated quote in %q", s)
+	}
+	flush()
+	return items, nil
+}
+
+// FormatMap formats a map as a KEY=VALUE form list, sorted by key.
+func FormatMap(m map[string]string) string {
+	keys := slices.Sorted(maps.Keys(m))
+	items := make([]string, len(keys))
+	for i, k := range keys {
+		items[i] = k + "=" + m[k]
+	}
+	return FormatList(items)
+}
+
+func parseMap(s string) (map[string]string, error) {
+	items, err := parseList(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]string, len(items))
+	for _, item := range items {
+		k, v, ok := strings.Cut(item, "=")
+		if !ok || strings.TrimSpace(k) == "" {
+			return nil, fmt.Errorf("invalid entry %q (expected key=value)", item)
+		}
+		out[strings.TrimSpace(k)] = v
+	}
+	return out, nil
+}
+
+func formatNanoCPUs(n int64) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(float64(n)/1e9, 'f', -1, 64)
+}
+
+func parseNanoCPUs(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil || f < 0 {
+		return 0, fmt.Errorf("invalid CPU amount %q (e.g. 0.5)", s)
+	}
+	return int64(f * 1e9), nil
+}
+
+func formatBytes(n int64) string {
+	if n == 0 {
+		return ""
+	}
+	return units.BytesSize(float64(n))
+}
+
+func parseBytes(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	n, err := units.RAMInBytes(s)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid memory amount %q (e.g. 512MiB)", s)
+	}
+	return n, nil
+}
+
+// stripDigest drops the digest swarm pins an image to.
+func stripDigest(image string) string {
+	if i := strings.Index(image, "@sha256:"); i != -1 {
+		return image[:i]
+	}
+	return image
+}
+
+func formatUpdateConfig(c *swarm.UpdateConfig) string {
+	if c == nil {
+		return ""
+	}
+	items := []string{fmt.Sprintf("parallelism=%d", c.Parallelism)}
+	if c.Delay > 0 {
+		items = append(items, "delay="+c.Delay.String())
+	}
+	if c.FailureAction != "" {
+		items = append(items, "failure-action="+c.FailureAction)
+	}
+	if c.Monitor > 0 {
+		items = append(items, "monitor="+c.Monitor.String())
+	}
+	if c.MaxFailureRatio > 0 {
+		items = append(items, "max-failure-ratio="+strconv.FormatFloat(float64(c.MaxFailureRatio), 'f', -1, 32))
+	}
+	if c.Order != "" {
+		items = append(items, "order="+c.Order)
+	}
+	return FormatList(items)
+}
+
+func parseUpdateConfig(s string, rollback bool) (*swarm.UpdateConfig, error) {
+	m, err := parseMap(s)
+	if err != nil || m == nil {
+		return nil, err
+	}
+	actions := []string{swarm.UpdateFailureActionPause, swarm.UpdateFailureActionContinue, swarm.UpdateFailureActionRollback}
+	if rollback {
+		actions = actions[:2]
+	}
+	c := &swarm.UpdateConfig{}
+	for k, v := range m {
+		v = strings.TrimSpace(v)
+		switch k {
+		case "parallelism":
+			n, err := strconv.ParseUint(v, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid parallelism %q", v)
+			}
+			c.Parallelism = n
+		case "delay", "monitor":
+			d, err := time.ParseDuration(v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %q (e.g. 10s)", k, v)
+			}
+			if k == "delay" {
+				c.Delay = d
+			} else {
+				c.Monitor = d
+			}
+		case "failure-action":
+			if !slices.Contains(actions, v) {
+				return nil, fmt.Errorf("invalid failure-action %q (use %s)", v, strings.Join(actions, ", "))
+			}
+			c.FailureAction = v
+		case "max-failure-ratio":
+			f, err := strconv.ParseFloat(v, 32)
+			if err != nil || f < 0 || f > 1 {
+				return nil, fmt.Errorf("invalid max-failure-ratio %q (0 to 1)", v)
+			}
+			c.MaxFailureRatio = float32(f)
+		case "order":
+			if v != swarm.UpdateOrderStopFirst && v != swarm.UpdateOrderStartFirst {
+				return nil, fmt.Errorf("invalid order %q (use stop-first or start-first)", v)
+			}
+			c.Order = v
+		default:
+			return nil, fmt.Errorf("unknown key %q (use parallelism, delay, failure-action, monitor, max-failure-ratio, order)", k)
+		}
+	}
+	return c, nil
+}
+
+// configTarget and secretTarget are where a reference is mounted when no
+// target is given, as with `docker service create --config/--secret`.
+func configTarget(name string) string { return "/" + name }
+func secretTarget(name string) string { return name }
+
+func formatRefs[T any](refs []T, ref func(T) (name, target string), defaultTarget func(string) string) string {
+	items := make([]string, len(refs))
+	for i, r := range refs {
+		name, target := ref(r)
+		items[i] = name
+		if target != defaultTarget(name) {
+			items[i] += ":" + target
+		}
+	}
+	return FormatList(items)
+}
+
+type refSpec struct{ name, target string }
+
+func parseRefs(s string, defaultTarget func(string) string) ([]refSpec, error) {
+	items, err := parseList(s)
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]refSpec, len(items))
+	for i, item := range items {
+		name, target, ok := strings.Cut(item, ":")
+		name, target = strings.TrimSpace(name), strings.TrimSpace(target)
+		if name == "" || ok && target == "" {
+			return nil, fmt.Errorf("invalid reference %q (expected name or name:target)", item)
+		}
+		if !ok {
+			target = defaultTarget(name)
+		}
+		refs[i] = refSpec{name, target}
+	}
+	return refs, nil
+}
+
+// NewServiceForm fills the form from a service's current spec.
+func NewServiceForm(svc swarm.Service) ServiceForm {
+	spec := svc.Spec
+	var f ServiceForm
+	cs := spec.TaskTemplate.ContainerSpec
+	if cs != nil {
+		f.Image = stripDigest(cs.Image)
+		f.Env = FormatList(cs.Env)
+		f.Configs = formatRefs(cs.Configs, func(r *swarm.ConfigReference) (string, string) {
+			if r.File == nil {
+				return r.ConfigName, ""
+			}
+			return r.ConfigName, r.File.Name
+		}, configTarget)
+		f.Secrets = formatRefs(cs.Secrets, func(r *swarm.SecretReference) (string, string) {
+			if r.File == nil {
+				return r.SecretName, ""
+			}
+			return r.SecretName, r.File.Name
+		}, secretTarget)
+	}
+	if spec.Mode.Replicated != nil && spec.Mode.Replicated.Replicas != nil {
+		f.Replicas = strconv.FormatUint(*spec.Mode.Replicated.Replicas, 10)
+	}
+	f.Labels = FormatMap(spec.Labels)
+	if r := spec.TaskTemplate.Resources; r != nil {
+		if r.Limits != nil {
+			f.CPULimit = formatNanoCPUs(r.Limits.NanoCPUs)
+			f.MemoryLimit = formatBytes(r.Limits.MemoryBytes)
+		}
+		if r.Reservations != nil {
+			f.CPUReservation = formatNanoCPUs(r.Reservations.NanoCPUs)
+			f.MemoryReservation = formatBytes(r.Reservations.MemoryBytes)
+		}
+	}
+	if p := spec.TaskTemplate.Placement; p != nil {
+		f.Constraints = FormatList(p.Constraints)
+	}
+	f.UpdateConfig = formatUpdateConfig(spec.UpdateConfig)
+	f.RollbackConfig = formatUpdateConfig(spec.RollbackConfig)
+	return f
+}
+
+// copySpec deep-copies a spec so edits leave the loaded service untouched.
+func copySpec(spec swarm.ServiceSpec) (swarm.ServiceSpec, error) {
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return swarm.ServiceSpec{}, err
+	}
+	var out swarm.ServiceSpec
+	err = json.Unmarshal(raw, &out)
+	return out, err
+}
+
+// resolver looks up the ID of a config, secret or network by name.
+type resolver func(kind, name string) (string, error)
+
+// apply returns a copy of spec with the form's values. Fields the form
+// shows unchanged keep their exact value, e.g. the image digest.
+func (f ServiceForm) apply(orig swarm.ServiceSpec, resolve resolver) (swarm.ServiceSpec, error) {
+	old := NewServiceForm(swarm.Service{Spec: orig})
+	spec, err := copySpec(orig)
+	if err != nil {
+		return spec, fmt.Errorf("copying spec: %w", err)
+	}
+	if spec.TaskTemplate.ContainerSpec == nil {
+		spec.TaskTemplate.ContainerSpec = &swarm.ContainerSpec{}
+	}
+	cs := spec.TaskTemplate.ContainerSpec
+
+	if image := strings.TrimSpace(f.Image); image != old.Image {
+		if image == "" {
+			return spec, fmt.Errorf("image cannot be empty")
+		}
+		cs.Image = image
+	}
+
+	if replicas := strings.TrimSpace(f.Replicas); replicas != old.Replicas {
+		if spec.Mode.Replicated == nil {
+			return spec, fmt.Errorf("global services have no replica count")
+		}
+		n, err := strconv.ParseUint(replicas, 10, 64)
+		if err != nil {
+			return spec, fmt.Errorf("invalid replicas %q", replicas)
+		}
+		spec.Mode.Replicated.Replicas = &n
+	}
+
+	if f.Env != old.Env {
+		if cs.Env, err = parseList(f.Env); err != nil {
+			return spec, fmt.Errorf("env: %w", err)
+		}
+	}
+	if f.Labels != old.Labels {
+		if spec.Labels, err = parseMap(f.Labels); err != nil {
+			return spec, fmt.Errorf("labels: %w", err)
+		}
+	}
+
+	if err := f.applyResources(&spec, old); err != nil {
+		return spec, err
+	}
+
+	if f.Constraints != old.Constraints {
+		constraints, err := parseList(f.Constraints)
+		if err != nil {
+			return spec, fmt.Errorf("constraints: %w", err)
+		}
+		for _, c := range constraints {
+			if _, err := parseConstraint(c); err != nil {
+				return spec, err
+			}
+		}
+		if spec.TaskTemplate.Placement == nil {
+			spec.TaskTemplate.Placement = &swarm.Placement{}
+		}
+		spec.TaskTemplate.Placement.Constraints = constraints
+	}
+
+	if f.Configs != old.Configs {
+		refs, err := parseRefs(f.Configs, configTarget)
+		if err != nil {
+			return spec, fmt.Errorf("configs: %w", err)
+		}
+		var out []*swarm.ConfigReference
+		for _, r := range refs {
+			i := slices.IndexFunc(cs.Configs, func(c *swarm.ConfigReference) bool { return c.ConfigName == r.name })
+			ref := &swarm.ConfigReference{
+				ConfigName: r.name,
+				File:       &swarm.ConfigReferenceFileTarget{UID: "0", GID: "0", Mode: 0o444},
+			}
+			if i >= 0 {
+				copied := *cs.Configs[i]
+				ref = &copied
+				if ref.File != nil {
+					file := *ref.File
+					ref.File = &file
+				} else {
+					ref.File = &swarm.ConfigReferenceFileTarget{UID: "0", GID: "0", Mode: 0o444}
+				}
+			} else if ref.ConfigID, err = resolve("config", r.name); err != nil {
+				return spec, err
+			}
+			ref.File.Name = r.target
+			out = append(out, ref)
+		}
+		cs.Configs = out
+	}
+
+	if f.Secrets != old.Secrets {
+		refs, err := parseRefs(f.Secrets, secretTarget)
+		if err != nil {
+			return spec, fmt.Errorf("secrets: %w", err)
+		}
+		var out []*swarm.SecretReference
+		for _, r := range refs {
+			i := slices.IndexFunc(cs.Secrets, func(s *swarm.SecretReference) bool { return s.SecretName == r.name })
+			ref := &swarm.SecretReference{
+				SecretName: r.name,
+				File:       &swarm.SecretReferenceFileTarget{UID: "0", GID: "0", Mode: 0o444},
+			}
+			if i >= 0 {
+				copied := *cs.Secrets[i]
+				ref = &copied
+				if ref.File != nil {
+					file := *ref.File
+					ref.File = &file
+				} else {
+					ref.File = &swarm.SecretReferenceFileTarget{UID: "0", GID: "0", Mode: 0o444}
+				}
+			} else if ref.SecretID, err = resolve("secret", r.name); err != nil {
+				return spec, err
+			}
+			ref.File.Name = r.target
+			out = append(out, ref)
+		}
+		cs.Secrets = out
+	}
+
+	if f.UpdateConfig != old.UpdateConfig {
+		if spec.UpdateConfig, err = parseUpdateConfig(f.UpdateConfig, false); err != nil {
+			return spec, fmt.Errorf("update config: %w", err)
+		}
+	}
+	if f.RollbackConfig != old.RollbackConfig {
+		if spec.RollbackConfig, err = parseUpdateConfig(f.RollbackConfig, true); err != nil {
+			return spec, fmt.Errorf("rollback config: %w", err)
+		}
+	}
+	return spec, nil
+}
+
+func (f ServiceForm) applyResources(spec *swarm.ServiceSpec, old ServiceForm) error {
+	if f.CPULimit == old.CPULimit && f.MemoryLimit == old.MemoryLimit &&
+		f.CPUReservation == old.CPUReservation && f.MemoryReservation == old.MemoryReservation {
+		return nil
+	}
+	if spec.TaskTemplate.Resources == nil {
+		spec.TaskTemplate.Resources = &swarm.ResourceRequirements{}
+	}
+	r := spec.TaskTemplate.Resources
+	if r.Limits == nil {
+		r.Limits = &swarm.Limit{}
+	}
+	if r.Reservations == nil {
+		r.Reservations = &swarm.Resources{}
+	}
+
+	var err error
+	if r.Limits.NanoCPUs, err = parseNanoCPUs(f.CPULimit); err != nil {
+		return fmt.Errorf("CPU limit: %w", err)
+	}
+	if r.Limits.MemoryBytes, err = parseBytes(f.MemoryLimit); err != nil {
+		return fmt.Errorf("memory limit: %w", err)
+	}
+	if r.Reservations.NanoCPUs, err = parseNanoCPUs(f.CPUReservation); err != nil {
+		return fmt.Errorf("CPU reservation: %w", err)
+	}
+	if r.Reservations.MemoryBytes, err = parseBytes(f.MemoryReservation); err != nil {
+		return fmt.Errorf("memory reservation: %w", err)
+	}
+	if r.Limits.NanoCPUs > 0 && r.Reservations.NanoCPUs > r.Limits.NanoCPUs {
+		return fmt.Errorf("CPU reservation exceeds the limit")
+	}
+	if r.Limits.MemoryBytes > 0 && r.Reservations.MemoryBytes > r.Limits.MemoryBytes {
+		return fmt.Errorf("memory reservation exceeds the limit")
+	}
+
+	// Drop what ends up empty, so clearing a field leaves no trace
+	if *r.Limits == (swarm.Limit{}) {
+		r.Limits = nil
+	}
+	if r.Reservations.NanoCPUs == 0 && r.Reservations.MemoryBytes == 0 && len(r.Reservations.GenericResources) == 0 {
+		r.Reservations = nil
+	}
+	if r.Limits == nil && r.Reservations == nil {
+		spec.TaskTemplate.Resources = nil
+	}
+	return nil
+}
+
+// LoadService inspects a service, with the version an update must carry.
+func LoadService(ctx context.Context, serviceID string) (swarm.Service, error) {
+	c, err := clientFor(ctx)
+	if err != nil {
+		return swarm.Service{}, fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	svc, _, err := c.ServiceInspectWithRaw(ctx, serviceID, swarm.ServiceInspectOptions{})
+	if err != nil {
+		return swarm.Service{}, fmt.Errorf("inspect service %s: %w", serviceID, err)
+	}
+	return svc, nil
+}
+
+// newResolver looks configs, secrets and swarm networks up by exact name.
+func newResolver(ctx context.Context, c *client.Client) resolver {
+	return func(kind, name string) (string, error) {
+		args := filters.NewArgs(filters.Arg("name", name))
+		switch kind {
+		case "config":
+			configs, err := c.ConfigList(ctx, swarm.ConfigListOptions{Filters: args})
+			if err != nil {
+				return "", fmt.Errorf("listing configs: %w", err)
+			}
+			for _, cfg := range configs {
+				if cfg.Spec.Name == name {
+					return cfg.ID, nil
+				}
+			}
+		case "network":
+			networks, err := c.NetworkList(ctx, network.ListOptions{Filters: args})
+			if err != nil {
+				return "", fmt.Errorf("listing networks: %w", err)
+			}
+			for _, n := range networks {
+				if n.Name == name && n.Scope == "swarm" {
+					return n.ID, nil
+				}
+			}
+		case "secret":
+			secrets, err := c.SecretList(ctx, swarm.SecretListOptions{Filters: args})
+			if err != nil {
+				return "", fmt.Errorf("listing secrets: %w", err)
+			}
+			for _, s := range secrets {
+				if s.Spec.Name == name {
+					return s.ID, nil
+				}
+			}
+		}
+		return "", fmt.Errorf("%s %q not found", kind, name)
+	}
+}
+
+// PrepareServiceUpdate builds the spec the form describes and diffs it
+// against the loaded one.
+func PrepareServiceUpdate(ctx context.Context, svc swarm.Service, form ServiceForm) (*ServiceUpdate, error) {
+	c, err := clientFor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	spec, err := form.apply(svc.Spec, newResolver(ctx, c))
+	if err != nil {
+		return nil, err
+	}
+	return NewServiceUpdate(svc, spec)
+}
+
+// specDiff diffs two specs as YAML.
+func specDiff(old, new swarm.ServiceSpec) ([]diff.Line, error) {
+	a, err := diff.YAML(old)
+	if err != nil {
+		return nil, err
+	}
+	b, err := diff.YAML(new)
+	if err != nil {
+		return nil, err
+	}
+	return diff.Lines(a, b), nil
+}
+
+// ApplyServiceUpdate sends the new spec like `docker service update`: with
+// the registry credentials of the spec and, when the image changed, its
+// digest resolved. It fails if the service changed since it was loaded, and
+// returns the daemon's warnings.
+func ApplyServiceUpdate(ctx context.Context, u *ServiceUpdate) ([]string, error) {
+	c, err := clientFor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	opts := swarm.ServiceUpdateOptions{RegistryAuthFrom: types.RegistryAuthFromSpec}
+	if cs := u.Spec.TaskTemplate.ContainerSpec; cs != nil && u.Service.Spec.TaskTemplate.ContainerSpec != nil &&
+		cs.Image != u.Service.Spec.TaskTemplate.ContainerSpec.Image {
+		opts.QueryRegistry = true
+	}
+	resp, err := c.ServiceUpdate(ctx, u.Service.ID, u.Service.Version, u.Spec, opts)
+	if err != nil {
+		return nil, fmt.Errorf("updating service %s: %w", u.Spec.Name, err)
+	}
+	for _, w := range resp.Warnings {
+		l().Warnf("⚠️  Warning for service %s: %s\n", u.Spec.Name, w)
+	}
+	l().Infof("✏️  Service %s updated\n", u.Spec.Name)
+	return resp.Warnings, nil
+}
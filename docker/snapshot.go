@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"sort"
 	"sync"
-	"sync/atomic"
 	"time"
 
 	"github.com/docker/docker/api/types/swarm"
@@ -24,6 +23,7 @@ type NodeEntry struct {
 	Manager      bool
 	Addr         string
 	Labels       map[string]string
+	Resources    NodeResources
 }
 
 // StackEntry is a lightweight representation of a Docker stack,
@@ -44,40 +44,68 @@ type SwarmSnapshot struct {
 
 var (
 	snapshotMu sync.RWMutex
-	snapshot   *SwarmSnapshot
-	// refreshInProgress indicates whether a background refresh goroutine is running.
-	refreshInProgress int32
+	// snapshots holds one cached snapshot per Docker context, so tabs bound
+	// to different contexts keep their data when switching between them.
+	snapshots = map[string]*SwarmSnapshot{}
+	// refreshing marks the contexts with a background refresh running.
+	refreshing = map[string]bool{}
 )
 
 // cacheTTL controls how long we reuse the snapshot before refreshing.
 const cacheTTL = 3 * time.Second
 
-// GetSnapshot returns the cached snapshot if it's still valid.
+// activeKey returns the snapshot cache key of the active context.
+func activeKey() string {
+	name, err := ActiveContext()
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
+// GetSnapshot returns the cached snapshot of the active context.
 func GetSnapshot() *SwarmSnapshot {
+	return SnapshotFor(activeKey())
+}
+
+// SnapshotFor returns the cached snapshot of the named context.
+func SnapshotFor(ctxName string) *SwarmSnapshot {
 	snapshotMu.RLock()
 	defer snapshotMu.RUnlock()
-	return snapshot
+	return snapshots[ctxName]
 }
 
 // SetSnapshot replaces the cached snapshot (useful for manual refresh).
 func SetSnapshot(s *SwarmSnapshot) {
+	setSnapshot(activeKey(), s)
+}
+
+func setSnapshot(ctxName string, s *SwarmSnapshot) {
 	snapshotMu.Lock()
 	defer snapshotMu.Unlock()
-	snapshot = s
+	snapshots[ctxName] = s
 }
 
-// InvalidateSnapshot clears the cached snapshot, forcing a fresh fetch on next access.
-// This should be called after a Docker context switch.
+// InvalidateSnapshot clears the cached snapshot of the active context,
+// forcing a fresh fetch on next access.
 func InvalidateSnapshot() {
-	snapshotMu.Lock()
-	defer snapshotMu.Unlock()
-	snapshot = nil
+	setSnapshot(activeKey(), nil)
 }
 
 // RefreshSnapshot fetches all swarm data (nodes, services, tasks) at once
-// and updates the global cache.
+// and updates the cache of the active context.
 func RefreshSnapshot() (*SwarmSnapshot, error) {
-	c, err := GetClient()
+	ctxName, err := ActiveContext()
+	if err != nil {
+		return nil, fmt.Errorf("docker client: %w", err)
+	}
+	return RefreshSnapshotFor(ctxName)
+}
+
+// RefreshSnapshotFor fetches the swarm data of the named context and updates
+// its cache entry.
+func RefreshSnapshotFor(ctxName string) (*SwarmSnapshot, error) {
+	c, err := ClientForContext(ctxName)
 	if err != nil {
 		return nil, fmt.Errorf("docker client: %w", err)
 	}
@@ -90,7 +118,9 @@ func RefreshSnapshot() (*SwarmSnapshot, error) {
 		return nil, fmt.Errorf("listing nodes: %w", err)
 	}
 
-	services, err := c.ServiceList(ctx, swarm.ServiceListOptions{})
+	// Status fills in ServiceStatus, the running and desired task counts
+	// the manager computed
+	services, err := c.ServiceList(ctx, swarm.ServiceListOptions{Status: true})
 	if err != nil {
 		return nil, fmt.Errorf("listing services: %w", err)
 	}
@@ -107,52 +137,71 @@ func RefreshSnapshot() (*SwarmSnapshot, error) {
 		Fetched:  time.Now(),
 	}
 
-	SetSnapshot(snap)
+	setSnapshot(ctxName, snap)
 	return snap, nil
 }
 
-// RefreshSnapshotAsync triggers a background refresh if one is not already running.
-// It returns immediately.
+// RefreshSnapshotAsync triggers a background refresh of the active context
+// if one is not already running. It returns immediately.
 func RefreshSnapshotAsync() {
-	if !atomic.CompareAndSwapInt32(&refreshInProgress, 0, 1) {
+	RefreshSnapshotAsyncFor(activeKey())
+}
+
+// RefreshSnapshotAsyncFor triggers a background refresh of the named context
+// if one is not already running. It returns immediately.
+func RefreshSnapshotAsyncFor(ctxName string) {
+	snapshotMu.Lock()
+	if refreshing[ctxName] {
 		// already refreshing
+		snapshotMu.Unlock()
 		return
 	}
+	refreshing[ctxName] = true
+	snapshotMu.Unlock()
 
 	go func() {
-		defer atomic.StoreInt32(&refreshInProgress, 0)
+		defer func() {
+			snapshotMu.Lock()
+			delete(refreshing, ctxName)
+			snapshotMu.Unlock()
+		}()
 		// Run a refresh and ignore the error; the cached snapshot will be updated on success.
-		_, _ = RefreshSnapshot()
+		_, _ = RefreshSnapshotFor(ctxName)
 	}()
 }
 
 // TriggerRefreshIfNeeded will check the cache TTL and start a background refresh
-// if the snapshot is empty or stale.
+// if the snapshot of the active context is empty or stale.
 func TriggerRefreshIfNeeded() {
-	snapshotMu.RLock()
-	s := snapshot
-	snapshotMu.RUnlock()
+	TriggerRefreshIfNeededFor(activeKey())
+}
 
+// TriggerRefreshIfNeededFor is TriggerRefreshIfNeeded for the named context.
+func TriggerRefreshIfNeededFor(ctxName string) {
+	s := SnapshotFor(ctxName)
 	if s == nil || time.Since(s.Fetched) > cacheTTL {
-		RefreshSnapshotAsync()
+		RefreshSnapshotAsyncFor(ctxName)
 	}
 }
 
-// GetOrRefreshSnapshot returns the current snapshot, refreshing it
-// if the cache is empty or too old.
+// GetOrRefreshSnapshot returns the snapshot of the active context,
+// refreshing it if the cache is empty or too old.
 func GetOrRefreshSnapshot() (*SwarmSnapshot, error) {
-	snapshotMu.RLock()
-	s := snapshot
-	snapshotMu.RUnlock()
+	return GetOrRefreshSnapshotFor(activeKey())
+}
 
+// GetOrRefreshSnapshotFor is GetOrRefreshSnapshot for the named context.
+func GetOrRefreshSnapshotFor(ctxName string) (*SwarmSnapshot, error) {
+	s := SnapshotFor(ctxName)
 	if s == nil || time.Since(s.Fetched) > cacheTTL {
-		return RefreshSnapshot()
+		return RefreshSnapshotFor(ctxName)
 	}
 	return s, nil
 }
 
 // ToNodeEntries converts the full nodes into display-friendly entries.
 func (s SwarmSnapshot) ToNodeEntries() []NodeEntry {
+	resources := s.NodeResources()
 	nodes := make([]NodeEntry, len(s.Nodes))
 	for i, n := range s.Nodes {
 		ver := "-"
@@ -175,6 +224,7 @@ func (s SwarmSnapshot) ToNodeEntries() []NodeEntry {
 			Manager:      isManager,
 			Addr:         n.Status.Addr,
 			Labels:       n.Spec.Labels,
+			Resources:    resources[n.ID],
 		}
 	}
 
@@ -0,0 +1,204 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"swarmcli/core/diff"
+
+	"github.com/docker/docker/api/types/swarm"
+	"gopkg.in/yaml.v3"
+)
+
+// ErrEditCancelled is returned for an edited spec left empty.
+var ErrEditCancelled = errors.New("edit cancelled")
+
+// NewServiceUpdate diffs a new spec against the loaded service.
+func NewServiceUpdate(svc swarm.Service, spec swarm.ServiceSpec) (*ServiceUpdate, error) {
+	lines, err := specDiff(svc.Spec, spec)
+	if err != nil {
+		return nil, err
+	}
+	return &ServiceUpdate{Service: svc, Spec: spec, Diff: lines}, nil
+}
+
+// ServiceSpecDocument renders a spec as the YAML document edited in
+// $EDITOR, under a comment header; notes (errors, conflicts) are added to
+// the header.
+func ServiceSpecDocument(name string, spec swarm.ServiceSpec, notes ...string) ([]byte, error) {
+	body, err := diff.YAML(spec)
+	if err != nil {
+		return nil, err
+	}
+	return WrapSpecDocument(name, []byte(body), notes...), nil
+}
+
+// WrapSpecDocument puts the comment header of ServiceSpecDocument on top of
+// an already rendered spec.
+func WrapSpecDocument(name string, body []byte, notes ...string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "# Edit the spec of service %s, then save and quit to review the changes.\n", name)
+	b.WriteString("# Lines starting with '#' are ignored; an empty file cancels the edit.\n")
+	for _, n := range notes {
+		b.WriteString("#\n")
+		for _, line := range strings.Split(strings.TrimRight(n, "\n"), "\n") {
+			b.WriteString("# " + line + "\n")
+		}
+	}
+	b.Write(body)
+	return b.Bytes()
+}
+
+// StripSpecComments drops the comment header of an edited document, so it
+// can be edited again under a new one.
+func StripSpecComments(data []byte) []byte {
+	lines := strings.Split(string(data), "\n")
+	i := 0
+	for i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), "#") {
+		i++
+	}
+	return []byte(strings.Join(lines[i:], "\n"))
+}
+
+// ParseServiceSpec reads an edited YAML (or JSON) document back into a
+// spec, rejecting unknown fields so typos do not get silently dropped.
+func ParseServiceSpec(data []byte) (swarm.ServiceSpec, error) {
+	var spec swarm.ServiceSpec
+	var generic any
+	if err := yaml.Unmarshal(data, &generic); err != nil {
+		return spec, fmt.Errorf("invalid YAML: %w", err)
+	}
+	if generic == nil {
+		return spec, ErrEditCancelled
+	}
+	raw, err := json.Marshal(generic)
+	if err != nil {
+		return spec, fmt.Errorf("invalid spec: %w", err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&spec); err != nil {
+		return spec, fmt.Errorf("invalid spec: %w", err)
+	}
+	return spec, nil
+}
+
+// ValidateServiceSpec catches the mistakes swarm would reject with a less
+// helpful message.
+func ValidateServiceSpec(orig, spec swarm.ServiceSpec) error {
+	switch {
+	case spec.Name != orig.Name:
+		return fmt.Errorf("renaming a service is not supported (Name must stay %q)", orig.Name)
+	case spec.TaskTemplate.ContainerSpec == nil || strings.TrimSpace(spec.TaskTemplate.ContainerSpec.Image) == "":
+		return fmt.Errorf("TaskTemplate.ContainerSpec.Image is required")
+	}
+	modes := 0
+	for _, set := range []bool{spec.Mode.Replicated != nil, spec.Mode.Global != nil,
+		spec.Mode.ReplicatedJob != nil, spec.Mode.GlobalJob != nil} {
+		if set {
+			modes++
+		}
+	}
+	if modes != 1 {
+		return fmt.Errorf("Mode must set exactly one of Replicated, Global, ReplicatedJob or GlobalJob")
+	}
+	if p := spec.TaskTemplate.Placement; p != nil {
+		for _, c := range p.Constraints {
+			if _, err := parseConstraint(c); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// IsVersionConflict reports whether an update failed because the service
+// changed since it was read.
+func IsVersionConflict(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "update out of sequence")
+}
+
+// toGeneric turns a spec into nested maps, the shape merged below.
+func toGeneric(spec swarm.ServiceSpec) (map[string]any, error) {
+	raw, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	err = json.Unmarshal(raw, &out)
+	return out, err
+}
+
+// MergeServiceSpecs applies the changes made from base to mine on top of
+// theirs, a newer version of the same service. Objects merge field by
+// field; other values, lists included, are replaced whole. Fields both
+// sides changed differently are returned as conflicts and keep mine.
+func MergeServiceSpecs(base, mine, theirs swarm.ServiceSpec) (swarm.ServiceSpec, []string, error) {
+	var merged swarm.ServiceSpec
+	b, err := toGeneric(base)
+	if err != nil {
+		return merged, nil, err
+	}
+	m, err := toGeneric(mine)
+	if err != nil {
+		return merged, nil, err
+	}
+	t, err := toGeneric(theirs)
+	if err != nil {
+		return merged, nil, err
+	}
+
+	var conflicts []string
+	out := merge3(b, m, t, "", &conflicts)
+	raw, err := json.Marshal(out)
+	if err != nil {
+		return merged, nil, err
+	}
+	if err := json.Unmarshal(raw, &merged); err != nil {
+		return merged, nil, err
+	}
+	return merged, conflicts, nil
+}
+
+// merge3 merges one value; nil stands for an absent field.
+func merge3(base, mine, theirs any, path string, conflicts *[]string) any {
+	switch {
+	case reflect.DeepEqual(mine, base):
+		return theirs
+	case reflect.DeepEqual(theirs, base), reflect.DeepEqual(mine, theirs):
+		return mine
+	}
+
+	mm, mineIsMap := mine.(map[string]any)
+	tm, theirsIsMap := theirs.(map[string]any)
+	if mineIsMap && theirsIsMap {
+		bm, _ := base.(map[string]any)
+		all := maps.Clone(mm)
+		maps.Copy(all, tm)
+		maps.Copy(all, bm)
+		keys := slices.Sorted(maps.Keys(all))
+		out := make(map[string]any, len(keys))
+		for _, k := range keys {
+			sub := k
+			if path != "" {
+				sub = path + "." + k
+			}
+			if v := merge3(bm[k], mm[k], tm[k], sub, conflicts); v != nil {
+				out[k] = v
+			}
+		}
+		return out
+	}
+
+	*conflicts = append(*conflicts, path)
+	return mine
+}
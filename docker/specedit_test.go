@@ -0,0 +1,292 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// testSpec returns a small replicated service spec with edits applied.
+func testSpec(edits ...func(*swarm.ServiceSpec)) swarm.ServiceSpec {
+	replicas := uint64(2)
+	spec := swarm.ServiceSpec{
+		Annotations: swarm.Annotations{Name: "web", Labels: map[string]string{"tier": "front", "team": "ops"}},
+		TaskTemplate: swarm.TaskSpec{
+			ContainerSpec: &swarm.ContainerSpec{Image: "nginx:1.27", Env: []string{"A=1"}},
+		},
+		Mode: swarm.ServiceMode{Replicated: &swarm.ReplicatedService{Replicas: &replicas}},
+	}
+	for _, edit := range edits {
+		edit(&spec)
+	}
+	return spec
+}
+
+func withImage(image string) func(*swarm.ServiceSpec) {
+	return func(s *swarm.ServiceSpec) { s.TaskTemplate.ContainerSpec.Image = image }
+}
+
+func withReplicas(n uint64) func(*swarm.ServiceSpec) {
+	return func(s *swarm.ServiceSpec) { s.Mode.Replicated.Replicas = &n }
+}
+
+func withEnv(env ...string) func(*swarm.ServiceSpec) {
+	return func(s *swarm.ServiceSpec) { s.TaskTemplate.ContainerSpec.Env = env }
+}
+
+func withLabels(labels map[string]string) func(*swarm.ServiceSpec) {
+	return func(s *swarm.ServiceSpec) { s.Labels = labels }
+}
+
+func TestMergeServiceSpecs(t *testing.T) {
+	tests := []struct {
+		name          string
+		mine          swarm.ServiceSpec
+		theirs        swarm.ServiceSpec
+		want          swarm.ServiceSpec
+		wantConflicts []string
+	}{
+		{
+			name:   "nothing changed",
+			mine:   testSpec(),
+			theirs: testSpec(),
+			want:   testSpec(),
+		},
+		{
+			name:   "only theirs changed",
+			mine:   testSpec(),
+			theirs: testSpec(withReplicas(5)),
+			want:   testSpec(withReplicas(5)),
+		},
+		{
+			name:   "disjoint edits merge",
+			mine:   testSpec(withImage("nginx:1.28")),
+			theirs: testSpec(withReplicas(5)),
+			want:   testSpec(withImage("nginx:1.28"), withReplicas(5)),
+		},
+		{
+			name:   "same edit on both sides",
+			mine:   testSpec(withImage("nginx:1.28")),
+			theirs: testSpec(withImage("nginx:1.28")),
+			want:   testSpec(withImage("nginx:1.28")),
+		},
+		{
+			name:          "same field edited differently conflicts and keeps mine",
+			mine:          testSpec(withImage("nginx:1.28")),
+			theirs:        testSpec(withImage("nginx:1.29")),
+			want:          testSpec(withImage("nginx:1.28")),
+			wantConflicts: []string{"TaskTemplate.ContainerSpec.Image"},
+		},
+		{
+			name:   "key removed on my side, another added on theirs",
+			mine:   testSpec(withLabels(map[string]string{"tier": "front"})),
+			theirs: testSpec(withLabels(map[string]string{"tier": "front", "team": "ops", "env": "prod"})),
+			want:   testSpec(withLabels(map[string]string{"tier": "front", "env": "prod"})),
+		},
+		{
+			name:   "key removed on their side",
+			mine:   testSpec(withImage("nginx:1.28")),
+			theirs: testSpec(withLabels(map[string]string{"tier": "front"})),
+			want:   testSpec(withImage("nginx:1.28"), withLabels(map[string]string{"tier": "front"})),
+		},
+		{
+			name:          "key removed on one side and changed on the other",
+			mine:          testSpec(withLabels(map[string]string{"tier": "front"})),
+			theirs:        testSpec(withLabels(map[string]string{"tier": "front", "team": "dev"})),
+			want:          testSpec(withLabels(map[string]string{"tier": "front"})),
+			wantConflicts: []string{"Labels.team"},
+		},
+		{
+			name:   "a list edited on one side is taken whole",
+			mine:   testSpec(withEnv("A=1", "B=2")),
+			theirs: testSpec(withReplicas(5)),
+			want:   testSpec(withEnv("A=1", "B=2"), withReplicas(5)),
+		},
+		{
+			name:          "lists are replaced whole, not merged by item",
+			mine:          testSpec(withEnv("A=1", "B=2")),
+			theirs:        testSpec(withEnv("A=1", "C=3")),
+			want:          testSpec(withEnv("A=1", "B=2")),
+			wantConflicts: []string{"TaskTemplate.ContainerSpec.Env"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, conflicts, err := MergeServiceSpecs(testSpec(), tt.mine, tt.theirs)
+			if err != nil {
+				t.Fatalf("MergeServiceSpecs failed: %v", err)
+			}
+			if !slices.Equal(conflicts, tt.wantConflicts) {
+				t.Errorf("conflicts = %q, want %q", conflicts, tt.wantConflicts)
+			}
+			gotDoc, err := ServiceSpecDocument(got.Name, got)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantDoc, err := ServiceSpecDocument(tt.want.Name, tt.want)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(gotDoc) != string(wantDoc) {
+				t.Errorf("merged spec:\n%s\nwant:\n%s", gotDoc, wantDoc)
+			}
+		})
+	}
+}
+
+func TestMerge3(t *testing.T) {
+	tests := []struct {
+		name               string
+		base, mine, theirs any
+		want               any
+		wantConflicts      []string
+	}{
+		{"unchanged", "a", "a", "a", "a", nil},
+		{"mine changed", "a", "b", "a", "b", nil},
+		{"theirs changed", "a", "a", "c", "c", nil},
+		{"both changed alike", "a", "b", "b", "b", nil},
+		{"both changed differently", "a", "b", "c", "b", []string{"x"}},
+		{"added on my side", nil, "b", nil, "b", nil},
+		{"removed on their side", "a", "a", nil, nil, nil},
+		{"added differently on both sides", nil, "b", "c", "b", []string{"x"}},
+		{
+			"nested objects merge by key",
+			map[string]any{"a": 1.0, "b": 1.0},
+			map[string]any{"a": 2.0, "b": 1.0},
+			map[string]any{"a": 1.0, "b": 3.0},
+			map[string]any{"a": 2.0, "b": 3.0},
+			nil,
+		},
+		{
+			"nested conflict is reported by path",
+			map[string]any{"a": map[string]any{"b": 1.0}},
+			map[string]any{"a": map[string]any{"b": 2.0}},
+			map[string]any{"a": map[string]any{"b": 3.0}},
+			map[string]any{"a": map[string]any{"b": 2.0}},
+			[]string{"x.a.b"},
+		},
+		{
+			"an object replacing a scalar conflicts",
+			"a",
+			map[string]any{"b": 1.0},
+			"c",
+			map[string]any{"b": 1.0},
+			[]string{"x"},
+		},
+		{"lists are values", []any{"a"}, []any{"a", "b"}, []any{"c"}, []any{"a", "b"}, []string{"x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var conflicts []string
+			got := merge3(tt.base, tt.mine, tt.theirs, "x", &conflicts)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("merge3 = %v, want %v", got, tt.want)
+			}
+			if !slices.Equal(conflicts, tt.wantConflicts) {
+				t.Errorf("conflicts = %q, want %q", conflicts, tt.wantConflicts)
+			}
+		})
+	}
+}
+
+func TestIsVersionConflict(t *testing.T) {
+	outOfSequence := errors.New("Error response from daemon: rpc error: code = Unknown desc = update out of sequence")
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"out of sequence", outOfSequence, true},
+		{"wrapped", fmt.Errorf("updating web: %w", outOfSequence), true},
+		{"other error", errors.New("service web not found"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsVersionConflict(tt.err); got != tt.want {
+				t.Errorf("IsVersionConflict(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseServiceSpec(t *testing.T) {
+	doc, err := ServiceSpecDocument("web", testSpec())
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name      string
+		data      string
+		wantImage string
+		wantErr   bool
+	}{
+		{"rendered document", string(doc), "nginx:1.27", false},
+		{"json", `{"Name": "web", "TaskTemplate": {"ContainerSpec": {"Image": "redis:7"}}}`, "redis:7", false},
+		{"unknown field", "Name: web\nTaskTemplate:\n  ContainerSpec:\n    Imag: nginx\n", "", true},
+		{"wrong type", "Name: [web]\n", "", true},
+		{"invalid yaml", "Name: web\n  Labels: {\n", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec, err := ParseServiceSpec([]byte(tt.data))
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseServiceSpec succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseServiceSpec failed: %v", err)
+			}
+			if spec.Name != "web" || spec.TaskTemplate.ContainerSpec.Image != tt.wantImage {
+				t.Errorf("ParseServiceSpec = %s %s, want web %s", spec.Name, spec.TaskTemplate.ContainerSpec.Image, tt.wantImage)
+			}
+		})
+	}
+}
+
+func TestParseServiceSpecCancelled(t *testing.T) {
+	for _, data := range []string{"", "# only comments\n# here\n"} {
+		if _, err := ParseServiceSpec([]byte(data)); !errors.Is(err, ErrEditCancelled) {
+			t.Errorf("ParseServiceSpec(%q) error = %v, want ErrEditCancelled", data, err)
+		}
+	}
+}
+
+func TestValidateServiceSpec(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    swarm.ServiceSpec
+		wantErr bool
+	}{
+		{"valid", testSpec(withImage("nginx:1.28")), false},
+		{"renamed", testSpec(func(s *swarm.ServiceSpec) { s.Name = "api" }), true},
+		{"no container spec", testSpec(func(s *swarm.ServiceSpec) { s.TaskTemplate.ContainerSpec = nil }), true},
+		{"blank image", testSpec(withImage("  ")), true},
+		{"no mode", testSpec(func(s *swarm.ServiceSpec) { s.Mode = swarm.ServiceMode{} }), true},
+		{"two modes", testSpec(func(s *swarm.ServiceSpec) { s.Mode.Global = &swarm.GlobalService{} }), true},
+		{"global job", testSpec(func(s *swarm.ServiceSpec) { s.Mode = swarm.ServiceMode{GlobalJob: &swarm.GlobalJob{}} }), false},
+		{"valid constraint", testSpec(func(s *swarm.ServiceSpec) {
+			s.TaskTemplate.Placement = &swarm.Placement{Constraints: []string{"node.role == worker"}}
+		}), false},
+		{"invalid constraint", testSpec(func(s *swarm.ServiceSpec) {
+			s.TaskTemplate.Placement = &swarm.Placement{Constraints: []string{"node.role=worker"}}
+		}), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateServiceSpec(testSpec(), tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateServiceSpec error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,534 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"swarmcli/core/compose"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// defaultNetwork is the network of the services that list none, created
+// as <stack>_default like `docker stack deploy` does.
+const defaultNetwork = "default"
+
+// contentHashLabel records the digest of the content of the configs and
+// secrets of a stack: swarm never returns the content of a secret, so it is
+// what a drift check compares.
+const contentHashLabel = "swarmcli.content-hash"
+
+// stackObject is a network, config or secret of a stack, by the name it has
+// in the swarm.
+type stackObject struct {
+	key      string // name in the compose file
+	name     string
+	external bool
+	labels   map[string]string
+	// data is the content of a config or secret.
+	data []byte
+	// network is set for networks the stack creates.
+	network *network.CreateOptions
+}
+
+// stackPlan is a compose file converted to swarm objects, before anything
+// is deployed.
+type stackPlan struct {
+	stack    string
+	networks []stackObject
+	configs  []stackObject
+	secrets  []stackObject
+	services []swarm.ServiceSpec
+}
+
+// stackName prefixes a name of the compose file with the stack, unless
+// name: set it.
+func stackName(stack, key, name string) string {
+	if name != "" {
+		return name
+	}
+	return stack + "_" + key
+}
+
+// stackLabels adds the stack label to labels.
+func stackLabels(stack string, labels map[string]string) map[string]string {
+	out := maps.Clone(labels)
+	if out == nil {
+		out = map[string]string{}
+	}
+	out[stackNamespaceLabel] = stack
+	return out
+}
+
+// contentHash is the digest of the content of a config or secret.
+func contentHash(data []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(data))
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	return slices.Sorted(maps.Keys(m))
+}
+
+// convertStack converts a compose file to the objects of stack.
+func convertStack(p *compose.Project, stack string) (*stackPlan, error) {
+	plan := &stackPlan{stack: stack}
+
+	used := map[string]bool{}
+	for _, svc := range p.Services {
+		if len(svc.Networks) == 0 {
+			used[defaultNetwork] = true
+		}
+		for name := range svc.Networks {
+			used[name] = true
+		}
+	}
+	networks := map[string]string{}
+	for _, key := range sortedKeys(used) {
+		nw, ok := p.Networks[key]
+		if !ok && key != defaultNetwork {
+			return nil, fmt.Errorf("undefined network %q", key)
+		}
+		obj, err := convertNetwork(stack, key, nw)
+		if err != nil {
+			return nil, err
+		}
+		networks[key] = obj.name
+		plan.networks = append(plan.networks, obj)
+	}
+
+	configs := map[string]string{}
+	for _, key := range sortedKeys(p.Configs) {
+		obj, err := convertFile(p, stack, "config", key, p.Configs[key])
+		if err != nil {
+			return nil, err
+		}
+		configs[key] = obj.name
+		plan.configs = append(plan.configs, obj)
+	}
+	secrets := map[string]string{}
+	for _, key := range sortedKeys(p.Secrets) {
+		obj, err := convertFile(p, stack, "secret", key, p.Secrets[key])
+		if err != nil {
+			return nil, err
+		}
+		secrets[key] = obj.name
+		plan.secrets = append(plan.secrets, obj)
+	}
+
+	for _, key := range sortedKeys(p.Services) {
+		spec, err := convertService(p, stack, key, p.Services[key], networks, configs, secrets)
+		if err != nil {
+			return nil, fmt.Errorf("service %s: %w", key, err)
+		}
+		plan.services = append(plan.services, spec)
+	}
+	return plan, nil
+}
+
+func convertNetwork(stack, key string, nw compose.Network) (stackObject, error) {
+	if nw.External.External {
+		name := nw.External.Name
+		if name == "" {
+			name = nw.Name
+		}
+		if name == "" {
+			name = key
+		}
+		return stackObject{key: key, name: name, external: true}, nil
+	}
+
+	opts := &network.CreateOptions{
+		Driver:     nw.Driver,
+		Options:    nw.DriverOpts,
+		Internal:   nw.Internal,
+		Attachable: nw.Attachable,
+		Labels:     stackLabels(stack, nw.Labels.Values(nil)),
+		Scope:      "swarm",
+	}
+	if opts.Driver == "" {
+		opts.Driver = "overlay"
+	}
+	if nw.IPAM.Driver != "" || len(nw.IPAM.Config) > 0 {
+		opts.IPAM = &network.IPAM{Driver: nw.IPAM.Driver}
+		for _, c := range nw.IPAM.Config {
+			opts.IPAM.Config = append(opts.IPAM.Config, network.IPAMConfig{Subnet: c.Subnet})
+		}
+	}
+	return stackObject{key: key, name: stackName(stack, key, nw.Name), labels: opts.Labels, network: opts}, nil
+}
+
+func convertFile(p *compose.Project, stack, kind, key string, f compose.File) (stackObject, error) {
+	if f.External.External {
+		name := f.External.Name
+		if name == "" {
+			name = f.Name
+		}
+		if name == "" {
+			name = key
+		}
+		return stackObject{key: key, name: name, external: true}, nil
+	}
+	if f.File == "" {
+		return stackObject{}, fmt.Errorf("%s %s: file is required", kind, key)
+	}
+	data, err := os.ReadFile(p.Path(f.File))
+	if err != nil {
+		return stackObject{}, fmt.Errorf("%s %s: %w", kind, key, err)
+	}
+	labels := stackLabels(stack, f.Labels.Values(nil))
+	labels[contentHashLabel] = contentHash(data)
+	return stackObject{
+		key:    key,
+		name:   stackName(stack, key, f.Name),
+		labels: labels,
+		data:   data,
+	}, nil
+}
+
+// convertService builds the spec of a service; configs and secrets are
+// referenced by name, their IDs are set once they exist.
+func convertService(p *compose.Project, stack, key string, svc compose.Service,
+	networks, configs, secrets map[string]string) (swarm.ServiceSpec, error) {
+	var spec swarm.ServiceSpec
+	if svc.Image == "" {
+		return spec, fmt.Errorf("image is required")
+	}
+	spec.Name = stack + "_" + key
+	spec.Labels = stackLabels(stack, svc.Deploy.Labels.Values(nil))
+
+	env, err := p.ReadEnvFiles(svc)
+	if err != nil {
+		return spec, err
+	}
+	maps.Copy(env, svc.Environment.Values(p.Lookup))
+	envList := make([]string, 0, len(env))
+	for _, k := range sortedKeys(env) {
+		envList = append(envList, k+"="+env[k])
+	}
+
+	cs := &swarm.ContainerSpec{
+		Image:           svc.Image,
+		Labels:          stackLabels(stack, svc.Labels.Values(nil)),
+		Command:         svc.Entrypoint,
+		Args:            svc.Command,
+		Env:             envList,
+		Hostname:        svc.Hostname,
+		User:            svc.User,
+		Dir:             svc.WorkingDir,
+		StopSignal:      svc.StopSignal,
+		TTY:             svc.Tty,
+		OpenStdin:       svc.StdinOpen,
+		ReadOnly:        svc.ReadOnly,
+		Init:            svc.Init,
+		CapabilityAdd:   svc.CapAdd,
+		CapabilityDrop:  svc.CapDrop,
+		StopGracePeriod: durationPtr(svc.StopGracePeriod),
+	}
+	if len(svc.DNS) > 0 || len(svc.DNSSearch) > 0 {
+		cs.DNSConfig = &swarm.DNSConfig{Nameservers: svc.DNS, Search: svc.DNSSearch}
+	}
+	for _, h := range svc.ExtraHosts {
+		host, ip, ok := strings.Cut(h, ":")
+		if !ok {
+			return spec, fmt.Errorf("invalid extra_hosts entry %q (host:ip)", h)
+		}
+		cs.Hosts = append(cs.Hosts, ip+" "+host)
+	}
+	if cs.Healthcheck, err = convertHealthcheck(svc.Healthcheck); err != nil {
+		return spec, err
+	}
+	if cs.Mounts, err = convertMounts(p, stack, svc.Volumes); err != nil {
+		return spec, err
+	}
+	for _, ref := range svc.Configs {
+		name, ok := configs[ref.Source]
+		if !ok {
+			return spec, fmt.Errorf("undefined config %q", ref.Source)
+		}
+		target := ref.Target
+		if target == "" {
+			target = "/" + ref.Source
+		}
+		cs.Configs = append(cs.Configs, &swarm.ConfigReference{
+			ConfigName: name,
+			File:       fileTarget(ref, target),
+		})
+	}
+	for _, ref := range svc.Secrets {
+		name, ok := secrets[ref.Source]
+		if !ok {
+			return spec, fmt.Errorf("undefined secret %q", ref.Source)
+		}
+		target := ref.Target
+		if target == "" {
+			target = ref.Source
+		}
+		f := fileTarget(ref, target)
+		cs.Secrets = append(cs.Secrets, &swarm.SecretReference{
+			SecretName: name,
+			File:       &swarm.SecretReferenceFileTarget{Name: f.Name, UID: f.UID, GID: f.GID, Mode: f.Mode},
+		})
+	}
+	spec.TaskTemplate.ContainerSpec = cs
+
+	if svc.Logging != nil {
+		spec.TaskTemplate.LogDriver = &swarm.Driver{Name: svc.Logging.Driver, Options: svc.Logging.Options}
+	}
+
+	attached := map[string][]string{}
+	if len(svc.Networks) == 0 {
+		attached[defaultNetwork] = nil
+	}
+	maps.Copy(attached, svc.Networks)
+	for _, name := range sortedKeys(attached) {
+		spec.TaskTemplate.Networks = append(spec.TaskTemplate.Networks, swarm.NetworkAttachmentConfig{
+			Target:  networks[name],
+			Aliases: append([]string{key}, attached[name]...),
+		})
+	}
+
+	if err := convertDeploy(&spec, svc.Deploy); err != nil {
+		return spec, err
+	}
+
+	ep := &swarm.EndpointSpec{Mode: swarm.ResolutionModeVIP}
+	switch svc.Deploy.EndpointMode {
+	case "", "vip":
+	case "dnsrr":
+		ep.Mode = swarm.ResolutionModeDNSRR
+	default:
+		return spec, fmt.Errorf("invalid endpoint_mode %q", svc.Deploy.EndpointMode)
+	}
+	for _, port := range svc.Ports {
+		pc := swarm.PortConfig{
+			TargetPort:    port.Target,
+			PublishedPort: port.Published,
+			Protocol:      swarm.PortConfigProtocol(port.Protocol),
+			PublishMode:   swarm.PortConfigPublishMode(port.Mode),
+		}
+		if pc.Protocol == "" {
+			pc.Protocol = swarm.PortConfigProtocolTCP
+		}
+		if pc.PublishMode == "" {
+			pc.PublishMode = swarm.PortConfigPublishModeIngress
+		}
+		ep.Ports = append(ep.Ports, pc)
+	}
+	spec.EndpointSpec = ep
+	return spec, nil
+}
+
+func durationPtr(d *compose.Duration) *time.Duration {
+	if d == nil {
+		return nil
+	}
+	v := time.Duration(*d)
+	return &v
+}
+
+func fileTarget(ref compose.FileRef, target string) *swarm.ConfigReferenceFileTarget {
+	f := &swarm.ConfigReferenceFileTarget{Name: target, UID: ref.UID, GID: ref.GID, Mode: 0o444}
+	if f.UID == "" {
+		f.UID = "0"
+	}
+	if f.GID == "" {
+		f.GID = "0"
+	}
+	if ref.Mode != nil {
+		f.Mode = os.FileMode(*ref.Mode)
+	}
+	return f
+}
+
+func convertHealthcheck(hc *compose.Healthcheck) (*container.HealthConfig, error) {
+	if hc == nil {
+		return nil, nil
+	}
+	if hc.Disable {
+		return &container.HealthConfig{Test: []string{"NONE"}}, nil
+	}
+	out := &container.HealthConfig{Test: hc.Test}
+	if len(hc.Test) == 1 && hc.Test[0] != "NONE" {
+		out.Test = []string{"CMD-SHELL", hc.Test[0]}
+	}
+	if len(out.Test) > 0 && !slices.Contains([]string{"CMD", "CMD-SHELL", "NONE"}, out.Test[0]) {
+		return nil, fmt.Errorf("healthcheck test must start with CMD, CMD-SHELL or NONE")
+	}
+	if p := durationPtr(hc.Interval); p != nil {
+		out.Interval = *p
+	}
+	if p := durationPtr(hc.Timeout); p != nil {
+		out.Timeout = *p
+	}
+	if p := durationPtr(hc.StartPeriod); p != nil {
+		out.StartPeriod = *p
+	}
+	if hc.Retries != nil {
+		out.Retries = int(*hc.Retries)
+	}
+	return out, nil
+}
+
+func convertMounts(p *compose.Project, stack string, volumes []compose.Mount) ([]mount.Mount, error) {
+	var mounts []mount.Mount
+	for _, v := range volumes {
+		m := mount.Mount{Target: v.Target, ReadOnly: v.ReadOnly}
+		switch v.Type {
+		case "bind":
+			m.Type = mount.TypeBind
+			m.Source = p.Path(v.Source)
+		case "tmpfs":
+			m.Type = mount.TypeTmpfs
+		case "volume", "":
+			m.Type = mount.TypeVolume
+			if v.Source == "" {
+				break
+			}
+			vol, ok := p.Volumes[v.Source]
+			if !ok {
+				return nil, fmt.Errorf("undefined volume %q", v.Source)
+			}
+			if vol.External.External {
+				m.Source = v.Source
+				if vol.External.Name != "" {
+					m.Source = vol.External.Name
+				} else if vol.Name != "" {
+					m.Source = vol.Name
+				}
+				break
+			}
+			m.Source = stackName(stack, v.Source, vol.Name)
+			m.VolumeOptions = &mount.VolumeOptions{Labels: stackLabels(stack, vol.Labels.Values(nil))}
+			if vol.Driver != "" {
+				m.VolumeOptions.DriverConfig = &mount.Driver{Name: vol.Driver, Options: vol.DriverOpts}
+			}
+		default:
+			return nil, fmt.Errorf("unsupported volume type %q", v.Type)
+		}
+		mounts = append(mounts, m)
+	}
+	return mounts, nil
+}
+
+func convertDeploy(spec *swarm.ServiceSpec, d compose.Deploy) error {
+	var replicas uint64 = 1
+	if d.Replicas != nil {
+		replicas = *d.Replicas
+	}
+	switch d.Mode {
+	case "", ModeReplicated:
+		spec.Mode.Replicated = &swarm.ReplicatedService{Replicas: &replicas}
+	case ModeGlobal:
+		spec.Mode.Global = &swarm.GlobalService{}
+	case ModeReplicatedJob:
+		spec.Mode.ReplicatedJob = &swarm.ReplicatedJob{MaxConcurrent: &replicas, TotalCompletions: &replicas}
+	case ModeGlobalJob:
+		spec.Mode.GlobalJob = &swarm.GlobalJob{}
+	default:
+		return fmt.Errorf("invalid mode %q (use %s)", d.Mode, strings.Join(ServiceModes, ", "))
+	}
+	if d.Replicas != nil && (spec.Mode.Global != nil || spec.Mode.GlobalJob != nil) {
+		return fmt.Errorf("%s services have no replica count", d.Mode)
+	}
+
+	tt := &spec.TaskTemplate
+	if r := d.Resources; r.Limits != nil || r.Reservations != nil {
+		tt.Resources = &swarm.ResourceRequirements{}
+		if r.Limits != nil {
+			tt.Resources.Limits = &swarm.Limit{
+				NanoCPUs:    int64(r.Limits.CPUs),
+				MemoryBytes: int64(r.Limits.Memory),
+				Pids:        r.Limits.Pids,
+			}
+		}
+		if r.Reservations != nil {
+			tt.Resources.Reservations = &swarm.Resources{
+				NanoCPUs:    int64(r.Reservations.CPUs),
+				MemoryBytes: int64(r.Reservations.Memory),
+			}
+		}
+	}
+
+	if rp := d.RestartPolicy; rp != nil {
+		tt.RestartPolicy = &swarm.RestartPolicy{
+			Delay:       durationPtr(rp.Delay),
+			MaxAttempts: rp.MaxAttempts,
+			Window:      durationPtr(rp.Window),
+		}
+		switch rp.Condition {
+		case "", "any":
+			tt.RestartPolicy.Condition = swarm.RestartPolicyConditionAny
+		case "on-failure":
+			tt.RestartPolicy.Condition = swarm.RestartPolicyConditionOnFailure
+		case "none", "no":
+			tt.RestartPolicy.Condition = swarm.RestartPolicyConditionNone
+		default:
+			return fmt.Errorf("invalid restart_policy condition %q", rp.Condition)
+		}
+	} else if spec.Mode.ReplicatedJob != nil || spec.Mode.GlobalJob != nil {
+		tt.RestartPolicy = &swarm.RestartPolicy{Condition: swarm.RestartPolicyConditionOnFailure}
+	}
+
+	pl := d.Placement
+	for _, c := range pl.Constraints {
+		if _, err := parseConstraint(c); err != nil {
+			return err
+		}
+	}
+	if len(pl.Constraints) > 0 || len(pl.Preferences) > 0 || pl.MaxReplicas > 0 {
+		tt.Placement = &swarm.Placement{Constraints: pl.Constraints, MaxReplicas: pl.MaxReplicas}
+		for _, pref := range pl.Preferences {
+			tt.Placement.Preferences = append(tt.Placement.Preferences, swarm.PlacementPreference{
+				Spread: &swarm.SpreadOver{SpreadDescriptor: pref.Spread},
+			})
+		}
+	}
+
+	var err error
+	if spec.UpdateConfig, err = convertUpdateConfig(d.UpdateConfig); err != nil {
+		return fmt.Errorf("update_config: %w", err)
+	}
+	if spec.RollbackConfig, err = convertUpdateConfig(d.RollbackConfig); err != nil {
+		return fmt.Errorf("rollback_config: %w", err)
+	}
+	return nil
+}
+
+func convertUpdateConfig(u *compose.UpdateConfig) (*swarm.UpdateConfig, error) {
+	if u == nil {
+		return nil, nil
+	}
+	out := &swarm.UpdateConfig{
+		Parallelism:     1,
+		Delay:           time.Duration(u.Delay),
+		FailureAction:   u.FailureAction,
+		Monitor:         time.Duration(u.Monitor),
+		MaxFailureRatio: u.MaxFailureRatio,
+		Order:           u.Order,
+	}
+	if u.Parallelism != nil {
+		out.Parallelism = *u.Parallelism
+	}
+	switch out.FailureAction {
+	case "", swarm.UpdateFailureActionPause, swarm.UpdateFailureActionContinue, swarm.UpdateFailureActionRollback:
+	default:
+		return nil, fmt.Errorf("invalid failure_action %q", out.FailureAction)
+	}
+	switch out.Order {
+	case "", swarm.UpdateOrderStopFirst, swarm.UpdateOrderStartFirst:
+	default:
+		return nil, fmt.Errorf("invalid order %q", out.Order)
+	}
+	return out, nil
+}
@@ -0,0 +1,307 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"swarmcli/core/compose"
+
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// testPlan converts a compose document, with its files in a temp dir, as
+// stack "app".
+func testPlan(t *testing.T, doc string, files map[string]string) (*stackPlan, error) {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p, err := compose.Parse([]byte(doc), dir, map[string]string{"TAG": "1.27", "GREETING": "hi"})
+	if err != nil {
+		t.Fatalf("parsing compose file: %v", err)
+	}
+	return convertStack(p, "app")
+}
+
+func TestConvertService(t *testing.T) {
+	tests := []struct {
+		name  string
+		doc   string
+		files map[string]string
+		check func(t *testing.T, spec swarm.ServiceSpec)
+	}{
+		{
+			name: "defaults",
+			doc:  "services:\n  web:\n    image: nginx:${TAG}\n",
+			check: func(t *testing.T, spec swarm.ServiceSpec) {
+				if spec.Name != "app_web" {
+					t.Errorf("name = %q, want app_web", spec.Name)
+				}
+				if got := spec.Labels[stackNamespaceLabel]; got != "app" {
+					t.Errorf("stack label = %q, want app", got)
+				}
+				cs := spec.TaskTemplate.ContainerSpec
+				if cs.Image != "nginx:1.27" {
+					t.Errorf("image = %q, want nginx:1.27", cs.Image)
+				}
+				if cs.Labels[stackNamespaceLabel] != "app" {
+					t.Errorf("container labels = %v, want the stack label", cs.Labels)
+				}
+				if r := spec.Mode.Replicated; r == nil || *r.Replicas != 1 {
+					t.Errorf("mode = %+v, want 1 replica", spec.Mode)
+				}
+				nets := spec.TaskTemplate.Networks
+				if len(nets) != 1 || nets[0].Target != "app_default" || !slices.Equal(nets[0].Aliases, []string{"web"}) {
+					t.Errorf("networks = %+v, want app_default aliased web", nets)
+				}
+				if spec.EndpointSpec.Mode != swarm.ResolutionModeVIP || len(spec.EndpointSpec.Ports) != 0 {
+					t.Errorf("endpoint = %+v, want vip without ports", spec.EndpointSpec)
+				}
+			},
+		},
+		{
+			name: "environment and env_file",
+			doc: "services:\n  web:\n    image: nginx\n    env_file: [web.env]\n" +
+				"    environment:\n      LEVEL: debug\n      GREETING:\n",
+			files: map[string]string{"web.env": "LEVEL=info\nMODE=prod\n"},
+			check: func(t *testing.T, spec swarm.ServiceSpec) {
+				want := []string{"GREETING=hi", "LEVEL=debug", "MODE=prod"}
+				if got := spec.TaskTemplate.ContainerSpec.Env; !slices.Equal(got, want) {
+					t.Errorf("env = %q, want %q", got, want)
+				}
+			},
+		},
+		{
+			name: "entrypoint, command and extra hosts",
+			doc: "services:\n  web:\n    image: nginx\n    entrypoint: [/bin/sh, -c]\n    command: [echo, hi]\n" +
+				"    extra_hosts: [\"db:10.0.0.2\"]\n",
+			check: func(t *testing.T, spec swarm.ServiceSpec) {
+				cs := spec.TaskTemplate.ContainerSpec
+				if !slices.Equal(cs.Command, []string{"/bin/sh", "-c"}) || !slices.Equal(cs.Args, []string{"echo", "hi"}) {
+					t.Errorf("command = %q, args = %q", cs.Command, cs.Args)
+				}
+				if !slices.Equal(cs.Hosts, []string{"10.0.0.2 db"}) {
+					t.Errorf("hosts = %q, want [10.0.0.2 db]", cs.Hosts)
+				}
+			},
+		},
+		{
+			name: "ports",
+			doc:  "services:\n  web:\n    image: nginx\n    ports: [\"8080:80\", \"53:53/udp\"]\n",
+			check: func(t *testing.T, spec swarm.ServiceSpec) {
+				want := []swarm.PortConfig{
+					{TargetPort: 80, PublishedPort: 8080, Protocol: swarm.PortConfigProtocolTCP, PublishMode: swarm.PortConfigPublishModeIngress},
+					{TargetPort: 53, PublishedPort: 53, Protocol: swarm.PortConfigProtocolUDP, PublishMode: swarm.PortConfigPublishModeIngress},
+				}
+				if got := spec.EndpointSpec.Ports; !slices.Equal(got, want) {
+					t.Errorf("ports = %+v, want %+v", got, want)
+				}
+			},
+		},
+		{
+			name: "deploy",
+			doc: "services:\n  web:\n    image: nginx\n    deploy:\n      replicas: 3\n      endpoint_mode: dnsrr\n" +
+				"      labels: [tier=front]\n      placement:\n        constraints: [node.role==worker]\n" +
+				"      restart_policy:\n        condition: on-failure\n        delay: 5s\n" +
+				"      update_config:\n        parallelism: 2\n        order: start-first\n",
+			check: func(t *testing.T, spec swarm.ServiceSpec) {
+				if r := spec.Mode.Replicated; r == nil || *r.Replicas != 3 {
+					t.Errorf("mode = %+v, want 3 replicas", spec.Mode)
+				}
+				if spec.EndpointSpec.Mode != swarm.ResolutionModeDNSRR {
+					t.Errorf("endpoint mode = %q, want dnsrr", spec.EndpointSpec.Mode)
+				}
+				if spec.Labels["tier"] != "front" {
+					t.Errorf("labels = %v, want tier=front", spec.Labels)
+				}
+				tt := spec.TaskTemplate
+				if tt.Placement == nil || !slices.Equal(tt.Placement.Constraints, []string{"node.role==worker"}) {
+					t.Errorf("placement = %+v", tt.Placement)
+				}
+				rp := tt.RestartPolicy
+				if rp == nil || rp.Condition != swarm.RestartPolicyConditionOnFailure || rp.Delay == nil || *rp.Delay != 5*time.Second {
+					t.Errorf("restart policy = %+v", rp)
+				}
+				uc := spec.UpdateConfig
+				if uc == nil || uc.Parallelism != 2 || uc.Order != swarm.UpdateOrderStartFirst {
+					t.Errorf("update config = %+v", uc)
+				}
+			},
+		},
+		{
+			name: "global job restarts on failure",
+			doc:  "services:\n  migrate:\n    image: migrate\n    deploy:\n      mode: global-job\n",
+			check: func(t *testing.T, spec swarm.ServiceSpec) {
+				if spec.Mode.GlobalJob == nil {
+					t.Errorf("mode = %+v, want global-job", spec.Mode)
+				}
+				if rp := spec.TaskTemplate.RestartPolicy; rp == nil || rp.Condition != swarm.RestartPolicyConditionOnFailure {
+					t.Errorf("restart policy = %+v, want on-failure", rp)
+				}
+			},
+		},
+		{
+			name: "healthcheck",
+			doc: "services:\n  web:\n    image: nginx\n    healthcheck:\n      test: curl -f localhost\n" +
+				"      interval: 10s\n      retries: 3\n",
+			check: func(t *testing.T, spec swarm.ServiceSpec) {
+				hc := spec.TaskTemplate.ContainerSpec.Healthcheck
+				if hc == nil || !slices.Equal(hc.Test, []string{"CMD-SHELL", "curl -f localhost"}) ||
+					hc.Interval != 10*time.Second || hc.Retries != 3 {
+					t.Errorf("healthcheck = %+v", hc)
+				}
+			},
+		},
+		{
+			name: "networks, configs, secrets and volumes",
+			doc: "services:\n  web:\n    image: nginx\n    networks:\n      front:\n        aliases: [www]\n" +
+				"    configs: [site]\n    secrets:\n      - source: key\n        target: tls.key\n        mode: 0400\n" +
+				"    volumes: [\"data:/var/lib/data\", \"./html:/usr/share/nginx/html:ro\"]\n" +
+				"networks:\n  front:\n" +
+				"configs:\n  site:\n    file: site.conf\n" +
+				"secrets:\n  key:\n    external: true\n    name: tls_key\n" +
+				"volumes:\n  data:\n",
+			files: map[string]string{"site.conf": "server {}"},
+			check: func(t *testing.T, spec swarm.ServiceSpec) {
+				nets := spec.TaskTemplate.Networks
+				if len(nets) != 1 || nets[0].Target != "app_front" || !slices.Equal(nets[0].Aliases, []string{"web", "www"}) {
+					t.Errorf("networks = %+v, want app_front aliased web and www", nets)
+				}
+				cs := spec.TaskTemplate.ContainerSpec
+				if len(cs.Configs) != 1 || cs.Configs[0].ConfigName != "app_site" || cs.Configs[0].File.Name != "/site" {
+					t.Errorf("configs = %+v, want app_site at /site", cs.Configs)
+				}
+				if len(cs.Secrets) != 1 || cs.Secrets[0].SecretName != "tls_key" ||
+					cs.Secrets[0].File.Name != "tls.key" || cs.Secrets[0].File.Mode != 0o400 {
+					t.Errorf("secrets = %+v, want tls_key at tls.key mode 0400", cs.Secrets)
+				}
+				if len(cs.Mounts) != 2 {
+					t.Fatalf("mounts = %+v, want 2", cs.Mounts)
+				}
+				if m := cs.Mounts[0]; m.Type != mount.TypeVolume || m.Source != "app_data" || m.Target != "/var/lib/data" {
+					t.Errorf("volume mount = %+v", m)
+				}
+				if m := cs.Mounts[1]; m.Type != mount.TypeBind || !filepath.IsAbs(m.Source) || !m.ReadOnly {
+					t.Errorf("bind mount = %+v", m)
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := testPlan(t, tt.doc, tt.files)
+			if err != nil {
+				t.Fatalf("convertStack failed: %v", err)
+			}
+			if len(plan.services) != 1 {
+				t.Fatalf("converted %d services, want 1", len(plan.services))
+			}
+			tt.check(t, plan.services[0])
+		})
+	}
+}
+
+func TestConvertStackObjects(t *testing.T) {
+	doc := "services:\n  web:\n    image: nginx\n    networks: [front, shared]\n" +
+		"  worker:\n    image: worker\n" +
+		"networks:\n  front:\n    driver_opts:\n      encrypted: \"true\"\n  shared:\n    external: true\n" +
+		"configs:\n  site:\n    file: site.conf\n    name: site_v2\n"
+	plan, err := testPlan(t, doc, map[string]string{"site.conf": "server {}"})
+	if err != nil {
+		t.Fatalf("convertStack failed: %v", err)
+	}
+
+	var names []string
+	for _, nw := range plan.networks {
+		names = append(names, nw.name)
+	}
+	if want := []string{"app_default", "app_front", "shared"}; !slices.Equal(names, want) {
+		t.Errorf("networks = %q, want %q", names, want)
+	}
+	front := plan.networks[1]
+	if front.network.Driver != "overlay" || front.network.Scope != "swarm" || front.network.Options["encrypted"] != "true" {
+		t.Errorf("front network = %+v", front.network)
+	}
+	if !plan.networks[2].external || plan.networks[2].network != nil {
+		t.Errorf("shared network = %+v, want external", plan.networks[2])
+	}
+
+	if len(plan.configs) != 1 {
+		t.Fatalf("configs = %+v, want 1", plan.configs)
+	}
+	cfg := plan.configs[0]
+	if cfg.name != "site_v2" || string(cfg.data) != "server {}" || cfg.labels[contentHashLabel] != contentHash(cfg.data) {
+		t.Errorf("config = %+v", cfg)
+	}
+}
+
+func TestConvertStackErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		doc     string
+		wantErr string
+	}{
+		{"missing image", "services:\n  web:\n    command: [true]\n", "service web: image is required"},
+		{"undefined network", "services:\n  web:\n    image: nginx\n    networks: [back]\n", `undefined network "back"`},
+		{"undefined config", "services:\n  web:\n    image: nginx\n    configs: [site]\n", `service web: undefined config "site"`},
+		{"undefined volume", "services:\n  web:\n    image: nginx\n    volumes: [\"data:/data\"]\n", `service web: undefined volume "data"`},
+		{"config without file", "services:\n  web:\n    image: nginx\nconfigs:\n  site: {}\n", "config site: file is required"},
+		{"invalid mode", "services:\n  web:\n    image: nginx\n    deploy:\n      mode: daemon\n", `service web: invalid mode "daemon"`},
+		{"replicas of a global service", "services:\n  web:\n    image: nginx\n    deploy:\n      mode: global\n      replicas: 2\n",
+			"service web: global services have no replica count"},
+		{"invalid endpoint mode", "services:\n  web:\n    image: nginx\n    deploy:\n      endpoint_mode: rr\n",
+			`service web: invalid endpoint_mode "rr"`},
+		{"invalid extra host", "services:\n  web:\n    image: nginx\n    extra_hosts: [db]\n",
+			`service web: invalid extra_hosts entry "db" (host:ip)`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testPlan(t, tt.doc, nil)
+			if err == nil || !strings.HasPrefix(err.Error(), tt.wantErr) {
+				t.Errorf("convertStack error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckStackNetwork(t *testing.T) {
+	nw := stackObject{key: "front", name: "app_front", network: &network.CreateOptions{Driver: "overlay", Scope: "swarm"}}
+	tests := []struct {
+		name    string
+		found   network.Summary
+		wantErr string
+	}{
+		{"same stack", network.Summary{Driver: "overlay", Scope: "swarm", Labels: map[string]string{stackNamespaceLabel: "app"}}, ""},
+		{"not a stack network", network.Summary{Driver: "overlay", Scope: "swarm"},
+			`network "app_front" already exists and is not part of stack app (declare it as external to use it)`},
+		{"other stack", network.Summary{Driver: "overlay", Scope: "swarm", Labels: map[string]string{stackNamespaceLabel: "other"}},
+			`network "app_front" already exists and belongs to stack other`},
+		{"other driver", network.Summary{Driver: "bridge", Scope: "swarm", Labels: map[string]string{stackNamespaceLabel: "app"}},
+			`network "app_front" exists with driver bridge, but the compose file wants overlay (remove it to recreate it)`},
+		{"other scope", network.Summary{Driver: "overlay", Scope: "local", Labels: map[string]string{stackNamespaceLabel: "app"}},
+			`network "app_front" exists with scope local, but the compose file wants swarm (remove it to recreate it)`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkStackNetwork("app", nw, tt.found)
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("checkStackNetwork failed: %v", err)
+			case tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr):
+				t.Errorf("checkStackNetwork error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,436 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+
+	"swarmcli/core/compose"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/swarm"
+	"github.com/docker/docker/client"
+)
+
+// What a deploy or removal does to an object of the stack.
+const (
+	StepCreate    = "create"
+	StepUpdate    = "update"
+	StepRemove    = "remove"
+	StepUnchanged = "unchanged"
+	StepExternal  = "external"
+)
+
+// StackDeployOptions mirror the flags of `docker stack deploy`.
+type StackDeployOptions struct {
+	// Prune removes the services of the stack the file no longer has.
+	Prune bool
+	// WithRegistryAuth sends the registry logins of the docker CLI to the
+	// swarm agents, so they can pull private images.
+	WithRegistryAuth bool
+}
+
+// StackStep reports a network, config, secret or service of a deploy or
+// removal: when it starts, while it waits (Detail says for what), and once
+// when Done.
+type StackStep struct {
+	Kind     string // network, config, secret or service
+	Name     string
+	Action   string
+	Detail   string
+	Done     bool
+	Err      error
+	Warnings []string
+}
+
+// StackServiceReplicas counts the running and desired tasks of a service.
+type StackServiceReplicas struct {
+	Running uint64
+	Desired uint64
+}
+
+// stepReporter sends the steps of a stack operation.
+type stepReporter struct {
+	ctx      context.Context
+	progress chan<- StackStep
+}
+
+func (r stepReporter) report(step StackStep) {
+	select {
+	case r.progress <- step:
+	case <-r.ctx.Done():
+	}
+}
+
+// run reports an action on an object and does it.
+func (r stepReporter) run(kind, name, action string, do func() ([]string, error)) error {
+	r.report(StackStep{Kind: kind, Name: name, Action: action})
+	warnings, err := do()
+	r.report(StackStep{Kind: kind, Name: name, Action: action, Done: true, Err: err, Warnings: warnings})
+	return err
+}
+
+func (r stepReporter) done(kind, name, action string) {
+	r.report(StackStep{Kind: kind, Name: name, Action: action, Done: true})
+}
+
+type stackDeployer struct {
+	stepReporter
+	c    *client.Client
+	plan *stackPlan
+	opts StackDeployOptions
+}
+
+// DeployStack creates or updates the networks, configs, secrets and
+// services of a compose file as stack, like `docker stack deploy`. Each
+// object is reported on progress; the file is fully converted before
+// anything changes.
+func DeployStack(ctx context.Context, p *compose.Project, stack string, opts StackDeployOptions, progress chan<- StackStep) error {
+	if !serviceNamePattern.MatchString(stack) {
+		return fmt.Errorf("invalid stack name %q", stack)
+	}
+	plan, err := convertStack(p, stack)
+	if err != nil {
+		return err
+	}
+
+	c, err := clientFor(ctx)
+	if err != nil {
+		return fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	d := &stackDeployer{stepReporter: stepReporter{ctx, progress}, c: c, plan: plan, opts: opts}
+	l().Infof("🚀 Deploying stack %s (%d services)\n", stack, len(plan.services))
+	if err := d.deployNetworks(); err != nil {
+		return err
+	}
+	ids, err := d.deployConfigs()
+	if err != nil {
+		return err
+	}
+	secretIDs, err := d.deploySecrets()
+	if err != nil {
+		return err
+	}
+	maps.Copy(ids, secretIDs)
+	if err := d.deployServices(ids); err != nil {
+		return err
+	}
+	l().Infof("✅ Stack %s deployed\n", stack)
+	return nil
+}
+
+func (d *stackDeployer) deployNetworks() error {
+	existing, err := d.c.NetworkList(d.ctx, network.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("listing networks: %w", err)
+	}
+	byName := map[string]network.Summary{}
+	for _, n := range existing {
+		byName[n.Name] = n
+	}
+	for _, nw := range d.plan.networks {
+		found, ok := byName[nw.name]
+		switch {
+		case nw.external:
+			if !ok || found.Scope != "swarm" {
+				err := fmt.Errorf("network %q is declared as external, but could not be found (create it with scope swarm)", nw.name)
+				d.report(StackStep{Kind: "network", Name: nw.name, Action: StepExternal, Done: true, Err: err})
+				return err
+			}
+			d.done("network", nw.name, StepExternal)
+		case ok:
+			if err := checkStackNetwork(d.plan.stack, nw, found); err != nil {
+				d.report(StackStep{Kind: "network", Name: nw.name, Action: StepUnchanged, Done: true, Err: err})
+				return err
+			}
+			d.done("network", nw.name, StepUnchanged)
+		default:
+			err := d.run("network", nw.name, StepCreate, func() ([]string, error) {
+				resp, err := d.c.NetworkCreate(d.ctx, nw.name, *nw.network)
+				if err != nil {
+					return nil, fmt.Errorf("creating network %s: %w", nw.name, err)
+				}
+				l().Infof("🌐 Network %s created (%s)\n", nw.name, resp.ID)
+				if resp.Warning != "" {
+					return []string{resp.Warning}, nil
+				}
+				return nil, nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkStackNetwork checks that an existing network of the same name is the
+// one the stack would create: networks are never updated, so deploying
+// against another stack's network, or one with another driver or scope,
+// would attach the services to the wrong network.
+func checkStackNetwork(stack string, nw stackObject, found network.Summary) error {
+	if owner := found.Labels[stackNamespaceLabel]; owner != stack {
+		if owner == "" {
+			return fmt.Errorf("network %q already exists and is not part of stack %s (declare it as external to use it)", nw.name, stack)
+		}
+		return fmt.Errorf("network %q already exists and belongs to stack %s", nw.name, owner)
+	}
+	if found.Driver != nw.network.Driver {
+		return fmt.Errorf("network %q exists with driver %s, but the compose file wants %s (remove it to recreate it)",
+			nw.name, found.Driver, nw.network.Driver)
+	}
+	if found.Scope != nw.network.Scope {
+		return fmt.Errorf("network %q exists with scope %s, but the compose file wants %s (remove it to recreate it)",
+			nw.name, found.Scope, nw.network.Scope)
+	}
+	return nil
+}
+
+func nameFilter(objects []stackObject) filters.Args {
+	args := filters.NewArgs()
+	for _, o := range objects {
+		args.Add("name", o.name)
+	}
+	return args
+}
+
+// deployConfigs creates the configs of the stack, or updates their
+// labels; swarm rejects a change of their content. It returns their IDs
+// by name.
+func (d *stackDeployer) deployConfigs() (map[string]string, error) {
+	ids := map[string]string{}
+	if len(d.plan.configs) == 0 {
+		return ids, nil
+	}
+	existing, err := d.c.ConfigList(d.ctx, swarm.ConfigListOptions{Filters: nameFilter(d.plan.configs)})
+	if err != nil {
+		return nil, fmt.Errorf("listing configs: %w", err)
+	}
+	byName := map[string]swarm.Config{}
+	for _, cfg := range existing {
+		byName[cfg.Spec.Name] = cfg
+	}
+
+	for _, obj := range d.plan.configs {
+		found, ok := byName[obj.name]
+		switch {
+		case obj.external:
+			if !ok {
+				err := fmt.Errorf("config %q is declared as external, but could not be found", obj.name)
+				d.report(StackStep{Kind: "config", Name: obj.name, Action: StepExternal, Done: true, Err: err})
+				return nil, err
+			}
+			d.done("config", obj.name, StepExternal)
+		case ok && bytes.Equal(found.Spec.Data, obj.data) && maps.Equal(found.Spec.Labels, obj.labels):
+			d.done("config", obj.name, StepUnchanged)
+		case ok && !bytes.Equal(found.Spec.Data, obj.data):
+			err := fmt.Errorf("config %s has changed, but configs cannot be updated: give it a new name", obj.name)
+			d.report(StackStep{Kind: "config", Name: obj.name, Action: StepUpdate, Done: true, Err: err})
+			return nil, err
+		case ok:
+			err := d.run("config", obj.name, StepUpdate, func() ([]string, error) {
+				spec := found.Spec
+				spec.Labels = obj.labels
+				if err := d.c.ConfigUpdate(d.ctx, found.ID, found.Version, spec); err != nil {
+					return nil, fmt.Errorf("updating config %s: %w", obj.name, err)
+				}
+				return nil, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		default:
+			err := d.run("config", obj.name, StepCreate, func() ([]string, error) {
+				resp, err := d.c.ConfigCreate(d.ctx, swarm.ConfigSpec{
+					Annotations: swarm.Annotations{Name: obj.name, Labels: obj.labels},
+					Data:        obj.data,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("creating config %s: %w", obj.name, err)
+				}
+				found.ID = resp.ID
+				return nil, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		ids["config/"+obj.name] = found.ID
+	}
+	return ids, nil
+}
+
+// deploySecrets creates the secrets of the stack, or updates their labels
+// like deployConfigs. It returns their IDs by name.
+func (d *stackDeployer) deploySecrets() (map[string]string, error) {
+	ids := map[string]string{}
+	if len(d.plan.secrets) == 0 {
+		return ids, nil
+	}
+	existing, err := d.c.SecretList(d.ctx, swarm.SecretListOptions{Filters: nameFilter(d.plan.secrets)})
+	if err != nil {
+		return nil, fmt.Errorf("listing secrets: %w", err)
+	}
+	byName := map[string]swarm.Secret{}
+	for _, s := range existing {
+		byName[s.Spec.Name] = s
+	}
+
+	for _, obj := range d.plan.secrets {
+		found, ok := byName[obj.name]
+		switch {
+		case obj.external:
+			if !ok {
+				err := fmt.Errorf("secret %q is declared as external, but could not be found", obj.name)
+				d.report(StackStep{Kind: "secret", Name: obj.name, Action: StepExternal, Done: true, Err: err})
+				return nil, err
+			}
+			d.done("secret", obj.name, StepExternal)
+		case ok:
+			// The content of a secret cannot be read back; swarm refuses
+			// the update when it differs.
+			err := d.run("secret", obj.name, StepUpdate, func() ([]string, error) {
+				spec := found.Spec
+				spec.Data, spec.Labels = obj.data, obj.labels
+				if err := d.c.SecretUpdate(d.ctx, found.ID, found.Version, spec); err != nil {
+					return nil, fmt.Errorf("updating secret %s: %w", obj.name, err)
+				}
+				return nil, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		default:
+			err := d.run("secret", obj.name, StepCreate, func() ([]string, error) {
+				resp, err := d.c.SecretCreate(d.ctx, swarm.SecretSpec{
+					Annotations: swarm.Annotations{Name: obj.name, Labels: obj.labels},
+					Data:        obj.data,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("creating secret %s: %w", obj.name, err)
+				}
+				found.ID = resp.ID
+				return nil, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		ids["secret/"+obj.name] = found.ID
+	}
+	return ids, nil
+}
+
+func stackFilter(stack string) filters.Args {
+	return filters.NewArgs(filters.Arg("label", stackNamespaceLabel+"="+stack))
+}
+
+// deployServices creates or updates every service, then prunes. A failed
+// service does not stop the others; the errors are returned together.
+func (d *stackDeployer) deployServices(ids map[string]string) error {
+	existing, err := d.c.ServiceList(d.ctx, swarm.ServiceListOptions{Filters: stackFilter(d.plan.stack)})
+	if err != nil {
+		return fmt.Errorf("listing services: %w", err)
+	}
+	byName := map[string]swarm.Service{}
+	for _, svc := range existing {
+		byName[svc.Spec.Name] = svc
+	}
+
+	auths := map[string]string{}
+	var errs []error
+	for _, spec := range d.plan.services {
+		cs := spec.TaskTemplate.ContainerSpec
+		for _, ref := range cs.Configs {
+			ref.ConfigID = ids["config/"+ref.ConfigName]
+		}
+		for _, ref := range cs.Secrets {
+			ref.SecretID = ids["secret/"+ref.SecretName]
+		}
+
+		var auth string
+		if d.opts.WithRegistryAuth {
+			var ok bool
+			if auth, ok = auths[cs.Image]; !ok {
+				if auth, err = RegistryAuth(cs.Image); err != nil {
+					l().Warnf("⚠️  No registry login for %s: %v\n", cs.Image, err)
+				}
+				auths[cs.Image] = auth
+			}
+		}
+
+		svc, ok := byName[spec.Name]
+		if ok {
+			delete(byName, spec.Name)
+			// Keep the force counter, or the update would not restart
+			// what `docker service update --force` restarted.
+			spec.TaskTemplate.ForceUpdate = svc.Spec.TaskTemplate.ForceUpdate
+			errs = append(errs, d.run("service", spec.Name, StepUpdate, func() ([]string, error) {
+				opts := swarm.ServiceUpdateOptions{QueryRegistry: true, EncodedRegistryAuth: auth}
+				if auth == "" {
+					opts.RegistryAuthFrom = types.RegistryAuthFromSpec
+				}
+				resp, err := d.c.ServiceUpdate(d.ctx, svc.ID, svc.Version, spec, opts)
+				if err != nil {
+					return nil, fmt.Errorf("updating service %s: %w", spec.Name, err)
+				}
+				l().Infof("🔄 Service %s updated\n", spec.Name)
+				return resp.Warnings, nil
+			}))
+			continue
+		}
+		errs = append(errs, d.run("service", spec.Name, StepCreate, func() ([]string, error) {
+			resp, err := d.c.ServiceCreate(d.ctx, spec, swarm.ServiceCreateOptions{QueryRegistry: true, EncodedRegistryAuth: auth})
+			if err != nil {
+				return nil, fmt.Errorf("creating service %s: %w", spec.Name, err)
+			}
+			l().Infof("✨ Service %s created (%s)\n", spec.Name, resp.ID)
+			return resp.Warnings, nil
+		}))
+	}
+
+	if d.opts.Prune {
+		for _, name := range sortedKeys(byName) {
+			svc := byName[name]
+			errs = append(errs, d.run("service", name, StepRemove, func() ([]string, error) {
+				if err := d.c.ServiceRemove(d.ctx, svc.ID); err != nil {
+					return nil, fmt.Errorf("removing service %s: %w", name, err)
+				}
+				l().Infof("🗑️  Service %s removed\n", name)
+				return nil, nil
+			}))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// StackReplicas returns the running and desired tasks of the services of
+// a stack, by service name.
+func StackReplicas(ctx context.Context, stack string) (map[string]StackServiceReplicas, error) {
+	c, err := clientFor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	services, err := c.ServiceList(ctx, swarm.ServiceListOptions{Filters: stackFilter(stack), Status: true})
+	if err != nil {
+		return nil, fmt.Errorf("listing services: %w", err)
+	}
+	out := make(map[string]StackServiceReplicas, len(services))
+	for _, svc := range services {
+		if st := svc.ServiceStatus; st != nil {
+			out[svc.Spec.Name] = StackServiceReplicas{Running: st.RunningTasks, Desired: st.DesiredTasks}
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,347 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"swarmcli/core/compose"
+
+	"github.com/distribution/reference"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// How an object of a stack differs from its compose file.
+const (
+	DriftChanged = "changed"
+	// DriftMissing is in the file but not deployed.
+	DriftMissing = "missing"
+	// DriftExtra is deployed but no longer in the file.
+	DriftExtra = "extra"
+	// DriftUnknown cannot be compared, such as the content of a secret
+	// deployed by another tool.
+	DriftUnknown = "unknown"
+)
+
+// StackDrift is a difference between a compose file and the deployed
+// stack: a field of an object, or the object as a whole when Field is
+// empty.
+type StackDrift struct {
+	Kind  string // network, config, secret or service
+	Name  string
+	State string
+	Field string
+	File  string
+	Live  string
+}
+
+// StackDrifts is the result of comparing a compose file with a stack.
+type StackDrifts struct {
+	Stack  string
+	Drifts []StackDrift
+	// Compared counts the objects of the file that were compared.
+	Compared int
+}
+
+// driftCheck compares the objects of a plan with those deployed.
+type driftCheck struct {
+	plan     *stackPlan
+	networks map[string]network.Summary // by ID and by name
+	drifts   []StackDrift
+}
+
+func (d *driftCheck) add(kind, name, state, field, file, live string) {
+	d.drifts = append(d.drifts, StackDrift{Kind: kind, Name: name, State: state, Field: field, File: file, Live: live})
+}
+
+// DiffStack compares a compose file with the stack deployed from it: the
+// fields of its services that were changed by hand, the objects missing on
+// either side, and the content of its configs and secrets by hash.
+func DiffStack(ctx context.Context, p *compose.Project, stack string) (StackDrifts, error) {
+	res := StackDrifts{Stack: stack}
+	plan, err := convertStack(p, stack)
+	if err != nil {
+		return res, err
+	}
+	c, err := clientFor(ctx)
+	if err != nil {
+		return res, fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	d := &driftCheck{plan: plan, networks: map[string]network.Summary{}}
+	networks, err := c.NetworkList(ctx, network.ListOptions{})
+	if err != nil {
+		return res, fmt.Errorf("listing networks: %w", err)
+	}
+	for _, n := range networks {
+		d.networks[n.ID] = n
+		d.networks[n.Name] = n
+	}
+	configs, err := c.ConfigList(ctx, swarm.ConfigListOptions{})
+	if err != nil {
+		return res, fmt.Errorf("listing configs: %w", err)
+	}
+	secrets, err := c.SecretList(ctx, swarm.SecretListOptions{})
+	if err != nil {
+		return res, fmt.Errorf("listing secrets: %w", err)
+	}
+	services, err := c.ServiceList(ctx, swarm.ServiceListOptions{Filters: stackFilter(stack)})
+	if err != nil {
+		return res, fmt.Errorf("listing services: %w", err)
+	}
+
+	d.compareNetworks(networks)
+	liveConfigs := map[string]swarm.Annotations{}
+	configData := map[string][]byte{}
+	for _, cfg := range configs {
+		liveConfigs[cfg.Spec.Name] = cfg.Spec.Annotations
+		configData[cfg.Spec.Name] = cfg.Spec.Data
+	}
+	d.compareFiles("config", plan.configs, liveConfigs, func(name string) string {
+		if data := configData[name]; data != nil {
+			return contentHash(data)
+		}
+		return liveConfigs[name].Labels[contentHashLabel]
+	})
+	liveSecrets := map[string]swarm.Annotations{}
+	for _, s := range secrets {
+		liveSecrets[s.Spec.Name] = s.Spec.Annotations
+	}
+	d.compareFiles("secret", plan.secrets, liveSecrets, func(name string) string {
+		return liveSecrets[name].Labels[contentHashLabel]
+	})
+	d.compareServices(services)
+
+	res.Drifts = d.drifts
+	res.Compared = len(plan.networks) + len(plan.configs) + len(plan.secrets) + len(plan.services)
+	l().Infof("🔍 Compared stack %s with its compose file: %d differences\n", stack, len(res.Drifts))
+	return res, nil
+}
+
+func (d *driftCheck) compareNetworks(live []network.Summary) {
+	want := map[string]bool{}
+	for _, obj := range d.plan.networks {
+		want[obj.name] = true
+		if _, ok := d.networks[obj.name]; !ok {
+			d.add("network", obj.name, DriftMissing, "", "", "")
+		}
+	}
+	for _, n := range live {
+		if n.Labels[stackNamespaceLabel] == d.plan.stack && !want[n.Name] {
+			d.add("network", n.Name, DriftExtra, "", "", "")
+		}
+	}
+}
+
+// compareFiles compares configs or secrets by the hash of their content;
+// liveHash returns the hash of a deployed one, or "" when unknown.
+func (d *driftCheck) compareFiles(kind string, objects []stackObject, live map[string]swarm.Annotations,
+	liveHash func(name string) string) {
+	want := map[string]bool{}
+	for _, obj := range objects {
+		want[obj.name] = true
+		if _, ok := live[obj.name]; !ok {
+			d.add(kind, obj.name, DriftMissing, "", "", "")
+			continue
+		}
+		if obj.external {
+			continue
+		}
+		fileHash, liveHash := contentHash(obj.data), liveHash(obj.name)
+		switch {
+		case liveHash == "":
+			d.add(kind, obj.name, DriftUnknown, "content", shortHash(fileHash), "not recorded")
+		case liveHash != fileHash:
+			d.add(kind, obj.name, DriftChanged, "content", shortHash(fileHash), shortHash(liveHash))
+		}
+	}
+	for _, name := range sortedKeys(live) {
+		if live[name].Labels[stackNamespaceLabel] == d.plan.stack && !want[name] {
+			d.add(kind, name, DriftExtra, "", "", "")
+		}
+	}
+}
+
+// shortHash shortens a content hash like docker shortens IDs.
+func shortHash(h string) string {
+	algo, hex, ok := strings.Cut(h, ":")
+	if !ok || len(hex) <= 12 {
+		return h
+	}
+	return algo + ":" + hex[:12]
+}
+
+func (d *driftCheck) compareServices(live []swarm.Service) {
+	byName := map[string]swarm.ServiceSpec{}
+	for _, svc := range live {
+		byName[svc.Spec.Name] = svc.Spec
+	}
+	want := map[string]bool{}
+	for _, spec := range d.plan.services {
+		want[spec.Name] = true
+		liveSpec, ok := byName[spec.Name]
+		if !ok {
+			d.add("service", spec.Name, DriftMissing, "", "", "")
+			continue
+		}
+		file, deployed := d.serviceFields(spec), d.serviceFields(liveSpec)
+		for _, field := range slices.Sorted(maps.Keys(mergeKeys(file, deployed))) {
+			if file[field] != deployed[field] {
+				d.add("service", spec.Name, DriftChanged, field, file[field], deployed[field])
+			}
+		}
+	}
+	for _, name := range sortedKeys(byName) {
+		if !want[name] {
+			d.add("service", name, DriftExtra, "", "", "")
+		}
+	}
+}
+
+func mergeKeys(a, b map[string]string) map[string]bool {
+	keys := map[string]bool{}
+	for k := range a {
+		keys[k] = true
+	}
+	for k := range b {
+		keys[k] = true
+	}
+	return keys
+}
+
+// serviceFields flattens what a hotfix usually changes in a service into
+// comparable fields; maps give one field per key.
+func (d *driftCheck) serviceFields(spec swarm.ServiceSpec) map[string]string {
+	f := map[string]string{}
+	set := func(field, value string) {
+		if value != "" {
+			f[field] = value
+		}
+	}
+
+	set("mode", serviceMode(spec.Mode))
+	switch m := spec.Mode; {
+	case m.Replicated != nil && m.Replicated.Replicas != nil:
+		set("replicas", strconv.FormatUint(*m.Replicated.Replicas, 10))
+	case m.ReplicatedJob != nil && m.ReplicatedJob.TotalCompletions != nil:
+		set("replicas", strconv.FormatUint(*m.ReplicatedJob.TotalCompletions, 10))
+	}
+	for k, v := range spec.Labels {
+		if k != stackNamespaceLabel {
+			f["deploy.labels."+k] = v
+		}
+	}
+
+	if cs := spec.TaskTemplate.ContainerSpec; cs != nil {
+		set("image", normalizeImage(cs.Image))
+		set("entrypoint", strings.Join(cs.Command, " "))
+		set("command", strings.Join(cs.Args, " "))
+		for _, kv := range cs.Env {
+			k, v, _ := strings.Cut(kv, "=")
+			f["environment."+k] = v
+		}
+		for k, v := range cs.Labels {
+			if k != stackNamespaceLabel && k != stackImageLabel {
+				f["labels."+k] = v
+			}
+		}
+		var mounts []string
+		for _, m := range cs.Mounts {
+			s := string(m.Type) + ":" + m.Source + ":" + m.Target
+			if m.ReadOnly {
+				s += ":ro"
+			}
+			mounts = append(mounts, s)
+		}
+		set("volumes", sortedJoin(mounts))
+		var configs, secrets []string
+		for _, ref := range cs.Configs {
+			if ref.File != nil {
+				configs = append(configs, ref.ConfigName+":"+ref.File.Name)
+			}
+		}
+		for _, ref := range cs.Secrets {
+			if ref.File != nil {
+				secrets = append(secrets, ref.SecretName+":"+ref.File.Name)
+			}
+		}
+		set("configs", sortedJoin(configs))
+		set("secrets", sortedJoin(secrets))
+	}
+
+	var networks []string
+	for _, att := range spec.TaskTemplate.Networks {
+		name := att.Target
+		if n, ok := d.networks[att.Target]; ok {
+			name = n.Name
+		}
+		networks = append(networks, name)
+	}
+	set("networks", sortedJoin(networks))
+
+	if ep := spec.EndpointSpec; ep != nil {
+		var ports []string
+		for _, p := range ep.Ports {
+			ports = append(ports, fmt.Sprintf("%d:%d/%s %s", p.PublishedPort, p.TargetPort, p.Protocol, p.PublishMode))
+		}
+		set("ports", sortedJoin(ports))
+	}
+	if pl := spec.TaskTemplate.Placement; pl != nil {
+		set("placement.constraints", sortedJoin(pl.Constraints))
+		if pl.MaxReplicas > 0 {
+			set("placement.max_replicas_per_node", strconv.FormatUint(pl.MaxReplicas, 10))
+		}
+	}
+	amount := func(field string, n int64, format func(int64) string) {
+		if n > 0 {
+			f[field] = format(n)
+		}
+	}
+	if r := spec.TaskTemplate.Resources; r != nil {
+		if lim := r.Limits; lim != nil {
+			amount("resources.limits.cpus", lim.NanoCPUs, formatCPUs)
+			amount("resources.limits.memory", lim.MemoryBytes, formatMemory)
+			amount("resources.limits.pids", lim.Pids, func(n int64) string { return strconv.FormatInt(n, 10) })
+		}
+		if res := r.Reservations; res != nil {
+			amount("resources.reservations.cpus", res.NanoCPUs, formatCPUs)
+			amount("resources.reservations.memory", res.MemoryBytes, formatMemory)
+		}
+	}
+	return f
+}
+
+func serviceMode(mode swarm.ServiceMode) string {
+	switch {
+	case mode.Global != nil:
+		return ModeGlobal
+	case mode.ReplicatedJob != nil:
+		return ModeReplicatedJob
+	case mode.GlobalJob != nil:
+		return ModeGlobalJob
+	}
+	return ModeReplicated
+}
+
+// normalizeImage drops the digest swarm pinned an image to and spells out
+// the default tag, so "nginx" and "nginx:latest@sha256:…" compare equal.
+func normalizeImage(image string) string {
+	image = stripDigest(image)
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return image
+	}
+	return reference.FamiliarString(reference.TagNameOnly(named))
+}
+
+func sortedJoin(items []string) string {
+	return strings.Join(slices.Sorted(slices.Values(items)), ", ")
+}
@@ -0,0 +1,575 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"maps"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"swarmcli/core/compose"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/swarm"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ExportComposeVersion is the compose format stacks are exported as.
+	ExportComposeVersion = "3.8"
+	// stackImageLabel is set on containers by `docker stack deploy`.
+	stackImageLabel = "com.docker.stack.image"
+	// overlayVXLANOption is set on overlay networks by swarm.
+	overlayVXLANOption = "com.docker.network.driver.overlay.vxlanid_list"
+)
+
+// Values swarm or the docker CLI fill in when a compose file leaves them
+// out; an export leaves them out again.
+const (
+	defaultRestartDelay  = 5 * time.Second
+	defaultUpdateMonitor = 5 * time.Second
+)
+
+// addrPools are the pools swarm allocates overlay subnets from, with the
+// size of those subnets.
+type addrPools struct {
+	prefixes []netip.Prefix
+	size     int
+}
+
+// allocated reports whether swarm picked subnet rather than the compose
+// file.
+func (p addrPools) allocated(subnet string) bool {
+	s, err := netip.ParsePrefix(subnet)
+	if err != nil || s.Bits() != p.size {
+		return false
+	}
+	for _, pool := range p.prefixes {
+		if pool.Contains(s.Addr()) {
+			return true
+		}
+	}
+	return false
+}
+
+// stackExporter rebuilds a compose file from the objects of a stack.
+type stackExporter struct {
+	stack    string
+	p        *compose.Project
+	networks map[string]network.Summary // by ID and by name
+	pools    addrPools
+	warnings []string
+}
+
+// ExportStack rebuilds a compose file (version 3.8) from the live specs of
+// a stack's services and the networks they use. Configs and secrets are
+// referenced as external, and what swarm or the CLI filled in is left out.
+// What cannot be expressed is listed in a comment at the top.
+func ExportStack(ctx context.Context, stack string) ([]byte, error) {
+	c, err := clientFor(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	services, err := c.ServiceList(ctx, swarm.ServiceListOptions{Filters: stackFilter(stack)})
+	if err != nil {
+		return nil, fmt.Errorf("listing services: %w", err)
+	}
+	if len(services) == 0 {
+		return nil, fmt.Errorf("stack %s has no services", stack)
+	}
+	networks, err := c.NetworkList(ctx, network.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("listing networks: %w", err)
+	}
+	pools := addrPools{size: 24}
+	defaultPools := []string{"10.0.0.0/8"}
+	if sw, err := c.SwarmInspect(ctx); err == nil && len(sw.DefaultAddrPool) > 0 {
+		defaultPools, pools.size = sw.DefaultAddrPool, int(sw.SubnetSize)
+	}
+	for _, pool := range defaultPools {
+		if prefix, err := netip.ParsePrefix(pool); err == nil {
+			pools.prefixes = append(pools.prefixes, prefix)
+		}
+	}
+
+	e := newStackExporter(stack, networks, pools)
+	for _, svc := range services {
+		e.service(svc.Spec)
+	}
+	l().Infof("📤 Exported stack %s (%d services, %d warnings)\n", stack, len(services), len(e.warnings))
+	return e.encode(time.Now())
+}
+
+func newStackExporter(stack string, networks []network.Summary, pools addrPools) *stackExporter {
+	e := &stackExporter{
+		stack: stack,
+		p: &compose.Project{
+			Version:  ExportComposeVersion,
+			Services: map[string]compose.Service{},
+		},
+		networks: map[string]network.Summary{},
+		pools:    pools,
+	}
+	for _, n := range networks {
+		e.networks[n.ID] = n
+		e.networks[n.Name] = n
+	}
+	return e
+}
+
+func (e *stackExporter) warn(format string, args ...any) {
+	e.warnings = append(e.warnings, fmt.Sprintf(format, args...))
+}
+
+// key is the name of an object in the compose file: its swarm name without
+// the stack prefix.
+func (e *stackExporter) key(name string) string {
+	return strings.TrimPrefix(name, e.stack+"_")
+}
+
+// encode writes the compose file, after a comment on where it comes from.
+func (e *stackExporter) encode(now time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "# Stack %s, exported by swarmcli on %s.\n", e.stack, now.Format(time.RFC3339))
+	if len(e.p.Configs) > 0 || len(e.p.Secrets) > 0 {
+		buf.WriteString("# Configs and secrets are external: their content stays in the swarm.\n")
+	}
+	if len(e.warnings) > 0 {
+		buf.WriteString("# Not exported:\n")
+		for _, w := range e.warnings {
+			fmt.Fprintf(&buf, "#   - %s\n", w)
+		}
+	}
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(e.p); err != nil {
+		return nil, fmt.Errorf("encoding compose file: %w", err)
+	}
+	if err := enc.Close(); err != nil {
+		return nil, fmt.Errorf("encoding compose file: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// labels returns labels without those the stack deploy added, or nil.
+func exportLabels(labels map[string]string) compose.Mapping {
+	var out compose.Mapping
+	for k, v := range labels {
+		if k == stackNamespaceLabel || k == stackImageLabel {
+			continue
+		}
+		if out == nil {
+			out = compose.Mapping{}
+		}
+		out[k] = &v
+	}
+	return out
+}
+
+func (e *stackExporter) service(spec swarm.ServiceSpec) {
+	key := e.key(spec.Name)
+	svc := compose.Service{}
+	if cs := spec.TaskTemplate.ContainerSpec; cs != nil {
+		e.container(key, &svc, cs)
+	}
+	if d := spec.TaskTemplate.LogDriver; d != nil {
+		svc.Logging = &compose.Logging{Driver: d.Name, Options: d.Options}
+	}
+	for _, att := range spec.TaskTemplate.Networks {
+		e.attach(key, &svc, att)
+	}
+	if aliases, ok := svc.Networks[defaultNetwork]; ok && len(svc.Networks) == 1 && len(aliases) == 0 {
+		svc.Networks = nil
+	}
+	e.ports(&svc, spec.EndpointSpec)
+	e.deploy(key, &svc.Deploy, spec)
+	e.p.Services[key] = svc
+}
+
+func (e *stackExporter) container(key string, svc *compose.Service, cs *swarm.ContainerSpec) {
+	svc.Image = stripDigest(cs.Image)
+	svc.Labels = exportLabels(cs.Labels)
+	svc.Entrypoint = cs.Command
+	svc.Command = cs.Args
+	for _, kv := range cs.Env {
+		if svc.Environment == nil {
+			svc.Environment = compose.Mapping{}
+		}
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			svc.Environment[k] = nil
+			continue
+		}
+		svc.Environment[k] = &v
+	}
+	svc.Hostname = cs.Hostname
+	svc.User = cs.User
+	svc.WorkingDir = cs.Dir
+	svc.StopSignal = cs.StopSignal
+	svc.Tty = cs.TTY
+	svc.StdinOpen = cs.OpenStdin
+	svc.ReadOnly = cs.ReadOnly
+	svc.Init = cs.Init
+	svc.CapAdd = cs.CapabilityAdd
+	svc.CapDrop = cs.CapabilityDrop
+	if cs.StopGracePeriod != nil {
+		d := compose.Duration(*cs.StopGracePeriod)
+		svc.StopGracePeriod = &d
+	}
+	if dns := cs.DNSConfig; dns != nil {
+		svc.DNS = dns.Nameservers
+		svc.DNSSearch = dns.Search
+		if len(dns.Options) > 0 {
+			e.warn("%s: dns options %s", key, strings.Join(dns.Options, ", "))
+		}
+	}
+	for _, h := range cs.Hosts {
+		// "ip host alias…", or "host:ip" from older clients
+		fields := strings.Fields(h)
+		if len(fields) < 2 {
+			svc.ExtraHosts = append(svc.ExtraHosts, h)
+			continue
+		}
+		for _, host := range fields[1:] {
+			svc.ExtraHosts = append(svc.ExtraHosts, host+":"+fields[0])
+		}
+	}
+	svc.Healthcheck = exportHealthcheck(cs.Healthcheck)
+	e.mounts(key, svc, cs.Mounts)
+
+	for _, ref := range cs.Configs {
+		if ref.File == nil {
+			e.warn("%s: runtime config %s", key, ref.ConfigName)
+			continue
+		}
+		source := e.externalFile(&e.p.Configs, ref.ConfigName)
+		svc.Configs = append(svc.Configs, exportFileRef(source, "/"+source,
+			ref.File.Name, ref.File.UID, ref.File.GID, uint32(ref.File.Mode)))
+	}
+	for _, ref := range cs.Secrets {
+		if ref.File == nil {
+			continue
+		}
+		source := e.externalFile(&e.p.Secrets, ref.SecretName)
+		svc.Secrets = append(svc.Secrets, exportFileRef(source, source,
+			ref.File.Name, ref.File.UID, ref.File.GID, uint32(ref.File.Mode)))
+	}
+
+	if cs.Privileges != nil {
+		e.warn("%s: privileges (credential spec, SELinux, seccomp, AppArmor)", key)
+	}
+	if len(cs.Sysctls) > 0 {
+		e.warn("%s: sysctls", key)
+	}
+	if len(cs.Ulimits) > 0 {
+		e.warn("%s: ulimits", key)
+	}
+	if len(cs.Groups) > 0 {
+		e.warn("%s: additional groups", key)
+	}
+}
+
+// externalFile adds a config or secret to the file as an external
+// reference, and returns its key.
+func (e *stackExporter) externalFile(files *map[string]compose.File, name string) string {
+	key := e.key(name)
+	if *files == nil {
+		*files = map[string]compose.File{}
+	}
+	f := compose.File{External: compose.External{External: true}}
+	if key != name {
+		f.Name = name
+	}
+	(*files)[key] = f
+	return key
+}
+
+// exportFileRef leaves out the target, owner and mode a stack deploy uses
+// when none is set.
+func exportFileRef(source, defaultTarget, target, uid, gid string, mode uint32) compose.FileRef {
+	ref := compose.FileRef{Source: source}
+	if target != defaultTarget {
+		ref.Target = target
+	}
+	if uid != "0" {
+		ref.UID = uid
+	}
+	if gid != "0" {
+		ref.GID = gid
+	}
+	if mode != 0o444 {
+		ref.Mode = &mode
+	}
+	return ref
+}
+
+func exportHealthcheck(hc *container.HealthConfig) *compose.Healthcheck {
+	if hc == nil {
+		return nil
+	}
+	if slices.Equal(hc.Test, []string{"NONE"}) {
+		return &compose.Healthcheck{Disable: true}
+	}
+	out := &compose.Healthcheck{Test: hc.Test}
+	for _, d := range []struct {
+		from time.Duration
+		to   **compose.Duration
+	}{{hc.Interval, &out.Interval}, {hc.Timeout, &out.Timeout}, {hc.StartPeriod, &out.StartPeriod}} {
+		if d.from != 0 {
+			v := compose.Duration(d.from)
+			*d.to = &v
+		}
+	}
+	if hc.Retries != 0 {
+		retries := uint64(hc.Retries)
+		out.Retries = &retries
+	}
+	return out
+}
+
+func (e *stackExporter) mounts(key string, svc *compose.Service, mounts []mount.Mount) {
+	for _, m := range mounts {
+		out := compose.Mount{Type: string(m.Type), Source: m.Source, Target: m.Target, ReadOnly: m.ReadOnly}
+		switch m.Type {
+		case mount.TypeBind:
+			if m.BindOptions != nil {
+				e.warn("%s: bind options of %s", key, m.Target)
+			}
+		case mount.TypeTmpfs:
+			if m.TmpfsOptions != nil {
+				e.warn("%s: tmpfs options of %s", key, m.Target)
+			}
+		case mount.TypeVolume:
+			if m.Source != "" {
+				out.Source = e.volume(m)
+			}
+		default:
+			e.warn("%s: %s mount %s", key, m.Type, m.Target)
+			continue
+		}
+		svc.Volumes = append(svc.Volumes, out)
+	}
+}
+
+// volume adds the named volume of a mount to the file and returns its key.
+// Volumes the stack created keep their driver; the others are external.
+func (e *stackExporter) volume(m mount.Mount) string {
+	if e.p.Volumes == nil {
+		e.p.Volumes = map[string]compose.Volume{}
+	}
+	var labels map[string]string
+	if m.VolumeOptions != nil {
+		labels = m.VolumeOptions.Labels
+	}
+	if labels[stackNamespaceLabel] != e.stack || !strings.HasPrefix(m.Source, e.stack+"_") {
+		e.p.Volumes[m.Source] = compose.Volume{External: compose.External{External: true}}
+		return m.Source
+	}
+	key := e.key(m.Source)
+	vol := compose.Volume{Labels: exportLabels(labels)}
+	if d := m.VolumeOptions.DriverConfig; d != nil {
+		vol.Driver, vol.DriverOpts = d.Name, d.Options
+	}
+	e.p.Volumes[key] = vol
+	return key
+}
+
+// attach adds a network of a service, and the network to the file.
+func (e *stackExporter) attach(key string, svc *compose.Service, att swarm.NetworkAttachmentConfig) {
+	n, ok := e.networks[att.Target]
+	if !ok {
+		e.warn("%s: network %s, which no longer exists", key, att.Target)
+		return
+	}
+	name := e.network(n)
+	if svc.Networks == nil {
+		svc.Networks = compose.ServiceNetwork{}
+	}
+	var aliases []string
+	for _, alias := range att.Aliases {
+		if alias != key {
+			aliases = append(aliases, alias)
+		}
+	}
+	svc.Networks[name] = aliases
+}
+
+// network adds a network to the file and returns its key. Networks of the
+// stack keep their options, minus the VXLAN ID and subnets swarm allocated;
+// the others are external.
+func (e *stackExporter) network(n network.Summary) string {
+	if e.p.Networks == nil {
+		e.p.Networks = map[string]compose.Network{}
+	}
+	if n.Labels[stackNamespaceLabel] != e.stack || !strings.HasPrefix(n.Name, e.stack+"_") {
+		e.p.Networks[n.Name] = compose.Network{External: compose.External{External: true}}
+		return n.Name
+	}
+	key := e.key(n.Name)
+	nw := compose.Network{
+		DriverOpts: maps.Clone(n.Options),
+		Attachable: n.Attachable,
+		Internal:   n.Internal,
+		Labels:     exportLabels(n.Labels),
+	}
+	delete(nw.DriverOpts, overlayVXLANOption)
+	if len(nw.DriverOpts) == 0 {
+		nw.DriverOpts = nil
+	}
+	if n.Driver != "overlay" {
+		nw.Driver = n.Driver
+	}
+	if n.IPAM.Driver != "" && n.IPAM.Driver != "default" {
+		nw.IPAM.Driver = n.IPAM.Driver
+	}
+	for _, c := range n.IPAM.Config {
+		if c.Subnet == "" || e.pools.allocated(c.Subnet) {
+			continue
+		}
+		nw.IPAM.Config = append(nw.IPAM.Config, struct {
+			Subnet string `yaml:"subnet,omitempty"`
+		}{c.Subnet})
+	}
+	// The default network is implied when it has no options
+	if key != defaultNetwork || !reflect.ValueOf(nw).IsZero() {
+		e.p.Networks[key] = nw
+	}
+	return key
+}
+
+func (e *stackExporter) ports(svc *compose.Service, ep *swarm.EndpointSpec) {
+	if ep == nil {
+		return
+	}
+	for _, pc := range ep.Ports {
+		port := compose.Port{Target: pc.TargetPort, Published: pc.PublishedPort}
+		if pc.Protocol != swarm.PortConfigProtocolTCP {
+			port.Protocol = string(pc.Protocol)
+		}
+		if pc.PublishMode == swarm.PortConfigPublishModeHost {
+			port.Mode = string(pc.PublishMode)
+		}
+		svc.Ports = append(svc.Ports, port)
+	}
+	if ep.Mode == swarm.ResolutionModeDNSRR {
+		svc.Deploy.EndpointMode = string(ep.Mode)
+	}
+}
+
+func (e *stackExporter) deploy(key string, d *compose.Deploy, spec swarm.ServiceSpec) {
+	d.Labels = exportLabels(spec.Labels)
+	restartCondition := swarm.RestartPolicyConditionAny
+	switch m := spec.Mode; {
+	case m.Replicated != nil:
+		d.Replicas = m.Replicated.Replicas
+	case m.Global != nil:
+		d.Mode = ModeGlobal
+	case m.ReplicatedJob != nil:
+		d.Mode = ModeReplicatedJob
+		d.Replicas = m.ReplicatedJob.TotalCompletions
+		restartCondition = swarm.RestartPolicyConditionOnFailure
+	case m.GlobalJob != nil:
+		d.Mode = ModeGlobalJob
+		restartCondition = swarm.RestartPolicyConditionOnFailure
+	}
+
+	tt := spec.TaskTemplate
+	if r := tt.Resources; r != nil {
+		if lim := r.Limits; lim != nil && (lim.NanoCPUs != 0 || lim.MemoryBytes != 0 || lim.Pids != 0) {
+			d.Resources.Limits = &compose.Resource{
+				CPUs:   compose.CPUs(lim.NanoCPUs),
+				Memory: compose.Bytes(lim.MemoryBytes),
+				Pids:   lim.Pids,
+			}
+		}
+		if res := r.Reservations; res != nil {
+			if res.NanoCPUs != 0 || res.MemoryBytes != 0 {
+				d.Resources.Reservations = &compose.Resource{
+					CPUs:   compose.CPUs(res.NanoCPUs),
+					Memory: compose.Bytes(res.MemoryBytes),
+				}
+			}
+			if len(res.GenericResources) > 0 {
+				e.warn("%s: generic resources", key)
+			}
+		}
+	}
+
+	if rp := tt.RestartPolicy; rp != nil {
+		out := &compose.RestartPolicy{}
+		if rp.Condition != "" && rp.Condition != restartCondition {
+			out.Condition = string(rp.Condition)
+		}
+		if rp.Delay != nil && *rp.Delay != defaultRestartDelay {
+			delay := compose.Duration(*rp.Delay)
+			out.Delay = &delay
+		}
+		if rp.MaxAttempts != nil && *rp.MaxAttempts != 0 {
+			out.MaxAttempts = rp.MaxAttempts
+		}
+		if rp.Window != nil && *rp.Window != 0 {
+			window := compose.Duration(*rp.Window)
+			out.Window = &window
+		}
+		if *out != (compose.RestartPolicy{}) {
+			d.RestartPolicy = out
+		}
+	}
+
+	// Platforms come from the registry when the service is created
+	if pl := tt.Placement; pl != nil {
+		d.Placement.Constraints = pl.Constraints
+		d.Placement.MaxReplicas = pl.MaxReplicas
+		for _, pref := range pl.Preferences {
+			if pref.Spread == nil {
+				continue
+			}
+			d.Placement.Preferences = append(d.Placement.Preferences, struct {
+				Spread string `yaml:"spread,omitempty"`
+			}{pref.Spread.SpreadDescriptor})
+		}
+	}
+
+	d.UpdateConfig = exportUpdateConfig(spec.UpdateConfig)
+	d.RollbackConfig = exportUpdateConfig(spec.RollbackConfig)
+}
+
+// exportUpdateConfig keeps the settings that differ from swarm's defaults,
+// or returns nil when none does.
+func exportUpdateConfig(u *swarm.UpdateConfig) *compose.UpdateConfig {
+	if u == nil {
+		return nil
+	}
+	out := &compose.UpdateConfig{
+		Delay:           compose.Duration(u.Delay),
+		MaxFailureRatio: u.MaxFailureRatio,
+	}
+	if u.Parallelism != 1 {
+		parallelism := u.Parallelism
+		out.Parallelism = &parallelism
+	}
+	if u.FailureAction != "" && u.FailureAction != swarm.UpdateFailureActionPause {
+		out.FailureAction = u.FailureAction
+	}
+	if u.Monitor != 0 && u.Monitor != defaultUpdateMonitor {
+		out.Monitor = compose.Duration(u.Monitor)
+	}
+	if u.Order != "" && u.Order != swarm.UpdateOrderStopFirst {
+		out.Order = u.Order
+	}
+	if *out == (compose.UpdateConfig{}) {
+		return nil
+	}
+	return out
+}
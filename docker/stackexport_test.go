@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"maps"
+	"net/netip"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"swarmcli/core/compose"
+	"swarmcli/core/diff"
+
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/swarm"
+)
+
+const roundTripDoc = `services:
+  web:
+    image: nginx:1.27
+    labels:
+      role: frontend
+    environment:
+      LEVEL: debug
+    ports: ["8080:80", "53:53/udp"]
+    networks:
+      front:
+        aliases: [www]
+      back:
+      shared:
+    configs:
+      - source: site
+        target: /etc/nginx/conf.d/site.conf
+    deploy:
+      replicas: 3
+      labels:
+        team: web
+      update_config:
+        parallelism: 2
+        order: start-first
+      placement:
+        constraints: [node.role==worker]
+  db:
+    image: postgres:16
+    networks: [back]
+    volumes: ["data:/var/lib/postgresql/data"]
+    deploy:
+      restart_policy:
+        condition: on-failure
+  migrate:
+    image: migrate:1
+    command: ["up"]
+    deploy:
+      mode: replicated-job
+networks:
+  front:
+    driver_opts:
+      encrypted: "true"
+  back:
+    internal: true
+    ipam:
+      config:
+        - subnet: 172.30.0.0/24
+  shared:
+    external: true
+volumes:
+  data:
+configs:
+  site:
+    external: true
+`
+
+// deployedSpec adds to a converted spec what swarm and the docker CLI fill
+// in on deploy.
+func deployedSpec(spec swarm.ServiceSpec) swarm.ServiceSpec {
+	cs := *spec.TaskTemplate.ContainerSpec
+	cs.Image += "@sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	cs.Labels = maps.Clone(cs.Labels)
+	cs.Labels[stackImageLabel] = spec.TaskTemplate.ContainerSpec.Image
+	spec.TaskTemplate.ContainerSpec = &cs
+
+	if spec.TaskTemplate.RestartPolicy == nil {
+		delay, attempts := defaultRestartDelay, uint64(0)
+		spec.TaskTemplate.RestartPolicy = &swarm.RestartPolicy{
+			Condition: swarm.RestartPolicyConditionAny, Delay: &delay, MaxAttempts: &attempts,
+		}
+	}
+	defaults := func(u *swarm.UpdateConfig) *swarm.UpdateConfig {
+		out := swarm.UpdateConfig{Parallelism: 1}
+		if u != nil {
+			out = *u
+		}
+		u = &out
+		if u.FailureAction == "" {
+			u.FailureAction = swarm.UpdateFailureActionPause
+		}
+		if u.Monitor == 0 {
+			u.Monitor = defaultUpdateMonitor
+		}
+		if u.Order == "" {
+			u.Order = swarm.UpdateOrderStopFirst
+		}
+		return u
+	}
+	spec.UpdateConfig = defaults(spec.UpdateConfig)
+	spec.RollbackConfig = defaults(spec.RollbackConfig)
+	return spec
+}
+
+// deployedNetwork is a network of the plan as the swarm lists it: with an
+// ID, a VXLAN ID and a subnet swarm allocated when the file set none.
+func deployedNetwork(obj stackObject) network.Summary {
+	if obj.external {
+		return network.Summary{ID: "id-" + obj.name, Name: obj.name, Driver: "overlay", Scope: "swarm"}
+	}
+	opts := obj.network
+	n := network.Summary{
+		ID:         "id-" + obj.name,
+		Name:       obj.name,
+		Driver:     opts.Driver,
+		Scope:      opts.Scope,
+		Internal:   opts.Internal,
+		Attachable: opts.Attachable,
+		Labels:     opts.Labels,
+		Options:    maps.Clone(opts.Options),
+		IPAM:       network.IPAM{Driver: "default"},
+	}
+	if n.Options == nil {
+		n.Options = map[string]string{}
+	}
+	n.Options[overlayVXLANOption] = "4097"
+	if opts.IPAM != nil && len(opts.IPAM.Config) > 0 {
+		n.IPAM.Config = opts.IPAM.Config
+	} else {
+		n.IPAM.Config = []network.IPAMConfig{{Subnet: "10.0.7.0/24", Gateway: "10.0.7.1"}}
+	}
+	return n
+}
+
+func TestStackExportRoundTrip(t *testing.T) {
+	plan, err := testPlan(t, roundTripDoc, nil)
+	if err != nil {
+		t.Fatalf("converting compose file: %v", err)
+	}
+
+	var networks []network.Summary
+	for _, obj := range plan.networks {
+		networks = append(networks, deployedNetwork(obj))
+	}
+	pools := addrPools{prefixes: []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}, size: 24}
+	e := newStackExporter("app", networks, pools)
+	for _, spec := range plan.services {
+		e.service(deployedSpec(spec))
+	}
+	data, err := e.encode(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("encode failed: %v", err)
+	}
+	exported := string(data)
+
+	if len(e.warnings) != 0 {
+		t.Errorf("warnings = %q, want none", e.warnings)
+	}
+	for _, dropped := range []string{
+		"sha256:", stackNamespaceLabel, stackImageLabel, overlayVXLANOption, "10.0.7.0/24",
+		"restart_policy:\n        condition: any", "monitor", "failure_action", "stop-first",
+	} {
+		if strings.Contains(exported, dropped) {
+			t.Errorf("export contains %q:\n%s", dropped, exported)
+		}
+	}
+	if !strings.HasPrefix(exported, "# Stack app, exported by swarmcli on 2026-01-02T03:04:05Z.\n") {
+		t.Errorf("export does not start with its origin:\n%s", exported)
+	}
+
+	p, err := compose.Parse(data, t.TempDir(), nil)
+	if err != nil {
+		t.Fatalf("loading the export back: %v\n%s", err, exported)
+	}
+	if p.Version != ExportComposeVersion {
+		t.Errorf("version = %q, want %q", p.Version, ExportComposeVersion)
+	}
+	again, err := convertStack(p, "app")
+	if err != nil {
+		t.Fatalf("converting the export back: %v\n%s", err, exported)
+	}
+
+	if len(again.services) != len(plan.services) {
+		t.Fatalf("export has %d services, want %d", len(again.services), len(plan.services))
+	}
+	for i, want := range plan.services {
+		got, err := diff.YAML(again.services[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantYAML, err := diff.YAML(want)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != wantYAML {
+			t.Errorf("service %s after the round trip:\n%s\nwant:\n%s\nexport:\n%s", want.Name, got, wantYAML, exported)
+		}
+	}
+	if !reflect.DeepEqual(again.networks, plan.networks) {
+		t.Errorf("networks after the round trip = %+v, want %+v", again.networks, plan.networks)
+	}
+	if len(again.configs) != 1 || !again.configs[0].external || again.configs[0].name != "site" {
+		t.Errorf("configs after the round trip = %+v, want site as external", again.configs)
+	}
+}
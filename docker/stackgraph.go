@@ -0,0 +1,256 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// Kinds of the resources of a stack graph, in the order they are drawn.
+const (
+	GraphNetwork = "network"
+	GraphConfig  = "config"
+	GraphSecret  = "secret"
+	GraphVolume  = "volume"
+)
+
+// GraphKinds lists the kinds of resources in drawing order.
+var GraphKinds = []string{GraphNetwork, GraphConfig, GraphSecret, GraphVolume}
+
+// noStack groups the services created outside of any stack.
+const noStack = "(no stack)"
+
+// GraphResource is a network, config, secret or volume used by the
+// services of a stack.
+type GraphResource struct {
+	Kind string
+	Name string
+	// External is set when the resource does not carry the stack's label.
+	External bool
+	// SharedWith lists the other stacks whose services use it too.
+	SharedWith []string
+}
+
+// GraphService is a service of a stack, with the resources it uses as
+// indexes into StackGraph.Resources.
+type GraphService struct {
+	Name      string
+	Ports     []string
+	Resources []int
+}
+
+// StackGraph is what the services of a stack use.
+type StackGraph struct {
+	Stack     string
+	Services  []GraphService
+	Resources []GraphResource
+}
+
+// Shared counts the resources other stacks use too.
+func (g StackGraph) Shared() int {
+	n := 0
+	for _, r := range g.Resources {
+		if len(r.SharedWith) > 0 {
+			n++
+		}
+	}
+	return n
+}
+
+// LoadStackGraph lists the services of a stack with the networks, configs,
+// secrets and volumes they use, and which of those other stacks use too.
+// Services are matched to resources like the used-by lists of the
+// networks, configs and secrets views: by ID or by name.
+func LoadStackGraph(ctx context.Context, stack string) (StackGraph, error) {
+	g := StackGraph{Stack: stack}
+	c, err := clientFor(ctx)
+	if err != nil {
+		return g, fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	services, err := c.ServiceList(ctx, swarm.ServiceListOptions{})
+	if err != nil {
+		return g, fmt.Errorf("listing services: %w", err)
+	}
+	networks, err := c.NetworkList(ctx, network.ListOptions{})
+	if err != nil {
+		return g, fmt.Errorf("listing networks: %w", err)
+	}
+	byNetwork := map[string]network.Summary{}
+	for _, n := range networks {
+		byNetwork[n.ID] = n
+		byNetwork[n.Name] = n
+	}
+	// Configs and secrets may be named freely; the stack's own carry its label
+	owned := map[string]bool{}
+	configs, err := c.ConfigList(ctx, swarm.ConfigListOptions{Filters: stackFilter(stack)})
+	if err != nil {
+		return g, fmt.Errorf("listing configs: %w", err)
+	}
+	for _, cfg := range configs {
+		owned[GraphConfig+"/"+cfg.Spec.Name] = true
+	}
+	secrets, err := c.SecretList(ctx, swarm.SecretListOptions{Filters: stackFilter(stack)})
+	if err != nil {
+		return g, fmt.Errorf("listing secrets: %w", err)
+	}
+	for _, sec := range secrets {
+		owned[GraphSecret+"/"+sec.Spec.Name] = true
+	}
+
+	var own, others []swarm.Service
+	for _, svc := range services {
+		if svc.Spec.Labels[stackNamespaceLabel] == stack {
+			own = append(own, svc)
+		} else {
+			others = append(others, svc)
+		}
+	}
+	if len(own) == 0 {
+		return g, fmt.Errorf("stack %s has no services", stack)
+	}
+	slices.SortFunc(own, func(a, b swarm.Service) int { return strings.Compare(a.Spec.Name, b.Spec.Name) })
+
+	index := map[string]int{}
+	use := func(svc *GraphService, r GraphResource, uses func(swarm.Service) bool) {
+		key := r.Kind + "/" + r.Name
+		i, ok := index[key]
+		if !ok {
+			r.SharedWith = sharedWith(others, uses)
+			i = len(g.Resources)
+			index[key] = i
+			g.Resources = append(g.Resources, r)
+		}
+		if !slices.Contains(svc.Resources, i) {
+			svc.Resources = append(svc.Resources, i)
+		}
+	}
+
+	for _, s := range own {
+		svc := GraphService{Name: s.Spec.Name, Ports: graphPorts(s.Spec.EndpointSpec)}
+		for _, att := range s.Spec.TaskTemplate.Networks {
+			n, ok := byNetwork[att.Target]
+			if !ok {
+				n = network.Summary{ID: att.Target, Name: att.Target}
+			}
+			use(&svc, GraphResource{Kind: GraphNetwork, Name: n.Name, External: n.Labels[stackNamespaceLabel] != stack},
+				func(o swarm.Service) bool { return serviceUsesNetwork(o, n.ID, n.Name) })
+		}
+		if cs := s.Spec.TaskTemplate.ContainerSpec; cs != nil {
+			for _, ref := range cs.Configs {
+				if ref.File == nil {
+					continue
+				}
+				use(&svc, GraphResource{Kind: GraphConfig, Name: ref.ConfigName, External: !owned[GraphConfig+"/"+ref.ConfigName]},
+					func(o swarm.Service) bool { return serviceUsesConfig(o, ref.ConfigID, ref.ConfigName) })
+			}
+			for _, ref := range cs.Secrets {
+				use(&svc, GraphResource{Kind: GraphSecret, Name: ref.SecretName, External: !owned[GraphSecret+"/"+ref.SecretName]},
+					func(o swarm.Service) bool { return serviceUsesSecret(o, ref.SecretID, ref.SecretName) })
+			}
+			for _, m := range cs.Mounts {
+				if m.Type != mount.TypeVolume || m.Source == "" {
+					continue
+				}
+				external := m.VolumeOptions == nil || m.VolumeOptions.Labels[stackNamespaceLabel] != stack
+				use(&svc, GraphResource{Kind: GraphVolume, Name: m.Source, External: external},
+					func(o swarm.Service) bool { return serviceUsesVolume(o, m.Source) })
+			}
+		}
+		g.Services = append(g.Services, svc)
+	}
+	g.sortResources()
+	return g, nil
+}
+
+// sortResources orders the resources by kind then name, and renumbers the
+// references of the services.
+func (g *StackGraph) sortResources() {
+	order := make([]int, len(g.Resources))
+	for i := range order {
+		order[i] = i
+	}
+	rank := func(kind string) int { return slices.Index(GraphKinds, kind) }
+	slices.SortFunc(order, func(a, b int) int {
+		ra, rb := g.Resources[a], g.Resources[b]
+		if d := rank(ra.Kind) - rank(rb.Kind); d != 0 {
+			return d
+		}
+		return strings.Compare(ra.Name, rb.Name)
+	})
+	renumber := make([]int, len(order))
+	sorted := make([]GraphResource, len(order))
+	for to, from := range order {
+		renumber[from] = to
+		sorted[to] = g.Resources[from]
+	}
+	g.Resources = sorted
+	for i := range g.Services {
+		refs := g.Services[i].Resources
+		for j, r := range refs {
+			refs[j] = renumber[r]
+		}
+		slices.Sort(refs)
+	}
+}
+
+// sharedWith lists the stacks of the services uses matches.
+func sharedWith(services []swarm.Service, uses func(swarm.Service) bool) []string {
+	var stacks []string
+	for _, svc := range services {
+		if !uses(svc) {
+			continue
+		}
+		stack := svc.Spec.Labels[stackNamespaceLabel]
+		if stack == "" {
+			stack = noStack
+		}
+		if !slices.Contains(stacks, stack) {
+			stacks = append(stacks, stack)
+		}
+	}
+	slices.Sort(stacks)
+	return stacks
+}
+
+// serviceUsesVolume reports whether a service mounts a named volume.
+func serviceUsesVolume(svc swarm.Service, name string) bool {
+	cs := svc.Spec.TaskTemplate.ContainerSpec
+	if cs == nil {
+		return false
+	}
+	for _, m := range cs.Mounts {
+		if m.Type == mount.TypeVolume && m.Source == name {
+			return true
+		}
+	}
+	return false
+}
+
+// graphPorts lists the published ports of a service, e.g. "8080→80/tcp".
+func graphPorts(ep *swarm.EndpointSpec) []string {
+	if ep == nil {
+		return nil
+	}
+	var ports []string
+	for _, p := range ep.Ports {
+		s := fmt.Sprintf("%d→%d/%s", p.PublishedPort, p.TargetPort, p.Protocol)
+		if p.PublishedPort == 0 {
+			s = fmt.Sprintf("%d/%s", p.TargetPort, p.Protocol)
+		}
+		if p.PublishMode == swarm.PortConfigPublishModeHost {
+			s += " (host)"
+		}
+		ports = append(ports, s)
+	}
+	return ports
+}
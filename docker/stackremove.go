@@ -0,0 +1,259 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	cerrdefs "github.com/containerd/errdefs"
+	"github.com/docker/docker/api/types/network"
+	"github.com/docker/docker/api/types/swarm"
+	"github.com/docker/docker/client"
+)
+
+const (
+	// stackTasksTimeout bounds the wait for the tasks of removed services
+	// to go away; networks left in use are retried anyway.
+	stackTasksTimeout = 2 * time.Minute
+	// stackRemoveAttempts and stackRemoveRetryDelay retry the networks,
+	// configs and secrets still in use by draining tasks.
+	stackRemoveAttempts   = 10
+	stackRemoveRetryDelay = 3 * time.Second
+)
+
+// StackResource is an object labelled with a stack's namespace.
+type StackResource struct {
+	ID   string
+	Name string
+}
+
+// StackResources lists everything a stack is made of.
+type StackResources struct {
+	Stack    string
+	Services []StackResource
+	Networks []StackResource
+	Configs  []StackResource
+	Secrets  []StackResource
+}
+
+// Count returns the number of objects of the stack.
+func (r StackResources) Count() int {
+	return len(r.Services) + len(r.Networks) + len(r.Configs) + len(r.Secrets)
+}
+
+// Describe lists the objects by kind, one kind per line.
+func (r StackResources) Describe() string {
+	var lines []string
+	for _, group := range []struct {
+		kind  string
+		items []StackResource
+	}{{"Services", r.Services}, {"Networks", r.Networks}, {"Configs", r.Configs}, {"Secrets", r.Secrets}} {
+		if len(group.items) == 0 {
+			continue
+		}
+		names := make([]string, len(group.items))
+		for i, item := range group.items {
+			names[i] = item.Name
+		}
+		lines = append(lines, fmt.Sprintf("%s (%d): %s", group.kind, len(names), strings.Join(names, ", ")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func sortResources(items []StackResource) []StackResource {
+	slices.SortFunc(items, func(a, b StackResource) int { return strings.Compare(a.Name, b.Name) })
+	return items
+}
+
+// ListStackResources finds the services, networks, configs and secrets
+// labelled with the stack's namespace, as `docker stack rm` does.
+func ListStackResources(ctx context.Context, stack string) (StackResources, error) {
+	res := StackResources{Stack: stack}
+	c, err := clientFor(ctx)
+	if err != nil {
+		return res, fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	args := stackFilter(stack)
+	services, err := c.ServiceList(ctx, swarm.ServiceListOptions{Filters: args})
+	if err != nil {
+		return res, fmt.Errorf("listing services: %w", err)
+	}
+	for _, s := range services {
+		res.Services = append(res.Services, StackResource{s.ID, s.Spec.Name})
+	}
+	networks, err := c.NetworkList(ctx, network.ListOptions{Filters: args})
+	if err != nil {
+		return res, fmt.Errorf("listing networks: %w", err)
+	}
+	for _, n := range networks {
+		res.Networks = append(res.Networks, StackResource{n.ID, n.Name})
+	}
+	configs, err := c.ConfigList(ctx, swarm.ConfigListOptions{Filters: args})
+	if err != nil {
+		return res, fmt.Errorf("listing configs: %w", err)
+	}
+	for _, cfg := range configs {
+		res.Configs = append(res.Configs, StackResource{cfg.ID, cfg.Spec.Name})
+	}
+	secrets, err := c.SecretList(ctx, swarm.SecretListOptions{Filters: args})
+	if err != nil {
+		return res, fmt.Errorf("listing secrets: %w", err)
+	}
+	for _, s := range secrets {
+		res.Secrets = append(res.Secrets, StackResource{s.ID, s.Spec.Name})
+	}
+	sortResources(res.Services)
+	sortResources(res.Networks)
+	sortResources(res.Configs)
+	sortResources(res.Secrets)
+	return res, nil
+}
+
+type stackRemover struct {
+	stepReporter
+	c *client.Client
+}
+
+// RemoveStack removes the objects of a stack in dependency order: the
+// services, then, once their tasks are gone, the networks, configs and
+// secrets. Those still in use are retried while the tasks drain. Every
+// object is reported on progress; a failure does not stop the others.
+func RemoveStack(ctx context.Context, res StackResources, progress chan<- StackStep) error {
+	c, err := clientFor(ctx)
+	if err != nil {
+		return fmt.Errorf("docker client: %w", err)
+	}
+	defer closeCli(c)
+
+	r := &stackRemover{stepReporter: stepReporter{ctx, progress}, c: c}
+	l().Infof("🗑️  Removing stack %s (%d objects)\n", res.Stack, res.Count())
+
+	var errs []error
+	removed := map[string]string{}
+	for _, svc := range res.Services {
+		r.report(StackStep{Kind: "service", Name: svc.Name, Action: StepRemove})
+		if err := c.ServiceRemove(ctx, svc.ID); err != nil && !cerrdefs.IsNotFound(err) {
+			err = fmt.Errorf("removing service %s: %w", svc.Name, err)
+			r.report(StackStep{Kind: "service", Name: svc.Name, Action: StepRemove, Done: true, Err: err})
+			errs = append(errs, err)
+			continue
+		}
+		removed[svc.ID] = svc.Name
+	}
+	if err := r.waitForTasks(removed); err != nil {
+		errs = append(errs, err)
+	}
+
+	for _, n := range res.Networks {
+		errs = append(errs, r.removeWithRetry("network", n.Name, func() error {
+			return c.NetworkRemove(ctx, n.ID)
+		}))
+	}
+	for _, cfg := range res.Configs {
+		errs = append(errs, r.removeWithRetry("config", cfg.Name, func() error {
+			return c.ConfigRemove(ctx, cfg.ID)
+		}))
+	}
+	for _, s := range res.Secrets {
+		errs = append(errs, r.removeWithRetry("secret", s.Name, func() error {
+			return c.SecretRemove(ctx, s.ID)
+		}))
+	}
+
+	err = errors.Join(errs...)
+	if err == nil {
+		l().Infof("✅ Stack %s removed\n", res.Stack)
+	}
+	return err
+}
+
+// waitForTasks reports each removed service as waiting until swarm has
+// deleted its tasks, or stackTasksTimeout.
+func (r *stackRemover) waitForTasks(services map[string]string) error {
+	waiting := map[string]int{}
+	deadline := time.Now().Add(stackTasksTimeout)
+	for len(services) > 0 {
+		tasks, err := r.c.TaskList(r.ctx, swarm.TaskListOptions{})
+		if err != nil {
+			return fmt.Errorf("listing tasks: %w", err)
+		}
+		left := map[string]int{}
+		for _, t := range tasks {
+			if _, ok := services[t.ServiceID]; ok {
+				left[t.ServiceID]++
+			}
+		}
+		for id, name := range services {
+			switch n := left[id]; {
+			case n == 0:
+				r.done("service", name, StepRemove)
+				delete(services, id)
+			case time.Now().After(deadline):
+				r.report(StackStep{Kind: "service", Name: name, Action: StepRemove, Done: true,
+					Warnings: []string{fmt.Sprintf("%d tasks still stopping after %s", n, stackTasksTimeout)}})
+				delete(services, id)
+			case n != waiting[id]:
+				waiting[id] = n
+				r.report(StackStep{Kind: "service", Name: name, Action: StepRemove,
+					Detail: fmt.Sprintf("waiting for %d tasks to stop", n)})
+			}
+		}
+		if len(services) == 0 {
+			break
+		}
+		select {
+		case <-r.ctx.Done():
+			return r.ctx.Err()
+		case <-time.After(time.Second):
+		}
+	}
+	return nil
+}
+
+// isInUse reports whether a removal failed because the object is still
+// used: swarm answers with a conflict for configs and secrets of running
+// tasks, and networks report their active endpoints.
+func isInUse(err error) bool {
+	if cerrdefs.IsConflict(err) {
+		return true
+	}
+	msg := strings.ToLower(err.Error())
+	return strings.Contains(msg, "in use") || strings.Contains(msg, "active endpoints")
+}
+
+// removeWithRetry removes an object, retrying while it is still in use.
+// An object already gone counts as removed; any other error fails at once.
+func (r *stackRemover) removeWithRetry(kind, name string, remove func() error) error {
+	r.report(StackStep{Kind: kind, Name: name, Action: StepRemove})
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = remove()
+		if err == nil || cerrdefs.IsNotFound(err) {
+			l().Infof("🗑️  %s %s removed\n", kind, name)
+			r.done(kind, name, StepRemove)
+			return nil
+		}
+		if attempt == stackRemoveAttempts || !isInUse(err) {
+			break
+		}
+		l().Warnf("⚠️  Removing %s %s (attempt %d): %v\n", kind, name, attempt, err)
+		r.report(StackStep{Kind: kind, Name: name, Action: StepRemove,
+			Detail: fmt.Sprintf("still in use, retrying (%d of %d)", attempt+1, stackRemoveAttempts)})
+		select {
+		case <-r.ctx.Done():
+			return r.ctx.Err()
+		case <-time.After(stackRemoveRetryDelay):
+		}
+	}
+	err = fmt.Errorf("removing %s %s: %w", kind, name, err)
+	r.report(StackStep{Kind: kind, Name: name, Action: StepRemove, Done: true, Err: err})
+	return err
+}
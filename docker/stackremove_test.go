@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	cerrdefs "github.com/containerd/errdefs"
+)
+
+func TestIsInUse(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"conflict", fmt.Errorf("removing: %w", cerrdefs.ErrConflict), true},
+		{"secret in use", errors.New("rpc error: code = InvalidArgument desc = secret 'db' is in use by the following service: app_db"), true},
+		{"network endpoints", errors.New("error while removing network: network app_back id 1a2b has active endpoints"), true},
+		{"permission denied", cerrdefs.ErrPermissionDenied, false},
+		{"other error", errors.New("connection refused"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isInUse(tt.err); got != tt.want {
+				t.Errorf("isInUse(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRemoveWithRetryStopsOnOtherErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		wantErr bool
+	}{
+		{"removed", nil, false},
+		{"already gone", cerrdefs.ErrNotFound, false},
+		{"permission denied", cerrdefs.ErrPermissionDenied, true},
+		{"connection refused", errors.New("connection refused"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			progress := make(chan StackStep, 2*stackRemoveAttempts)
+			r := &stackRemover{stepReporter: stepReporter{context.Background(), progress}}
+			calls := 0
+			err := r.removeWithRetry("config", "app_site", func() error {
+				calls++
+				return tt.err
+			})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("removeWithRetry error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && !errors.Is(err, tt.err) {
+				t.Errorf("removeWithRetry error = %v, want it to wrap %v", err, tt.err)
+			}
+			if calls != 1 {
+				t.Errorf("remove called %d times, want 1", calls)
+			}
+			close(progress)
+			var last StackStep
+			for step := range progress {
+				last = step
+			}
+			if !last.Done || (last.Err != nil) != tt.wantErr {
+				t.Errorf("last step = %+v, want done with wantErr %v", last, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,446 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/docker/docker/api/types/swarm"
+	"github.com/docker/go-units"
+)
+
+// The stats collector is a global service that runs `docker stats` on every
+// node and prints the results to its log, prefixed with the node ID and the
+// time of the round. Reading the service logs through the manager then gives
+// the container stats of the whole cluster, where ContainerStats only sees
+// the daemon of the current context.
+const (
+	StatsCollectorName  = "swarmcli-stats"
+	StatsCollectorImage = "docker:cli"
+
+	statsCollectorPurpose = "stats-collector"
+	statsInterval         = 5 * time.Second
+	// statsLogWindow is how much of the collector log is read; it spans a
+	// few rounds so a slow node still reports.
+	statsLogWindow = 4 * statsInterval
+	// statsMaxAge is how long ClusterStats reuses the last result.
+	statsMaxAge = statsInterval
+	// The collector prints a round every statsInterval for as long as it
+	// runs, and only the last statsLogWindow is ever read, so its log is
+	// rotated to keep it from filling the nodes' disks.
+	statsLogMaxSize  = "1m"
+	statsLogMaxFiles = "2"
+)
+
+// statsScript prints one line per container each round:
+// node|round|name|cpu%|used / limit
+var statsScript = fmt.Sprintf(`while :; do
+  round=$(date +%%s)
+  docker stats --no-stream --format '{{.Name}}|{{.CPUPerc}}|{{.MemUsage}}' | sed "s/^/$NODE_ID|$round|/"
+  sleep %d
+done`, int(statsInterval.Seconds()))
+
+// ErrNoStatsCollector is returned by ClusterStats when the collector is not
+// deployed.
+var ErrNoStatsCollector = errors.New("stats collector not deployed")
+
+// StatsCollectorMsg reports the outcome of a stats collector action.
+type StatsCollectorMsg struct {
+	// Action is "start", "stop" or "status".
+	Action string
+	// Running tells whether the collector is deployed afterwards.
+	Running bool
+	// Nodes is the number of nodes reporting stats.
+	Nodes int
+	Err   error
+}
+
+// ContainerStats is the usage of one container as reported by the collector.
+type ContainerStats struct {
+	NodeID string
+	Name   string
+	// Service and TaskID are empty for containers not started by swarm.
+	Service string
+	TaskID  string
+
+	// CPU is a percentage of one core, like `docker stats`.
+	CPU      float64
+	MemUsed  int64
+	MemLimit int64
+}
+
+// Usage is the summed usage of a group of containers.
+type Usage struct {
+	CPU        float64
+	MemUsed    int64
+	MemLimit   int64
+	Containers int
+}
+
+func (u *Usage) add(c ContainerStats) {
+	u.CPU += c.CPU
+	u.MemUsed += c.MemUsed
+	u.MemLimit += c.MemLimit
+	u.Containers++
+}
+
+// SwarmStats holds the latest container stats of every node.
+type SwarmStats struct {
+	Containers []ContainerStats
+	Fetched    time.Time
+}
+
+// Total sums the usage of the whole cluster.
+func (s SwarmStats) Total() Usage {
+	var u Usage
+	for _, c := range s.Containers {
+		u.add(c)
+	}
+	return u
+}
+
+// ByNode sums the usage per node ID.
+func (s SwarmStats) ByNode() map[string]Usage {
+	out := map[string]Usage{}
+	for _, c := range s.Containers {
+		u := out[c.NodeID]
+		u.add(c)
+		out[c.NodeID] = u
+	}
+	return out
+}
+
+// ByService sums the usage per service name; containers outside swarm are
+// left out.
+func (s SwarmStats) ByService() map[string]Usage {
+	out := map[string]Usage{}
+	for _, c := range s.Containers {
+		if c.Service == "" {
+			continue
+		}
+		u := out[c.Service]
+		u.add(c)
+		out[c.Service] = u
+	}
+	return out
+}
+
+// ByTask returns the stats of each swarm task's container.
+func (s SwarmStats) ByTask() map[string]ContainerStats {
+	out := map[string]ContainerStats{}
+	for _, c := range s.Containers {
+		if c.TaskID != "" {
+			out[c.TaskID] = c
+		}
+	}
+	return out
+}
+
+var (
+	statsMu sync.Mutex
+	// statsCache holds the last SwarmStats per Docker context.
+	statsCache = map[string]*SwarmStats{}
+)
+
+// statsCollectorSpec is the global service running the collector on every
+// Linux node.
+func statsCollectorSpec() swarm.ServiceSpec {
+	return swarm.ServiceSpec{
+		Annotations: swarm.Annotations{
+			Name: StatsCollectorName,
+			Labels: map[string]string{
+				"swarmcli.temporary": "true",
+				"swarmcli.purpose":   statsCollectorPurpose,
+			},
+		},
+		TaskTemplate: swarm.TaskSpec{
+			ContainerSpec: &swarm.ContainerSpec{
+				Image:   StatsCollectorImage,
+				Command: []string{"sh", "-c", statsScript},
+				Env:     []string{"NODE_ID={{.Node.ID}}"},
+				Mounts: []mount.Mount{{
+					Type:     mount.TypeBind,
+					Source:   "/var/run/docker.sock",
+					Target:   "/var/run/docker.sock",
+					ReadOnly: true,
+				}},
+			},
+			Resources: &swarm.ResourceRequirements{
+				Limits: &swarm.Limit{
+					NanoCPUs:    100_000_000,
+					MemoryBytes: 64 * 1024 * 1024,
+				},
+			},
+			Placement: &swarm.Placement{
+				Constraints: []string{"node.platform.os == linux"},
+			},
+			// The stats are read back from the service logs, which needs a
+			// driver `docker service logs` supports.
+			LogDriver: &swarm.Driver{
+				Name: "json-file",
+				Options: map[string]string{
+					"max-size": statsLogMaxSize,
+					"max-file": statsLogMaxFiles,
+				},
+			},
+		},
+		Mode: swarm.ServiceMode{Global: &swarm.GlobalService{}},
+	}
+}
+
+// findStatsCollector returns the collector service; nil when it is not
+// deployed.
+func findStatsCollector(ctx context.Context) (*swarm.Service, error) {
+	cli, err := clientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer closeCli(cli)
+
+	services, err := cli.ServiceList(ctx, swarm.ServiceListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", "swarmcli.purpose="+statsCollectorPurpose)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing services: %w", err)
+	}
+	if len(services) == 0 {
+		return nil, nil
+	}
+	return &services[0], nil
+}
+
+// StartStatsCollector deploys the collector unless it already runs.
+func StartStatsCollector(ctx context.Context) error {
+	svc, err := findStatsCollector(ctx)
+	if err != nil {
+		return err
+	}
+	if svc != nil {
+		return nil
+	}
+	if _, err := CreateService(ctx, statsCollectorSpec()); err != nil {
+		return err
+	}
+	l().Infof("[StartStatsCollector] deployed %s", StatsCollectorName)
+	return nil
+}
+
+// StopStatsCollector removes the collector; it is a no-op when it is not
+// deployed.
+func StopStatsCollector(ctx context.Context) error {
+	svc, err := findStatsCollector(ctx)
+	if err != nil || svc == nil {
+		return err
+	}
+	cli, err := clientFor(ctx)
+	if err != nil {
+		return err
+	}
+	defer closeCli(cli)
+
+	if err := cli.ServiceRemove(ctx, svc.ID); err != nil {
+		return fmt.Errorf("removing %s: %w", StatsCollectorName, err)
+	}
+	invalidateStats(ctx)
+	l().Infof("[StopStatsCollector] removed %s", StatsCollectorName)
+	return nil
+}
+
+// LoadSwarmStats reads the latest round of every node from the collector
+// log. It returns ErrNoStatsCollector when the collector is not deployed.
+func LoadSwarmStats(ctx context.Context) (*SwarmStats, error) {
+	svc, err := findStatsCollector(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if svc == nil {
+		return nil, ErrNoStatsCollector
+	}
+
+	cli, err := clientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer closeCli(cli)
+
+	reader, err := cli.ServiceLogs(ctx, svc.ID, container.LogsOptions{
+		ShowStdout: true,
+		Since:      statsLogWindow.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("reading collector logs: %w", err)
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	raw, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("reading collector logs: %w", err)
+	}
+	return parseStatsLog(stripDockerLogHeaders(raw), time.Now()), nil
+}
+
+// parseStatsLog keeps the last complete round of each node. Rounds are
+// told apart by their timestamp; the newest one of a node may still be
+// printing, so a round only counts once a later one started or it is older
+// than an interval.
+func parseStatsLog(log string, now time.Time) *SwarmStats {
+	type round struct {
+		at         int64
+		containers []ContainerStats
+	}
+	// rounds per node, oldest first
+	rounds := map[string][]*round{}
+
+	sc := bufio.NewScanner(strings.NewReader(log))
+	for sc.Scan() {
+		c, at, ok := parseStatsLine(sc.Text())
+		if !ok {
+			continue
+		}
+		rs := rounds[c.NodeID]
+		if len(rs) == 0 || rs[len(rs)-1].at != at {
+			rs = append(rs, &round{at: at})
+			rounds[c.NodeID] = rs
+		}
+		r := rs[len(rs)-1]
+		r.containers = append(r.containers, c)
+	}
+
+	nodes := make([]string, 0, len(rounds))
+	for n := range rounds {
+		nodes = append(nodes, n)
+	}
+	sort.Strings(nodes)
+
+	stats := &SwarmStats{Fetched: now}
+	for _, n := range nodes {
+		rs := rounds[n]
+		last := rs[len(rs)-1]
+		if len(rs) > 1 && now.Sub(time.Unix(last.at, 0)) < statsInterval {
+			last = rs[len(rs)-2]
+		}
+		stats.Containers = append(stats.Containers, last.containers...)
+	}
+	return stats
+}
+
+// parseStatsLine parses node|round|name|cpu%|used / limit.
+func parseStatsLine(line string) (ContainerStats, int64, bool) {
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) != 5 {
+		return ContainerStats{}, 0, false
+	}
+	at, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return ContainerStats{}, 0, false
+	}
+
+	c := ContainerStats{NodeID: parts[0], Name: parts[2]}
+	// Swarm names task containers <service>.<slot or node>.<task>
+	if f := strings.Split(c.Name, "."); len(f) == 3 {
+		c.Service, c.TaskID = f[0], f[2]
+	}
+
+	c.CPU, err = strconv.ParseFloat(strings.TrimSuffix(parts[3], "%"), 64)
+	if err != nil {
+		return ContainerStats{}, 0, false
+	}
+	if used, limit, ok := strings.Cut(parts[4], " / "); ok {
+		c.MemUsed = parseStatsBytes(used)
+		c.MemLimit = parseStatsBytes(limit)
+	}
+	return c, at, true
+}
+
+// parseStatsBytes reads a size like "64MiB"; what docker stats cannot
+// measure ("--") counts as zero.
+func parseStatsBytes(s string) int64 {
+	n, err := units.RAMInBytes(strings.TrimSpace(s))
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// ClusterStats returns the collector's stats of the active context, reusing
+// a recent result. It returns ErrNoStatsCollector when the collector is not
+// deployed.
+func ClusterStats() (*SwarmStats, error) {
+	return ClusterStatsFor(activeKey())
+}
+
+// ClusterStatsFor is ClusterStats for the named context.
+func ClusterStatsFor(ctxName string) (*SwarmStats, error) {
+	statsMu.Lock()
+	cached := statsCache[ctxName]
+	statsMu.Unlock()
+	if cached != nil && time.Since(cached.Fetched) < statsMaxAge {
+		return cached, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stats, err := LoadSwarmStats(WithContextName(ctx, ctxName))
+	if err != nil {
+		return nil, err
+	}
+
+	statsMu.Lock()
+	statsCache[ctxName] = stats
+	statsMu.Unlock()
+	return stats, nil
+}
+
+func invalidateStats(ctx context.Context) {
+	key, _ := contextName(ctx)
+	statsMu.Lock()
+	delete(statsCache, key)
+	statsMu.Unlock()
+}
+
+// StatsCollectorAction runs a collector action ("start", "stop" or
+// "status") and reports the result.
+func StatsCollectorAction(action string) StatsCollectorMsg {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	msg := StatsCollectorMsg{Action: action}
+	switch action {
+	case "start":
+		msg.Err = StartStatsCollector(ctx)
+	case "stop":
+		msg.Err = StopStatsCollector(ctx)
+	case "status":
+	default:
+		msg.Err = fmt.Errorf("unknown action %q (use start, stop or status)", action)
+	}
+	if msg.Err != nil {
+		return msg
+	}
+
+	stats, err := LoadSwarmStats(ctx)
+	switch {
+	case errors.Is(err, ErrNoStatsCollector):
+	case err != nil:
+		msg.Err = err
+	default:
+		msg.Running = true
+		msg.Nodes = len(stats.ByNode())
+	}
+	return msg
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseStatsLine(t *testing.T) {
+	tests := []struct {
+		name   string
+		line   string
+		want   ContainerStats
+		wantAt int64
+		wantOK bool
+	}{
+		{
+			name:   "task container",
+			line:   "n1|100|web.1.abc|12.5%|64MiB / 1GiB",
+			want:   ContainerStats{NodeID: "n1", Name: "web.1.abc", Service: "web", TaskID: "abc", CPU: 12.5, MemUsed: 64 << 20, MemLimit: 1 << 30},
+			wantAt: 100, wantOK: true,
+		},
+		{
+			name:   "global task container",
+			line:   "n1|100|agent.n1.def|0.00%|512KiB / 2GiB",
+			want:   ContainerStats{NodeID: "n1", Name: "agent.n1.def", Service: "agent", TaskID: "def", MemUsed: 512 << 10, MemLimit: 2 << 30},
+			wantAt: 100, wantOK: true,
+		},
+		{
+			name:   "plain container",
+			line:   "n2|100|builder|150%|1.5GiB / 7.5GiB",
+			want:   ContainerStats{NodeID: "n2", Name: "builder", CPU: 150, MemUsed: 3 << 29, MemLimit: 15 << 29},
+			wantAt: 100, wantOK: true,
+		},
+		{
+			name:   "surrounding whitespace",
+			line:   "  n1|100|web.1.abc|1%|1MiB / 1MiB\r",
+			want:   ContainerStats{NodeID: "n1", Name: "web.1.abc", Service: "web", TaskID: "abc", CPU: 1, MemUsed: 1 << 20, MemLimit: 1 << 20},
+			wantAt: 100, wantOK: true,
+		},
+		{
+			name:   "memory not reported",
+			line:   "n1|100|web.1.abc|1%|-- / --",
+			want:   ContainerStats{NodeID: "n1", Name: "web.1.abc", Service: "web", TaskID: "abc", CPU: 1},
+			wantAt: 100, wantOK: true,
+		},
+		{
+			name:   "memory without a limit",
+			line:   "n1|100|web.1.abc|1%|64MiB",
+			want:   ContainerStats{NodeID: "n1", Name: "web.1.abc", Service: "web", TaskID: "abc", CPU: 1},
+			wantAt: 100, wantOK: true,
+		},
+		{name: "too few fields", line: "n1|100|web.1.abc|1%"},
+		{name: "too many fields", line: "n1|100|web.1.abc|1%|1MiB / 1GiB|x"},
+		{name: "round is not a number", line: "n1|now|web.1.abc|1%|1MiB / 1GiB"},
+		{name: "CPU is not a number", line: "n1|100|web.1.abc|--|1MiB / 1GiB"},
+		{name: "empty line", line: ""},
+		{name: "collector message", line: "waiting for docker.sock"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, at, ok := parseStatsLine(tt.line)
+			if ok != tt.wantOK {
+				t.Fatalf("parseStatsLine(%q) ok = %v, want %v", tt.line, ok, tt.wantOK)
+			}
+			if got != tt.want || at != tt.wantAt {
+				t.Errorf("parseStatsLine(%q) = %+v, %d, want %+v, %d", tt.line, got, at, tt.want, tt.wantAt)
+			}
+		})
+	}
+}
+
+func TestParseStatsLog(t *testing.T) {
+	now := time.Unix(1000, 0)
+	// old is well before now, recent is less than an interval ago
+	const old, older, recent = "990", "985", "998"
+	line := func(node, at, name string) string {
+		return node + "|" + at + "|" + name + "|1%|1MiB / 1GiB"
+	}
+	tests := []struct {
+		name string
+		log  []string
+		// want lists node/name of the kept containers, in order
+		want []string
+	}{
+		{"empty", nil, nil},
+		{
+			name: "single finished round",
+			log:  []string{line("n1", old, "a"), line("n1", old, "b")},
+			want: []string{"n1/a", "n1/b"},
+		},
+		{
+			name: "newest round still printing is skipped",
+			log:  []string{line("n1", old, "a"), line("n1", old, "b"), line("n1", recent, "a")},
+			want: []string{"n1/a", "n1/b"},
+		},
+		{
+			name: "newest round older than an interval is kept",
+			log:  []string{line("n1", older, "a"), line("n1", old, "a"), line("n1", old, "b")},
+			want: []string{"n1/a", "n1/b"},
+		},
+		{
+			name: "only round is kept even while printing",
+			log:  []string{line("n1", recent, "a")},
+			want: []string{"n1/a"},
+		},
+		{
+			name: "rounds are per node, nodes sorted",
+			log: []string{
+				line("n2", old, "c"),
+				line("n1", older, "x"),
+				line("n1", old, "a"),
+				line("n2", recent, "d"),
+			},
+			want: []string{"n1/a", "n2/c"},
+		},
+		{
+			name: "malformed lines are ignored",
+			log: []string{
+				"stats collector starting",
+				line("n1", old, "a"),
+				"n1|" + old + "|b|bad|1MiB / 1GiB",
+				"n1|" + old + "|c",
+				"",
+				line("n1", old, "d"),
+			},
+			want: []string{"n1/a", "n1/d"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := parseStatsLog(strings.Join(tt.log, "\n"), now)
+			if !stats.Fetched.Equal(now) {
+				t.Errorf("Fetched = %v, want %v", stats.Fetched, now)
+			}
+			var got []string
+			for _, c := range stats.Containers {
+				got = append(got, c.NodeID+"/"+c.Name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("containers = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
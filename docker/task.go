@@ -24,15 +24,30 @@ type TaskEntry struct {
 	Ports        string
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+
+	// Restarts counts the exited tasks that preceded this one in its slot.
+	Restarts int
+	// MemLimit is the task's memory limit; 0 when unlimited.
+	MemLimit int64
+
+	// Usage, set by AttachTaskUsage when stats are available.
+	Measured bool
+	CPU      float64
+	MemUsed  int64
 }
 
 // GetTasksForStack returns all tasks for services in the given stack
 func GetTasksForStack(stackName string) ([]TaskEntry, error) {
-	snap := GetSnapshot()
+	return GetTasksForStackFor(activeKey(), stackName)
+}
+
+// GetTasksForStackFor is GetTasksForStack for the named context.
+func GetTasksForStackFor(ctxName, stackName string) ([]TaskEntry, error) {
+	snap := SnapshotFor(ctxName)
 	if snap == nil {
 		// No cached snapshot, try to refresh
 		var err error
-		snap, err = RefreshSnapshot()
+		snap, err = RefreshSnapshotFor(ctxName)
 		if err != nil {
 			return nil, fmt.Errorf("failed to refresh snapshot: %w", err)
 		}
@@ -98,6 +113,8 @@ func GetTasksForStack(stackName string) ([]TaskEntry, error) {
 				Ports:        "", // Ports are typically on service level, not task level
 				CreatedAt:    task.CreatedAt,
 				UpdatedAt:    task.UpdatedAt,
+				Restarts:     taskRestarts(task, snap),
+				MemLimit:     taskMemLimit(task),
 			})
 		}
 	}
@@ -127,7 +144,12 @@ func sortTasksByServiceAndTime(tasks []TaskEntry) {
 
 // GetTasksForService returns all tasks for a specific service ID from the cached snapshot.
 func GetTasksForService(serviceID string) ([]TaskEntry, error) {
-	snap := GetSnapshot()
+	return GetTasksForServiceFor(activeKey(), serviceID)
+}
+
+// GetTasksForServiceFor is GetTasksForService for the named context.
+func GetTasksForServiceFor(ctxName, serviceID string) ([]TaskEntry, error) {
+	snap := SnapshotFor(ctxName)
 	if snap == nil {
 		return nil, fmt.Errorf("no snapshot available")
 	}
@@ -193,6 +215,8 @@ func GetTasksForService(serviceID string) ([]TaskEntry, error) {
 				Ports:        "", // Ports are typically on service level, not task level
 				CreatedAt:    task.CreatedAt,
 				UpdatedAt:    task.UpdatedAt,
+				Restarts:     taskRestarts(task, snap),
+				MemLimit:     taskMemLimit(task),
 			})
 		}
 	}
@@ -209,6 +233,80 @@ func GetTasksForService(serviceID string) ([]TaskEntry, error) {
 	return tasks, nil
 }
 
+// GetTasksForNode returns all tasks scheduled on the given node from the
+// cached snapshot, grouped by service with the newest task first.
+func GetTasksForNode(nodeID string) ([]TaskEntry, error) {
+	return GetTasksForNodeFor(activeKey(), nodeID)
+}
+
+// GetTasksForNodeFor is GetTasksForNode for the named context.
+func GetTasksForNodeFor(ctxName, nodeID string) ([]TaskEntry, error) {
+	snap, err := GetOrRefreshSnapshotFor(ctxName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh snapshot: %w", err)
+	}
+
+	services := make(map[string]string)
+	for _, svc := range snap.Services {
+		services[svc.ID] = svc.Spec.Name
+	}
+	nodeName := nodeID
+	for _, node := range snap.Nodes {
+		if node.ID == nodeID {
+			nodeName = node.Description.Hostname
+			break
+		}
+	}
+
+	var tasks []TaskEntry
+	for _, task := range snap.Tasks {
+		if task.NodeID != nodeID {
+			continue
+		}
+		tasks = append(tasks, newTaskEntry(task, services[task.ServiceID], nodeName, snap))
+	}
+	sortTasksByServiceAndTime(tasks)
+
+	return tasks, nil
+}
+
+// newTaskEntry converts a swarm task for display.
+func newTaskEntry(task swarm.Task, serviceName, nodeName string, snap *SwarmSnapshot) TaskEntry {
+	// Extract image name (without registry/tag details for cleaner display)
+	image := strings.Split(task.Spec.ContainerSpec.Image, "@")[0]
+
+	// Format current state with timestamp
+	currentState := string(task.Status.State)
+	if !task.Status.Timestamp.IsZero() {
+		currentState = fmt.Sprintf("%s %s", currentState, formatTaskDuration(time.Since(task.Status.Timestamp)))
+	}
+
+	errorMsg := task.Status.Err
+	if len(errorMsg) > 50 {
+		errorMsg = errorMsg[:47] + "…"
+	}
+
+	id := task.ID
+	if len(id) > 12 {
+		id = id[:12]
+	}
+
+	return TaskEntry{
+		ID:           id,
+		Name:         fmt.Sprintf("%s.%d", serviceName, task.Slot),
+		ServiceName:  serviceName,
+		Image:        image,
+		NodeName:     nodeName,
+		DesiredState: string(task.DesiredState),
+		CurrentState: currentState,
+		Error:        errorMsg,
+		CreatedAt:    task.CreatedAt,
+		UpdatedAt:    task.UpdatedAt,
+		Restarts:     taskRestarts(task, snap),
+		MemLimit:     taskMemLimit(task),
+	}
+}
+
 func formatTaskDuration(d time.Duration) string {
 	if d < time.Minute {
 		return fmt.Sprintf("%d seconds ago", int(d.Seconds()))
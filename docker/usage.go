@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/filters"
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// TaskStats returns the usage of each task's container, keyed by task ID.
+// It covers every node when the stats collector is deployed and the tasks
+// on the daemon of the current context otherwise.
+func TaskStats() (map[string]ContainerStats, error) {
+	return TaskStatsFor(activeKey())
+}
+
+// TaskStatsFor is TaskStats for the named context.
+func TaskStatsFor(ctxName string) (map[string]ContainerStats, error) {
+	stats, err := ClusterStatsFor(ctxName)
+	if errors.Is(err, ErrNoStatsCollector) {
+		stats, err = localTaskStats(ctxName)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return stats.ByTask(), nil
+}
+
+// localTaskStats reads the stats of the swarm task containers on the
+// daemon of the named context, reusing a recent result like ClusterStats.
+func localTaskStats(ctxName string) (*SwarmStats, error) {
+	key := ctxName + "/local"
+	statsMu.Lock()
+	cached := statsCache[key]
+	statsMu.Unlock()
+	if cached != nil && time.Since(cached.Fetched) < statsMaxAge {
+		return cached, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stats, err := loadLocalTaskStats(WithContextName(ctx, ctxName))
+	if err != nil {
+		return nil, err
+	}
+
+	statsMu.Lock()
+	statsCache[key] = stats
+	statsMu.Unlock()
+	return stats, nil
+}
+
+func loadLocalTaskStats(ctx context.Context) (*SwarmStats, error) {
+	cli, err := clientFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer closeCli(cli)
+
+	containers, err := cli.ContainerList(ctx, container.ListOptions{
+		Filters: filters.NewArgs(filters.Arg("label", "com.docker.swarm.task.id")),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing containers: %w", err)
+	}
+
+	out := make([]ContainerStats, len(containers))
+	ok := make([]bool, len(containers))
+	var wg sync.WaitGroup
+	for i, c := range containers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := cli.ContainerStats(ctx, c.ID, false)
+			if err != nil {
+				l().Infof("[loadLocalTaskStats] ContainerStats %s: %v", c.ID[:12], err)
+				return
+			}
+			defer func() {
+				_ = resp.Body.Close()
+			}()
+
+			var s container.StatsResponse
+			if err := json.NewDecoder(resp.Body).Decode(&s); err != nil {
+				l().Infof("[loadLocalTaskStats] decoding stats of %s: %v", c.ID[:12], err)
+				return
+			}
+			out[i] = ContainerStats{
+				NodeID:   c.Labels["com.docker.swarm.node.id"],
+				Service:  c.Labels["com.docker.swarm.service.name"],
+				TaskID:   c.Labels["com.docker.swarm.task.id"],
+				CPU:      cpuPercent(s),
+				MemUsed:  memUsed(s),
+				MemLimit: int64(s.MemoryStats.Limit),
+			}
+			if len(c.Names) > 0 {
+				out[i].Name = c.Names[0][1:]
+			}
+			ok[i] = true
+		}()
+	}
+	wg.Wait()
+
+	stats := &SwarmStats{Fetched: time.Now()}
+	for i, c := range out {
+		if ok[i] {
+			stats.Containers = append(stats.Containers, c)
+		}
+	}
+	return stats, nil
+}
+
+// cpuPercent computes the CPU usage like `docker stats`: 100% is one core.
+func cpuPercent(s container.StatsResponse) float64 {
+	cpuDelta := float64(s.CPUStats.CPUUsage.TotalUsage - s.PreCPUStats.CPUUsage.TotalUsage)
+	systemDelta := float64(s.CPUStats.SystemUsage - s.PreCPUStats.SystemUsage)
+	onlineCPUs := float64(s.CPUStats.OnlineCPUs)
+	if onlineCPUs == 0 {
+		onlineCPUs = float64(len(s.CPUStats.CPUUsage.PercpuUsage))
+	}
+	if systemDelta <= 0 || cpuDelta < 0 {
+		return 0
+	}
+	return cpuDelta / systemDelta * onlineCPUs * 100.0
+}
+
+// memUsed computes the memory usage like `docker stats`, without the page
+// cache the kernel can reclaim.
+func memUsed(s container.StatsResponse) int64 {
+	used := s.MemoryStats.Usage
+	for _, k := range []string{"inactive_file", "total_inactive_file"} {
+		if v, ok := s.MemoryStats.Stats[k]; ok && v < used {
+			return int64(used - v)
+		}
+	}
+	return int64(used)
+}
+
+// taskMemLimit returns the memory limit of a task; 0 when unlimited.
+func taskMemLimit(t swarm.Task) int64 {
+	if r := t.Spec.Resources; r != nil && r.Limits != nil {
+		return r.Limits.MemoryBytes
+	}
+	return 0
+}
+
+// AttachServiceUsage fills in the CPU and memory usage of the services from
+// the task stats. A service's limit is the sum of its measured tasks'
+// limits, and stays 0 when any of them is unlimited.
+func AttachServiceUsage(entries []ServiceEntry) error {
+	return AttachServiceUsageFor(activeKey(), entries)
+}
+
+// AttachServiceUsageFor is AttachServiceUsage for the named context.
+func AttachServiceUsageFor(ctxName string, entries []ServiceEntry) error {
+	stats, err := TaskStatsFor(ctxName)
+	if err != nil {
+		return err
+	}
+	snap := SnapshotFor(ctxName)
+	if snap == nil {
+		return nil
+	}
+
+	index := make(map[string]int, len(entries))
+	for i := range entries {
+		index[entries[i].ServiceID] = i
+	}
+	unlimited := map[string]bool{}
+	for _, t := range snap.Tasks {
+		i, ok := index[t.ServiceID]
+		if !ok {
+			continue
+		}
+		c, ok := stats[t.ID]
+		if !ok {
+			continue
+		}
+		e := &entries[i]
+		e.Measured++
+		e.CPU += c.CPU
+		e.MemUsed += c.MemUsed
+		if limit := taskMemLimit(t); limit > 0 {
+			e.MemLimit += limit
+		} else {
+			unlimited[t.ServiceID] = true
+		}
+	}
+	for id := range unlimited {
+		entries[index[id]].MemLimit = 0
+	}
+	return nil
+}
+
+// AttachTaskUsage fills in the CPU and memory usage of the tasks from the
+// task stats.
+func AttachTaskUsage(tasks []TaskEntry) error {
+	return AttachTaskUsageFor(activeKey(), tasks)
+}
+
+// AttachTaskUsageFor is AttachTaskUsage for the named context.
+func AttachTaskUsageFor(ctxName string, tasks []TaskEntry) error {
+	stats, err := TaskStatsFor(ctxName)
+	if err != nil {
+		return err
+	}
+	// TaskEntry IDs are shortened
+	short := make(map[string]ContainerStats, len(stats))
+	for id, c := range stats {
+		if len(id) > 12 {
+			id = id[:12]
+		}
+		short[id] = c
+	}
+	for i := range tasks {
+		c, ok := short[tasks[i].ID]
+		if !ok {
+			continue
+		}
+		tasks[i].Measured = true
+		tasks[i].CPU = c.CPU
+		tasks[i].MemUsed = c.MemUsed
+	}
+	return nil
+}
+
+// exited reports whether a task's container stopped on its own, which
+// makes swarm start a replacement: a restart. Job tasks, those with a job
+// iteration, are meant to complete; only their failures count.
+func exited(t swarm.Task) bool {
+	switch t.Status.State {
+	case swarm.TaskStateFailed, swarm.TaskStateRejected:
+		return true
+	case swarm.TaskStateComplete:
+		return t.JobIteration == nil
+	}
+	return false
+}
+
+// slotKey identifies the slot a task fills: its number for replicated
+// services, its node for global ones.
+func slotKey(t swarm.Task) string {
+	if t.Slot != 0 {
+		return fmt.Sprintf("%s/%d", t.ServiceID, t.Slot)
+	}
+	return t.ServiceID + "/" + t.NodeID
+}
+
+// countRestarts counts the exited tasks of a service; if nodeID != "", only
+// those on that node. Swarm keeps a limited task history (5 per slot by
+// default), so this is a lower bound.
+func countRestarts(serviceID, nodeID string, snap *SwarmSnapshot) int {
+	n := 0
+	for _, t := range snap.Tasks {
+		if t.ServiceID == serviceID && (nodeID == "" || t.NodeID == nodeID) && exited(t) {
+			n++
+		}
+	}
+	return n
+}
+
+// taskRestarts counts the exited tasks of the same slot created before t.
+func taskRestarts(t swarm.Task, snap *SwarmSnapshot) int {
+	key := slotKey(t)
+	n := 0
+	for _, o := range snap.Tasks {
+		if o.ID != t.ID && exited(o) && o.CreatedAt.Before(t.CreatedAt) && slotKey(o) == key {
+			n++
+		}
+	}
+	return n
+}
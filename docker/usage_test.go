@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/docker/docker/api/types/swarm"
+)
+
+func TestCountRestarts(t *testing.T) {
+	at := time.Date(2026, 1, 2, 3, 0, 0, 0, time.UTC)
+	task := func(id, service string, slot int, state swarm.TaskState, job bool, age time.Duration) swarm.Task {
+		tk := swarm.Task{
+			ID: id, ServiceID: service, NodeID: "n1", Slot: slot,
+			Status: swarm.TaskStatus{State: state},
+		}
+		tk.CreatedAt = at.Add(-age)
+		if job {
+			tk.JobIteration = &swarm.Version{Index: 1}
+		}
+		return tk
+	}
+	snap := &SwarmSnapshot{Tasks: []swarm.Task{
+		task("w1", "web", 1, swarm.TaskStateRunning, false, 0),
+		task("w2", "web", 1, swarm.TaskStateFailed, false, time.Minute),
+		task("w3", "web", 1, swarm.TaskStateComplete, false, 2*time.Minute),
+		task("w4", "web", 1, swarm.TaskStateRejected, false, 3*time.Minute),
+		task("w5", "web", 1, swarm.TaskStateShutdown, false, 4*time.Minute),
+		task("w6", "web", 2, swarm.TaskStateFailed, false, time.Minute),
+		task("j1", "migrate", 1, swarm.TaskStateComplete, true, 0),
+		task("j2", "migrate", 2, swarm.TaskStateComplete, true, 0),
+		task("j3", "migrate", 3, swarm.TaskStateFailed, true, time.Minute),
+		task("j4", "migrate", 3, swarm.TaskStateRunning, true, 0),
+	}}
+	tests := []struct {
+		service string
+		want    int
+	}{
+		{"web", 4},
+		{"migrate", 1},
+		{"missing", 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.service, func(t *testing.T) {
+			if got := countRestarts(tt.service, "", snap); got != tt.want {
+				t.Errorf("countRestarts(%s) = %d, want %d", tt.service, got, tt.want)
+			}
+		})
+	}
+
+	for id, want := range map[string]int{"w1": 3, "w6": 0, "j1": 0, "j4": 1} {
+		var tk swarm.Task
+		for _, o := range snap.Tasks {
+			if o.ID == id {
+				tk = o
+			}
+		}
+		if got := taskRestarts(tk, snap); got != want {
+			t.Errorf("taskRestarts(%s) = %d, want %d", id, got, want)
+		}
+	}
+}
@@ -0,0 +1,356 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package docker
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// Kinds of the items of an xray tree.
+const (
+	XrayCluster   = "cluster"
+	XrayStack     = "stack"
+	XrayNode      = "node"
+	XrayService   = "service"
+	XrayTask      = "task"
+	XrayContainer = "container"
+	XrayConfig    = "config"
+	XraySecret    = "secret"
+)
+
+// XrayScope is where an xray tree starts: a stack, a node, or the whole
+// cluster when both are empty.
+type XrayScope struct {
+	Stack string
+	// Node is the hostname or ID of a node.
+	Node string
+}
+
+// XrayItem is an item of an xray tree: the stack, node or cluster at its
+// root, then services, tasks, containers and the configs and secrets
+// mounted in them.
+type XrayItem struct {
+	Kind string
+	// ID is the full ID of the object; empty for stacks and the cluster.
+	ID   string
+	Name string
+	// State is the running/desired replicas of a service, the state of a
+	// node, task or container, or a count for stacks and the cluster.
+	State string
+	// Node is the hostname of the node a task or container runs on.
+	Node string
+	// Health of a container: healthy, unhealthy or starting. Only the
+	// containers of the node the client is connected to can be asked.
+	Health string
+	// ExitCode of a container, when Exited.
+	ExitCode int
+	Exited   bool
+	// Err is the error swarm reported for a task.
+	Err string
+	// Target is where a config or secret is mounted in its container.
+	Target string
+
+	// The service and task an item belongs to, for the actions on it.
+	ServiceID   string
+	ServiceName string
+	StackName   string
+	TaskID      string
+
+	Children []*XrayItem
+}
+
+// Key identifies an item across refreshes.
+func (it *XrayItem) Key() string {
+	if it.ID != "" {
+		// Configs and secrets are listed under each container mounting them
+		return it.Kind + "/" + it.TaskID + "/" + it.ID
+	}
+	return it.Kind + "/" + it.Name
+}
+
+// xrayBuilder turns the snapshot into a tree.
+type xrayBuilder struct {
+	snap      *SwarmSnapshot
+	hostnames map[string]string
+	// history keeps the tasks swarm shut down, with the exit codes of
+	// their containers.
+	history bool
+	// node limits the tasks to one node.
+	node string
+	// health of the containers of the node the client is connected to.
+	health map[string]string
+}
+
+// LoadXray builds the tree of a scope from a fresh snapshot. Only the
+// current tasks of each service are listed unless history is set.
+func LoadXray(ctx context.Context, scope XrayScope, history bool) (*XrayItem, error) {
+	ctxName, err := contextName(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("docker client: %w", err)
+	}
+	snap, err := RefreshSnapshotFor(ctxName)
+	if err != nil {
+		return nil, err
+	}
+	b := &xrayBuilder{snap: snap, hostnames: map[string]string{}, history: history}
+	for _, n := range snap.Nodes {
+		b.hostnames[n.ID] = n.Description.Hostname
+	}
+	b.health = containerHealth(ctx, snap)
+
+	switch {
+	case scope.Node != "":
+		var node *swarm.Node
+		for i, n := range snap.Nodes {
+			if n.ID == scope.Node || n.Description.Hostname == scope.Node {
+				node = &snap.Nodes[i]
+				break
+			}
+		}
+		if node == nil {
+			return nil, fmt.Errorf("no such node: %s", scope.Node)
+		}
+		b.node = node.ID
+		root := &XrayItem{
+			Kind:  XrayNode,
+			ID:    node.ID,
+			Name:  node.Description.Hostname,
+			State: fmt.Sprintf("%s, %s", node.Status.State, node.Spec.Availability),
+		}
+		for _, svc := range b.sortedServices(func(swarm.Service) bool { return true }) {
+			if item := b.service(svc); len(item.Children) > 0 {
+				root.Children = append(root.Children, item)
+			}
+		}
+		return root, nil
+
+	case scope.Stack != "":
+		root := b.stack(scope.Stack)
+		if len(root.Children) == 0 {
+			return nil, fmt.Errorf("stack %s has no services", scope.Stack)
+		}
+		return root, nil
+	}
+
+	root := &XrayItem{Kind: XrayCluster, Name: ctxName}
+	stacks := map[string]bool{}
+	for _, svc := range snap.Services {
+		if stack := svc.Spec.Labels[stackNamespaceLabel]; stack != "" {
+			stacks[stack] = true
+		}
+	}
+	for _, stack := range sortedKeys(stacks) {
+		root.Children = append(root.Children, b.stack(stack))
+	}
+	// Services created outside of any stack hang from the cluster itself
+	for _, svc := range b.sortedServices(func(s swarm.Service) bool { return s.Spec.Labels[stackNamespaceLabel] == "" }) {
+		root.Children = append(root.Children, b.service(svc))
+	}
+	root.State = fmt.Sprintf("%d stacks, %d services", len(stacks), len(snap.Services))
+	return root, nil
+}
+
+func (b *xrayBuilder) sortedServices(keep func(swarm.Service) bool) []swarm.Service {
+	var services []swarm.Service
+	for _, svc := range b.snap.Services {
+		if keep(svc) {
+			services = append(services, svc)
+		}
+	}
+	slices.SortFunc(services, func(a, b swarm.Service) int { return strings.Compare(a.Spec.Name, b.Spec.Name) })
+	return services
+}
+
+func (b *xrayBuilder) stack(name string) *XrayItem {
+	item := &XrayItem{Kind: XrayStack, Name: name, StackName: name}
+	for _, svc := range b.sortedServices(func(s swarm.Service) bool { return s.Spec.Labels[stackNamespaceLabel] == name }) {
+		item.Children = append(item.Children, b.service(svc))
+	}
+	item.State = fmt.Sprintf("%d services", len(item.Children))
+	return item
+}
+
+func (b *xrayBuilder) service(svc swarm.Service) *XrayItem {
+	item := &XrayItem{
+		Kind:        XrayService,
+		ID:          svc.ID,
+		Name:        svc.Spec.Name,
+		ServiceID:   svc.ID,
+		ServiceName: svc.Spec.Name,
+		StackName:   svc.Spec.Labels[stackNamespaceLabel],
+	}
+	var tasks []swarm.Task
+	running, desired := 0, 0
+	for _, t := range b.snap.Tasks {
+		if t.ServiceID != svc.ID || (b.node != "" && t.NodeID != b.node) {
+			continue
+		}
+		current := t.DesiredState != swarm.TaskStateShutdown && t.DesiredState != swarm.TaskStateRemove
+		if current {
+			desired++
+			if t.Status.State == swarm.TaskStateRunning {
+				running++
+			}
+		}
+		if current || b.history {
+			tasks = append(tasks, t)
+		}
+	}
+	// A node only runs part of the replicas, so count its tasks there
+	if r := svc.Spec.Mode.Replicated; r != nil && r.Replicas != nil && b.node == "" {
+		desired = int(*r.Replicas)
+	}
+	item.State = fmt.Sprintf("%d/%d", running, desired)
+
+	slices.SortFunc(tasks, func(x, y swarm.Task) int {
+		if c := cmp.Compare(x.Slot, y.Slot); c != 0 {
+			return c
+		}
+		if c := strings.Compare(b.hostnames[x.NodeID], b.hostnames[y.NodeID]); c != 0 {
+			return c
+		}
+		return y.CreatedAt.Compare(x.CreatedAt)
+	})
+	for _, t := range tasks {
+		item.Children = append(item.Children, b.task(item, t))
+	}
+	return item
+}
+
+func (b *xrayBuilder) task(svc *XrayItem, t swarm.Task) *XrayItem {
+	node := b.hostnames[t.NodeID]
+	if node == "" && len(t.NodeID) > 12 {
+		node = t.NodeID[:12]
+	}
+	// Global services have no slots, their tasks go by node
+	name := fmt.Sprintf("%s.%d", svc.Name, t.Slot)
+	if t.Slot == 0 {
+		name = svc.Name + "." + node
+	}
+	item := &XrayItem{
+		Kind:        XrayTask,
+		ID:          t.ID,
+		Name:        name,
+		State:       string(t.Status.State),
+		Node:        node,
+		Err:         t.Status.Err,
+		ServiceID:   svc.ServiceID,
+		ServiceName: svc.ServiceName,
+		StackName:   svc.StackName,
+		TaskID:      t.ID,
+	}
+	cs := t.Status.ContainerStatus
+	if cs == nil || cs.ContainerID == "" {
+		return item
+	}
+	ctr := &XrayItem{
+		Kind:        XrayContainer,
+		ID:          cs.ContainerID,
+		Name:        shortID(cs.ContainerID),
+		State:       containerState(t.Status.State),
+		Node:        node,
+		Health:      b.health[cs.ContainerID],
+		ServiceID:   svc.ServiceID,
+		ServiceName: svc.ServiceName,
+		StackName:   svc.StackName,
+		TaskID:      t.ID,
+	}
+	if ctr.State == "exited" {
+		ctr.Exited, ctr.ExitCode = true, cs.ExitCode
+	}
+	if spec := t.Spec.ContainerSpec; spec != nil {
+		for _, ref := range spec.Configs {
+			if ref.File == nil {
+				continue
+			}
+			ctr.Children = append(ctr.Children, b.file(ctr, XrayConfig, ref.ConfigID, ref.ConfigName, mountTarget("/", ref.File.Name)))
+		}
+		for _, ref := range spec.Secrets {
+			if ref.File == nil {
+				continue
+			}
+			ctr.Children = append(ctr.Children, b.file(ctr, XraySecret, ref.SecretID, ref.SecretName, mountTarget("/run/secrets", ref.File.Name)))
+		}
+	}
+	item.Children = []*XrayItem{ctr}
+	return item
+}
+
+func (b *xrayBuilder) file(ctr *XrayItem, kind, id, name, target string) *XrayItem {
+	return &XrayItem{
+		Kind:        kind,
+		ID:          id,
+		Name:        name,
+		Target:      target,
+		ServiceID:   ctr.ServiceID,
+		ServiceName: ctr.ServiceName,
+		StackName:   ctr.StackName,
+		TaskID:      ctr.TaskID,
+	}
+}
+
+// mountTarget resolves the target of a config or secret like the engine:
+// relative names are under dir.
+func mountTarget(dir, name string) string {
+	if path.IsAbs(name) {
+		return name
+	}
+	return path.Join(dir, name)
+}
+
+// containerState tells from the state of a task what became of its
+// container.
+func containerState(s swarm.TaskState) string {
+	switch s {
+	case swarm.TaskStateRunning:
+		return "running"
+	case swarm.TaskStateComplete, swarm.TaskStateFailed, swarm.TaskStateShutdown:
+		return "exited"
+	case swarm.TaskStateRejected, swarm.TaskStateOrphaned, swarm.TaskStateRemove:
+		return "removed"
+	}
+	return "created"
+}
+
+func shortID(id string) string {
+	if len(id) > 12 {
+		return id[:12]
+	}
+	return id
+}
+
+// containerHealth asks the engine the client is connected to about the
+// health of the running containers it holds; the others are out of reach.
+func containerHealth(ctx context.Context, snap *SwarmSnapshot) map[string]string {
+	health := map[string]string{}
+	c, err := clientFor(ctx)
+	if err != nil {
+		return health
+	}
+	defer closeCli(c)
+	info, err := c.Info(ctx)
+	if err != nil {
+		l().Warnf("xray: reading the local node: %v", err)
+		return health
+	}
+	for _, t := range snap.Tasks {
+		cs := t.Status.ContainerStatus
+		if t.NodeID != info.Swarm.NodeID || t.Status.State != swarm.TaskStateRunning || cs == nil || cs.ContainerID == "" {
+			continue
+		}
+		ctr, err := c.ContainerInspect(ctx, cs.ContainerID)
+		if err != nil || ctr.State == nil || ctr.State.Health == nil {
+			continue
+		}
+		health[cs.ContainerID] = ctr.State.Health.Status
+	}
+	return health
+}
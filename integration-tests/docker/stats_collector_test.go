@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+//go:build integration
+
+package docker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"swarmcli/docker"
+
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// TestStatsCollectorCoversAllNodes deploys the stats collector on the test
+// swarm and checks that every ready node reports its containers.
+func TestStatsCollectorCoversAllNodes(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 4*time.Minute)
+	defer cancel()
+
+	snap, err := docker.RefreshSnapshot()
+	if err != nil {
+		t.Fatalf("failed to refresh snapshot: %v", err)
+	}
+	ready := map[string]bool{}
+	for _, n := range snap.Nodes {
+		if n.Status.State == swarm.NodeStateReady {
+			ready[n.ID] = true
+		}
+	}
+	if len(ready) < 2 {
+		t.Skipf("need a multi-node swarm, found %d ready nodes", len(ready))
+	}
+
+	if err := docker.StartStatsCollector(ctx); err != nil {
+		t.Fatalf("failed to start stats collector: %v", err)
+	}
+	t.Cleanup(func() {
+		if err := docker.StopStatsCollector(context.Background()); err != nil {
+			t.Errorf("failed to stop stats collector: %v", err)
+		}
+	})
+
+	// Starting twice must not create a second service
+	if err := docker.StartStatsCollector(ctx); err != nil {
+		t.Fatalf("second start failed: %v", err)
+	}
+
+	var stats *docker.SwarmStats
+	for {
+		stats, err = docker.LoadSwarmStats(ctx)
+		if err != nil {
+			t.Fatalf("failed to load stats: %v", err)
+		}
+		if len(stats.ByNode()) >= len(ready) {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			t.Fatalf("only %d of %d nodes reported stats", len(stats.ByNode()), len(ready))
+		case <-time.After(2 * time.Second):
+		}
+	}
+
+	byNode := stats.ByNode()
+	for id := range ready {
+		u, ok := byNode[id]
+		if !ok {
+			t.Fatalf("node %s did not report stats", id)
+		}
+		if u.MemUsed <= 0 {
+			t.Fatalf("node %s reported no memory usage", id)
+		}
+	}
+
+	// The collector is a global service, so it sees one of its own tasks per
+	// node
+	own := stats.ByService()[docker.StatsCollectorName]
+	if own.Containers != len(ready) {
+		t.Fatalf("expected %d %s containers, got %d", len(ready), docker.StatsCollectorName, own.Containers)
+	}
+	t.Logf("✅ %d nodes reported %d containers (CPU %.1f%%, %d bytes)",
+		len(byNode), len(stats.Containers), stats.Total().CPU, stats.Total().MemUsed)
+}
+
+// TestStatsCollectorNotDeployed checks that stats are reported missing
+// rather than empty without the collector.
+func TestStatsCollectorNotDeployed(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := docker.StopStatsCollector(ctx); err != nil {
+		t.Fatalf("failed to stop stats collector: %v", err)
+	}
+	if _, err := docker.LoadSwarmStats(ctx); !errors.Is(err, docker.ErrNoStatsCollector) {
+		t.Fatalf("expected ErrNoStatsCollector, got %v", err)
+	}
+}
@@ -5,6 +5,7 @@ package main
 
 import (
 	"swarmcli/app"
+	"swarmcli/core/clipboard"
 	swarmlog "swarmcli/utils/log"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -25,7 +26,7 @@ func init() {
 }
 
 func main() {
-	p := tea.NewProgram(app.InitialModel(), tea.WithAltScreen())
+	p := tea.NewProgram(app.InitialModel(), tea.WithAltScreen(), tea.WithOutput(clipboard.Output))
 
 	if _, err := p.Run(); err != nil {
 		swarmlog.L().Fatal(err)
@@ -4,8 +4,9 @@
 package registry
 
 import (
-	"strings"
+	"sort"
 	"swarmcli/args"
+	"swarmcli/core/primitives/fuzzy"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
@@ -38,13 +39,16 @@ func All() []Command {
 	return cmds
 }
 
-// Suggest returns all command names that start with a given prefix
-func Suggest(prefix string) []string {
-	var out []string
+// Suggest returns the command names that fuzzy-match the given input, best
+// match first. An empty input returns every command in alphabetical order.
+func Suggest(input string) []string {
+	names := make([]string, 0, len(apiRegistry))
 	for name := range apiRegistry {
-		if prefix == "" || strings.HasPrefix(name, prefix) {
-			out = append(out, name)
-		}
+		names = append(names, name)
 	}
-	return out
+	sort.Strings(names)
+	if input == "" {
+		return names
+	}
+	return fuzzy.Rank(input, names)
 }
@@ -0,0 +1,425 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package columns renders list rows from a user-configurable column layout:
+// columns can be hidden, reordered, pinned to a width, and extended with
+// label-derived columns. Layouts are persisted per view in the config dir.
+package columns
+
+import (
+	"fmt"
+	"strings"
+	"swarmcli/core/primitives/fuzzy"
+	"swarmcli/ui"
+	"swarmcli/utils/config"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+const sepWidth = 2
+
+// Column describes a built-in column a view knows how to render.
+type Column[T any] struct {
+	// Name is the stable identifier used in the config file, e.g. "image".
+	Name string
+	// Title is the header label.
+	Title string
+	// Min is the smallest automatic width.
+	Min int
+	// Fit grows the column to its widest value when there is room.
+	Fit bool
+	// Hidden hides the column in the default layout.
+	Hidden bool
+	// Value returns the cell text; exports always use it when set.
+	Value func(T) string
+	// Format, when set, renders the cell text for a given width and takes
+	// precedence over Value on screen (for cells that scroll or abbreviate
+	// themselves).
+	Format func(item T, width int) string
+	// Color returns an optional foreground colour for the cell.
+	Color func(T) lipgloss.Color
+	// Search marks a column the free-text filter terms are matched against;
+	// Row highlights the runes they matched.
+	Search bool
+}
+
+// Layout resolves the stored layout of a view against its built-in columns.
+type Layout[T any] struct {
+	View    string
+	Columns []Column[T]
+	// Labels returns the labels used by label-derived columns.
+	Labels func(T) map[string]string
+	// Foreground and SelectedBg style plain and selected rows.
+	Foreground lipgloss.Color
+	SelectedBg lipgloss.Color
+	// Terms returns the free-text terms of the list's filter, usually the
+	// list's FreeTerms, whose matches are highlighted in Search columns.
+	Terms func() []string
+
+	specs   []config.Column
+	visible []Column[T]
+	widths  []int
+	width   int
+}
+
+// NewLayout returns a layout using the view's stored columns, falling back to
+// the defaults when none are stored or the config cannot be read.
+func NewLayout[T any](view string, cols []Column[T], labels func(T) map[string]string) (*Layout[T], error) {
+	l := &Layout[T]{
+		View:       view,
+		Columns:    cols,
+		Labels:     labels,
+		Foreground: lipgloss.Color("15"),
+		SelectedBg: lipgloss.Color("25"),
+	}
+	stored, err := config.ViewColumns(view)
+	l.SetSpecs(stored)
+	return l, err
+}
+
+// Defaults returns the default layout of the view.
+func (l *Layout[T]) Defaults() []config.Column {
+	specs := make([]config.Column, len(l.Columns))
+	for i, c := range l.Columns {
+		specs[i] = config.Column{Name: c.Name, Hidden: c.Hidden}
+	}
+	return specs
+}
+
+// Specs returns the full resolved layout, hidden columns included.
+func (l *Layout[T]) Specs() []config.Column {
+	return append([]config.Column(nil), l.specs...)
+}
+
+// SetSpecs applies a layout. Unknown column names are dropped and built-in
+// columns missing from specs are appended as hidden, so they remain
+// selectable. A nil layout restores the defaults.
+func (l *Layout[T]) SetSpecs(specs []config.Column) {
+	if len(specs) == 0 {
+		specs = l.Defaults()
+	}
+
+	known := make(map[string]int, len(l.Columns))
+	for i, c := range l.Columns {
+		known[c.Name] = i
+	}
+
+	seen := make(map[string]bool)
+	resolved := make([]config.Column, 0, len(specs)+len(l.Columns))
+	for _, s := range specs {
+		s.Name = strings.ToLower(strings.TrimSpace(s.Name))
+		if s.Label == "" {
+			if _, ok := known[s.Name]; !ok || seen[s.Name] {
+				continue
+			}
+			seen[s.Name] = true
+		} else if s.Name == "" {
+			s.Name = strings.ToLower(s.Label)
+		}
+		resolved = append(resolved, s)
+	}
+	for _, c := range l.Columns {
+		if !seen[c.Name] {
+			resolved = append(resolved, config.Column{Name: c.Name, Hidden: true})
+		}
+	}
+
+	l.specs = resolved
+	l.visible = nil
+	for _, s := range resolved {
+		if s.Hidden {
+			continue
+		}
+		if s.Label != "" {
+			l.visible = append(l.visible, l.labelColumn(s))
+			continue
+		}
+		c := l.Columns[known[s.Name]]
+		l.visible = append(l.visible, c)
+	}
+	l.widths = nil
+}
+
+// Apply sets a new layout (or restores the defaults when reset is true) and
+// persists it for the view. Default layouts are removed from the config file.
+func (l *Layout[T]) Apply(reset bool, specs []config.Column) error {
+	if reset {
+		specs = nil
+	}
+	l.SetSpecs(specs)
+	var stored []config.Column
+	if l.IsCustom() {
+		stored = l.Specs()
+	}
+	return config.SaveViewColumns(l.View, stored)
+}
+
+// IsCustom reports whether the layout differs from the view's defaults.
+func (l *Layout[T]) IsCustom() bool {
+	defaults := l.Defaults()
+	if len(defaults) != len(l.specs) {
+		return true
+	}
+	for i := range defaults {
+		if defaults[i] != l.specs[i] {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *Layout[T]) labelColumn(s config.Column) Column[T] {
+	key := s.Label
+	return Column[T]{
+		Name:  s.Name,
+		Title: strings.ToUpper(s.Name),
+		Min:   6,
+		Fit:   true,
+		Value: func(item T) string {
+			if l.Labels == nil {
+				return ""
+			}
+			if v, ok := l.Labels(item)[key]; ok {
+				return v
+			}
+			return "-"
+		},
+	}
+}
+
+// Visible returns the columns to render, in order.
+func (l *Layout[T]) Visible() []Column[T] {
+	return l.visible
+}
+
+// spec returns the layout entry of the i-th visible column.
+func (l *Layout[T]) spec(i int) config.Column {
+	n := -1
+	for _, s := range l.specs {
+		if s.Hidden {
+			continue
+		}
+		n++
+		if n == i {
+			return s
+		}
+	}
+	return config.Column{}
+}
+
+// Compute sizes the visible columns for the given total width. Pinned
+// columns keep their width, fit columns grow to their widest value, and the
+// remaining space is spread evenly across the automatic columns.
+func (l *Layout[T]) Compute(total int, items []T) []int {
+	if total <= 0 {
+		total = 80
+	}
+	n := len(l.visible)
+	widths := make([]int, n)
+	if n == 0 {
+		l.widths, l.width = widths, total
+		return widths
+	}
+
+	avail := total - 1 - sepWidth*(n-1)
+	flex := make([]int, 0, n)
+	sum := 0
+	for i, c := range l.visible {
+		if w := l.spec(i).Width; w > 0 {
+			widths[i] = w
+			sum += w
+			continue
+		}
+		flex = append(flex, i)
+		w := max(c.Min, lipgloss.Width(c.Title)+2)
+		if c.Fit {
+			for _, it := range items {
+				w = max(w, lipgloss.Width(cellText(c, it, 0)))
+			}
+		}
+		widths[i] = w
+		sum += w
+	}
+
+	if sum < avail && len(flex) > 0 {
+		per, rem := (avail-sum)/len(flex), (avail-sum)%len(flex)
+		for _, i := range flex {
+			widths[i] += per
+			if rem > 0 {
+				widths[i]++
+				rem--
+			}
+		}
+	} else if sum > avail {
+		widths = shrink(widths, avail, flex)
+	}
+
+	l.widths, l.width = widths, total
+	return widths
+}
+
+// shrink narrows flexible columns (largest first), then all columns, until
+// the widths fit in avail.
+func shrink(widths []int, avail int, flex []int) []int {
+	sum := 0
+	for _, w := range widths {
+		sum += w
+	}
+	for _, pool := range [][]int{flex, nil} {
+		if pool == nil {
+			pool = make([]int, len(widths))
+			for i := range pool {
+				pool[i] = i
+			}
+		}
+		for sum > avail {
+			largest := -1
+			for _, i := range pool {
+				if widths[i] > 1 && (largest == -1 || widths[i] > widths[largest]) {
+					largest = i
+				}
+			}
+			if largest == -1 {
+				break
+			}
+			widths[largest]--
+			sum--
+		}
+	}
+	return widths
+}
+
+// Header renders the header labels aligned with the rows. The optional
+// suffix adds decorations, such as sort arrows, to a column title.
+func (l *Layout[T]) Header(suffix func(name string) string) string {
+	parts := make([]string, len(l.visible))
+	for i, c := range l.visible {
+		title := c.Title
+		if suffix != nil {
+			if s := suffix(c.Name); s != "" {
+				title += " " + s
+			}
+		}
+		parts[i] = pad(truncate(title, l.colWidth(i)), l.colWidth(i))
+	}
+	return " " + strings.Join(parts, strings.Repeat(" ", sepWidth))
+}
+
+// Row renders an item using the widths of the last Compute call.
+func (l *Layout[T]) Row(item T, selected bool) string {
+	if l.widths == nil || len(l.widths) != len(l.visible) {
+		l.Compute(l.width, []T{item})
+	}
+
+	base := lipgloss.NewStyle().Foreground(l.Foreground)
+	if selected {
+		base = lipgloss.NewStyle().Foreground(lipgloss.Color("230")).Background(l.SelectedBg).Bold(true)
+	}
+
+	var terms []string
+	if l.Terms != nil {
+		terms = l.Terms()
+	}
+
+	var b strings.Builder
+	b.WriteString(base.Render(" "))
+	for i, c := range l.visible {
+		w := l.colWidth(i)
+		full := cellText(c, item, w)
+		shown := truncate(full, w)
+		text := pad(shown, w)
+		style := base
+		if c.Color != nil {
+			if fg := c.Color(item); fg != "" {
+				style = style.Foreground(fg)
+			}
+		}
+		if i < len(l.visible)-1 {
+			text += strings.Repeat(" ", sepWidth)
+		}
+		if c.Search && len(terms) > 0 {
+			b.WriteString(ui.HighlightRunes(text, matchPositions(terms, full, shown), style, ui.MatchHighlightStyle))
+			continue
+		}
+		b.WriteString(style.Render(text))
+	}
+	return b.String()
+}
+
+// matchPositions returns the runes of full matched by the terms that are
+// still visible in shown, its truncated form.
+func matchPositions(terms []string, full, shown string) []int {
+	visible := len([]rune(shown))
+	if shown != full {
+		visible-- // the ellipsis
+	}
+	var positions []int
+	for _, t := range terms {
+		if r, ok := fuzzy.Match(t, full); ok {
+			for _, p := range r.Positions {
+				if p < visible {
+					positions = append(positions, p)
+				}
+			}
+		}
+	}
+	return positions
+}
+
+func (l *Layout[T]) colWidth(i int) int {
+	if i < len(l.widths) {
+		return l.widths[i]
+	}
+	return max(l.visible[i].Min, 1)
+}
+
+func cellText[T any](c Column[T], item T, width int) string {
+	if c.Format != nil {
+		return c.Format(item, width)
+	}
+	if c.Value != nil {
+		return c.Value(item)
+	}
+	return ""
+}
+
+func pad(s string, width int) string {
+	if w := lipgloss.Width(s); w < width {
+		return s + strings.Repeat(" ", width-w)
+	}
+	return s
+}
+
+// truncate shortens s to maxWidth cells, ending it with an ellipsis.
+func truncate(s string, maxWidth int) string {
+	if maxWidth <= 0 {
+		return ""
+	}
+	if lipgloss.Width(s) <= maxWidth {
+		return s
+	}
+	if maxWidth == 1 {
+		return "…"
+	}
+	var b strings.Builder
+	for _, r := range s {
+		if lipgloss.Width(b.String()+string(r)) > maxWidth-1 {
+			break
+		}
+		b.WriteRune(r)
+	}
+	return b.String() + "…"
+}
+
+// Describe returns a short human-readable summary of a layout entry.
+func Describe(s config.Column) string {
+	kind := ""
+	if s.Label != "" {
+		kind = fmt.Sprintf(" (label %s)", s.Label)
+	}
+	width := "auto"
+	if s.Width > 0 {
+		width = fmt.Sprintf("%d", s.Width)
+	}
+	return fmt.Sprintf("%s%s · %s", strings.ToUpper(s.Name), kind, width)
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package columns
+
+import (
+	"math"
+	"swarmcli/core/export"
+)
+
+// Table exports items with the given columns. Values are taken untruncated.
+func Table[T any](cols []Column[T], items []T) export.Table {
+	t := export.Table{Columns: make([]string, len(cols)), Rows: make([][]string, len(items))}
+	for i, c := range cols {
+		t.Columns[i] = c.Name
+	}
+	for r, it := range items {
+		row := make([]string, len(cols))
+		for i, c := range cols {
+			row[i] = exportText(c, it)
+		}
+		t.Rows[r] = row
+	}
+	return t
+}
+
+// Table exports items with every column of the layout in layout order,
+// hidden columns included.
+func (l *Layout[T]) Table(items []T) export.Table {
+	known := make(map[string]Column[T], len(l.Columns))
+	for _, c := range l.Columns {
+		known[c.Name] = c
+	}
+	cols := make([]Column[T], 0, len(l.specs))
+	for _, s := range l.specs {
+		if s.Label != "" {
+			cols = append(cols, l.labelColumn(s))
+		} else if c, ok := known[s.Name]; ok {
+			cols = append(cols, c)
+		}
+	}
+	return Table(cols, items)
+}
+
+func exportText[T any](c Column[T], item T) string {
+	if c.Value != nil {
+		return c.Value(item)
+	}
+	if c.Format != nil {
+		return c.Format(item, math.MaxInt32)
+	}
+	return ""
+}
@@ -3,16 +3,30 @@
 
 package filterlist
 
+import "sort"
+
 func (f *FilterableList[T]) ApplyFilter() {
 	if f.Query == "" {
 		f.Filtered = f.Items
 	} else {
-		var result []T
+		type ranked struct {
+			item  T
+			score int
+		}
+		q := CompileQuery(f.Query, f.Fields)
+		var matches []ranked
 		for _, item := range f.Items {
-			if f.Match(item, f.Query) {
-				result = append(result, item)
+			if score, ok := q.Match(item, f.Score); ok {
+				matches = append(matches, ranked{item, score})
 			}
 		}
+		sort.SliceStable(matches, func(i, j int) bool {
+			return matches[i].score > matches[j].score
+		})
+		result := make([]T, len(matches))
+		for i, m := range matches {
+			result[i] = m.item
+		}
 		f.Filtered = result
 	}
 
@@ -26,3 +40,40 @@ func (f *FilterableList[T]) ApplyFilter() {
 
 	f.ensureCursorVisible()
 }
+
+// Ranked reports whether Filtered is currently ordered by match score rather
+// than by the view's sort order. Views skip their own sorting while this is
+// true so the best match stays on top.
+func (f *FilterableList[T]) Ranked() bool {
+	return f.Query != ""
+}
+
+// PrepareSort readies the list for a view that sorts Filtered in place. While
+// the list is ranked, it points Filtered at Items so the view's sort orders
+// the underlying items (ties and the unfiltered list then follow it), and the
+// returned func re-ranks them and restores the cursor. Otherwise the returned
+// func does nothing. Views call it as
+//
+//	defer m.List.PrepareSort()()
+func (f *FilterableList[T]) PrepareSort() func() {
+	if !f.Ranked() {
+		return func() {}
+	}
+	cursor := f.Cursor
+	f.Filtered = f.Items
+	return func() {
+		f.ApplyFilter()
+		if cursor < len(f.Filtered) {
+			f.Cursor = cursor
+		}
+	}
+}
+
+// FreeTerms returns the free-text terms of the current query, for views that
+// highlight matched runes in their default columns.
+func (f *FilterableList[T]) FreeTerms() []string {
+	if f.Query == "" {
+		return nil
+	}
+	return CompileQuery(f.Query, f.Fields).FreeTerms()
+}
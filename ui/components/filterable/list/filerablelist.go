@@ -22,8 +22,15 @@ type FilterableList[T any] struct {
 	// Function to render a single item (pass in computed colWidth)
 	RenderItem func(item T, selected bool, colWidth int) string
 
-	// Match function for filtering
-	Match func(item T, query string) bool
+	// Score reports whether item matches a free-text filter term and how well.
+	// Matching items are ranked by descending score; ties keep their order
+	// in Items. Views typically delegate to fuzzy.Score over the item's
+	// searchable fields.
+	Score func(item T, query string) (int, bool)
+
+	// Fields registers the attributes the filter query language can address
+	// (e.g. "stack:web", "!label:env=dev"). Free-text terms go to Score.
+	Fields []Field[T]
 
 	// SkipOffsetAdjustment disables automatic viewport scrolling in VisibleContent.
 	// When false (default): VisibleContent automatically adjusts YOffset to keep the cursor visible.
@@ -31,7 +38,12 @@ type FilterableList[T any] struct {
 	// where the cursor position doesn't reflect the actual line being viewed.
 	SkipOffsetAdjustment bool
 
+	// Key identifies items across refreshes; setting it enables marking rows
+	// for bulk actions (space marks, ctrl+a marks all filtered).
+	Key func(item T) string
+
 	colWidth int
+	marked   map[string]bool
 }
 
 type ModeType int
@@ -86,6 +98,9 @@ func (f *FilterableList[T]) VisibleContent(lines int) string {
 	for i := range f.Filtered {
 		if f.RenderItem != nil {
 			renderedItems[i] = f.RenderItem(f.Filtered[i], i == f.Cursor, f.colWidth)
+			if f.IsMarked(f.Filtered[i]) {
+				renderedItems[i] = markRow(renderedItems[i])
+			}
 		} else {
 			renderedItems[i] = fmt.Sprintf("%v", f.Filtered[i])
 		}
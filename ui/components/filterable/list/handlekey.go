@@ -10,12 +10,14 @@ func (f *FilterableList[T]) HandleKey(msg tea.KeyMsg) {
 	// Searching mode
 	if f.Mode == ModeSearching {
 		switch msg.Type {
-		case tea.KeyRunes:
+		case tea.KeyRunes, tea.KeySpace:
+			// Spaces separate query terms (see Query).
 			f.Query += string(msg.Runes)
 			f.ApplyFilter()
 		case tea.KeyBackspace:
 			if len(f.Query) > 0 {
-				f.Query = f.Query[:len(f.Query)-1]
+				r := []rune(f.Query)
+				f.Query = string(r[:len(r)-1])
 			} else if len(f.Query) == 0 {
 				f.Mode = ModeNormal
 				f.Query = ""
@@ -33,6 +35,11 @@ func (f *FilterableList[T]) HandleKey(msg tea.KeyMsg) {
 			f.Cursor = 0
 			f.Viewport.GotoTop()
 		}
+		// Typed characters belong to the query; only navigation keys
+		// (arrows, paging) fall through to the normal-mode handling.
+		if msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace {
+			return
+		}
 	}
 
 	// Normal mode
@@ -63,6 +70,10 @@ func (f *FilterableList[T]) HandleKey(msg tea.KeyMsg) {
 			f.Cursor = len(f.Filtered) - 1
 		}
 		f.ensureCursorVisible()
+	case " ":
+		f.ToggleMark()
+	case "ctrl+a":
+		f.ToggleMarkAll()
 	case "/":
 		f.Mode = ModeSearching
 		f.Query = ""
@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package filterlist
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// MarkStyle renders the glyph that replaces the leading padding of marked rows.
+var MarkStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("214")).
+	Bold(true)
+
+// ToggleMark marks or unmarks the item under the cursor and moves the cursor
+// down, so holding space marks a run of rows. It is a no-op unless Key is set.
+func (f *FilterableList[T]) ToggleMark() {
+	if f.Key == nil || f.Cursor >= len(f.Filtered) {
+		return
+	}
+	if f.marked == nil {
+		f.marked = make(map[string]bool)
+	}
+	k := f.Key(f.Filtered[f.Cursor])
+	if f.marked[k] {
+		delete(f.marked, k)
+	} else {
+		f.marked[k] = true
+	}
+	if f.Cursor < len(f.Filtered)-1 {
+		f.Cursor++
+		f.ensureCursorVisible()
+	}
+}
+
+// ToggleMarkAll marks every filtered item, or clears the marks if they are
+// all already marked.
+func (f *FilterableList[T]) ToggleMarkAll() {
+	if f.Key == nil {
+		return
+	}
+	all := len(f.Filtered) > 0
+	for _, it := range f.Filtered {
+		if !f.marked[f.Key(it)] {
+			all = false
+			break
+		}
+	}
+	if all {
+		f.ClearMarks()
+		return
+	}
+	if f.marked == nil {
+		f.marked = make(map[string]bool)
+	}
+	for _, it := range f.Filtered {
+		f.marked[f.Key(it)] = true
+	}
+}
+
+// ClearMarks unmarks every item.
+func (f *FilterableList[T]) ClearMarks() {
+	f.marked = nil
+}
+
+// IsMarked reports whether item is marked.
+func (f *FilterableList[T]) IsMarked(item T) bool {
+	return f.Key != nil && f.marked[f.Key(item)]
+}
+
+// Marked returns the marked items that are still present in Items, in Items
+// order. Marks survive filtering and data refreshes.
+func (f *FilterableList[T]) Marked() []T {
+	if len(f.marked) == 0 {
+		return nil
+	}
+	var out []T
+	for _, it := range f.Items {
+		if f.marked[f.Key(it)] {
+			out = append(out, it)
+		}
+	}
+	return out
+}
+
+// MarkedCount returns len(Marked()).
+func (f *FilterableList[T]) MarkedCount() int {
+	return len(f.Marked())
+}
+
+// markRow swaps the first visible cell of a rendered row for a check mark while
+// keeping the row's own styling for the rest of the line. Rows in every list
+// view start with a padding space, so alignment is unaffected.
+func markRow(row string) string {
+	line, rest, multi := strings.Cut(row, "\n")
+
+	// Skip leading ANSI escape sequences to find the first visible rune.
+	i := 0
+	for i < len(line) && line[i] == '\x1b' {
+		j := strings.IndexAny(line[i:], "mK")
+		if j < 0 {
+			break
+		}
+		i += j + 1
+	}
+	if i >= len(line) {
+		return row
+	}
+	prefix := line[:i]
+	_, size := utf8.DecodeRuneInString(line[i:])
+	marked := prefix + MarkStyle.Render("✓") + prefix + line[i+size:]
+	if multi {
+		return marked + "\n" + rest
+	}
+	return marked
+}
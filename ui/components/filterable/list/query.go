@@ -0,0 +1,240 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package filterlist
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"swarmcli/core/primitives/fuzzy"
+)
+
+// Field exposes one attribute of T to the filter query language. A query
+// term "name:value" is checked against every string Values returns.
+type Field[T any] struct {
+	Name   string
+	Values func(item T) []string
+	// Exact makes plain values compare against the whole field value
+	// instead of as a substring (used for labels, where "env=prod" must not
+	// match "env=production").
+	Exact bool
+}
+
+// LabelValues renders a label map for a Field with Exact set, so that both
+// "label:env" (key present) and "label:env=prod" (key and value) match.
+func LabelValues(labels map[string]string) []string {
+	out := make([]string, 0, len(labels)*2)
+	for k, v := range labels {
+		out = append(out, k, k+"="+v)
+	}
+	sort.Strings(out)
+	return out
+}
+
+type termKind int
+
+const (
+	termFuzzy termKind = iota
+	termSubstring
+	termExact
+	termGlob
+	termRegex
+)
+
+type term struct {
+	negate bool
+	field  string // "" for free-text terms
+	kind   termKind
+	text   string
+	re     *regexp.Regexp
+}
+
+// Query is a compiled filter. Terms are separated by whitespace (an optional
+// literal AND is ignored) and must all match:
+//
+//	web             fuzzy match on the view's default columns
+//	stack:web       field contains "web"
+//	image:~ngx      field fuzzy-matches "ngx"
+//	node:worker-*   field matches a glob (* and ?)
+//	name:/^api-\d/  field matches a regular expression
+//	/^api-\d/       any field matches a regular expression
+//	!name:test      negates any of the above
+//
+// Terms naming a field the view does not register are treated as free text.
+type Query[T any] struct {
+	fields map[string]Field[T]
+	terms  []term
+}
+
+// CompileQuery parses query against the given fields.
+func CompileQuery[T any](query string, fields []Field[T]) Query[T] {
+	q := Query[T]{fields: make(map[string]Field[T], len(fields))}
+	for _, f := range fields {
+		q.fields[strings.ToLower(f.Name)] = f
+	}
+	for _, tok := range tokenize(query) {
+		if tok == "AND" || tok == "&&" {
+			continue
+		}
+		if t, ok := q.parseTerm(tok); ok {
+			q.terms = append(q.terms, t)
+		}
+	}
+	return q
+}
+
+func (q Query[T]) parseTerm(tok string) (term, bool) {
+	var t term
+	if strings.HasPrefix(tok, "!") && len(tok) > 1 {
+		t.negate = true
+		tok = tok[1:]
+	}
+
+	if key, val, ok := strings.Cut(tok, ":"); ok && !strings.HasPrefix(tok, "/") {
+		if f, known := q.fields[strings.ToLower(key)]; known {
+			t.field = strings.ToLower(key)
+			tok = val
+			if f.Exact {
+				t.kind = termExact
+			} else {
+				t.kind = termSubstring
+			}
+		}
+	}
+	tok = strings.Trim(tok, `"`)
+	if tok == "" {
+		return t, false
+	}
+	if t.field == "" && t.negate {
+		// Negated fuzzy matches exclude far too much; use a plain substring.
+		t.kind = termSubstring
+	}
+
+	switch {
+	case len(tok) >= 2 && strings.HasPrefix(tok, "/") && strings.HasSuffix(tok, "/"):
+		if re, err := regexp.Compile("(?i)" + tok[1:len(tok)-1]); err == nil {
+			t.kind, t.re = termRegex, re
+			return t, true
+		}
+		// An invalid expression falls back to a literal match on its body.
+		tok = tok[1 : len(tok)-1]
+		t.kind = termSubstring
+	case t.field != "" && strings.HasPrefix(tok, "~"):
+		t.kind = termFuzzy
+		tok = tok[1:]
+	case t.field != "" && strings.ContainsAny(tok, "*?"):
+		pattern := regexp.QuoteMeta(strings.ToLower(tok))
+		pattern = strings.ReplaceAll(pattern, `\*`, ".*")
+		pattern = strings.ReplaceAll(pattern, `\?`, ".")
+		t.kind, t.re = termGlob, regexp.MustCompile("^"+pattern+"$")
+	}
+	t.text = strings.ToLower(tok)
+	return t, true
+}
+
+// Empty reports whether the query has no terms.
+func (q Query[T]) Empty() bool { return len(q.terms) == 0 }
+
+// FreeTerms returns the free-text terms of the query, i.e. what the view
+// should highlight in its default columns.
+func (q Query[T]) FreeTerms() []string {
+	var out []string
+	for _, t := range q.terms {
+		if t.field == "" && t.kind == termFuzzy && !t.negate {
+			out = append(out, t.text)
+		}
+	}
+	return out
+}
+
+// Match reports whether item satisfies every term. The returned score sums
+// the fuzzy scores of the free-text terms, which are evaluated with score
+// (or against every registered field when score is nil).
+func (q Query[T]) Match(item T, score func(item T, text string) (int, bool)) (int, bool) {
+	total := 0
+	for _, t := range q.terms {
+		s, ok := q.matchTerm(item, t, score)
+		if ok == t.negate {
+			return 0, false
+		}
+		if !t.negate {
+			total += s
+		}
+	}
+	return total, true
+}
+
+func (q Query[T]) matchTerm(item T, t term, score func(item T, text string) (int, bool)) (int, bool) {
+	if t.field == "" && t.kind == termFuzzy && score != nil {
+		return score(item, t.text)
+	}
+
+	var values []string
+	if t.field != "" {
+		values = q.fields[t.field].Values(item)
+	} else {
+		for _, f := range q.fields {
+			values = append(values, f.Values(item)...)
+		}
+	}
+
+	best, matched := 0, false
+	for _, v := range values {
+		lv := strings.ToLower(v)
+		switch t.kind {
+		case termFuzzy:
+			if r, ok := fuzzy.Match(t.text, v); ok && (!matched || r.Score > best) {
+				best, matched = r.Score, true
+			}
+		case termSubstring:
+			matched = matched || strings.Contains(lv, t.text)
+		case termExact:
+			matched = matched || lv == t.text
+		case termGlob:
+			matched = matched || t.re.MatchString(lv)
+		case termRegex:
+			matched = matched || t.re.MatchString(v)
+		}
+	}
+	return best, matched
+}
+
+// tokenize splits a query on whitespace, keeping double-quoted strings and
+// /regex/ bodies (which may contain spaces) together.
+func tokenize(s string) []string {
+	var (
+		tokens []string
+		cur    strings.Builder
+		quote  rune
+	)
+	flush := func() {
+		if cur.Len() > 0 {
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+		}
+	}
+	prev := rune(0)
+	for _, r := range s {
+		switch {
+		case quote != 0:
+			cur.WriteRune(r)
+			if r == quote && prev != '\\' {
+				quote = 0
+			}
+		case r == '"':
+			cur.WriteRune(r)
+			quote = r
+		case r == '/' && (cur.Len() == 0 || prev == ':' || (cur.Len() == 1 && prev == '!')):
+			cur.WriteRune(r)
+			quote = r
+		case r == ' ' || r == '\t':
+			flush()
+		default:
+			cur.WriteRune(r)
+		}
+		prev = r
+	}
+	flush()
+	return tokens
+}
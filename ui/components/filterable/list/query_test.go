@@ -0,0 +1,174 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package filterlist
+
+import (
+	"slices"
+	"swarmcli/core/primitives/fuzzy"
+	"testing"
+)
+
+type testService struct {
+	name   string
+	stack  string
+	image  string
+	labels map[string]string
+}
+
+var testFields = []Field[testService]{
+	{Name: "name", Values: func(s testService) []string { return []string{s.name} }},
+	{Name: "stack", Values: func(s testService) []string { return []string{s.stack} }},
+	{Name: "image", Values: func(s testService) []string { return []string{s.image} }},
+	{Name: "label", Values: func(s testService) []string { return LabelValues(s.labels) }, Exact: true},
+}
+
+var testServices = []testService{
+	{name: "web_api", stack: "web", image: "nginx:1.27", labels: map[string]string{"env": "prod"}},
+	{name: "web_worker", stack: "web", image: "worker:2", labels: map[string]string{"env": "production"}},
+	{name: "db_primary", stack: "data", image: "postgres:16", labels: map[string]string{"env": "prod", "tier": "db"}},
+	{name: "api-test", stack: "qa", image: "nginx:1.25"},
+}
+
+func testScore(s testService, text string) (int, bool) {
+	return fuzzy.Score(text, s.name)
+}
+
+// matching returns the names of the services query matches, in input order.
+func matching(query string) []string {
+	q := CompileQuery(query, testFields)
+	var out []string
+	for _, s := range testServices {
+		if _, ok := q.Match(s, testScore); ok {
+			out = append(out, s.name)
+		}
+	}
+	return out
+}
+
+func TestCompileQuery(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{"empty", "", []string{"web_api", "web_worker", "db_primary", "api-test"}},
+		{"fuzzy free text", "wbap", []string{"web_api"}},
+		{"field substring", "stack:we", []string{"web_api", "web_worker"}},
+		{"field name is case-insensitive", "STACK:web", []string{"web_api", "web_worker"}},
+		{"field value is case-insensitive", "stack:WEB", []string{"web_api", "web_worker"}},
+		{"field fuzzy", "image:~ngx", []string{"web_api", "api-test"}},
+		{"field glob", "image:nginx:1.2?", []string{"web_api", "api-test"}},
+		{"field glob is anchored", "image:nginx*", []string{"web_api", "api-test"}},
+		{"field regex", `name:/^web_\w+$/`, []string{"web_api", "web_worker"}},
+		{"regex on any field", "/postgres/", []string{"db_primary"}},
+		{"label key", "label:tier", []string{"db_primary"}},
+		{"label key and value is exact", "label:env=prod", []string{"web_api", "db_primary"}},
+		{"negated field", "!stack:web", []string{"db_primary", "api-test"}},
+		{"negated free text is a substring", "!api", []string{"web_worker", "db_primary"}},
+		{"terms are ANDed", "stack:web image:nginx", []string{"web_api"}},
+		{"literal AND is ignored", "stack:web AND image:nginx", []string{"web_api"}},
+		{"&& is ignored", "stack:web && image:nginx", []string{"web_api"}},
+		{"quoted value", `image:"nginx:1.25"`, []string{"api-test"}},
+		{"regex with spaces", "/api|db primary/", []string{"web_api", "api-test"}},
+		{"unknown field is free text", "host:web", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matching(tt.query); !slices.Equal(got, tt.want) {
+				t.Errorf("query %q matched %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileQueryMalformed(t *testing.T) {
+	all := []string{"web_api", "web_worker", "db_primary", "api-test"}
+	tests := []struct {
+		name      string
+		query     string
+		want      []string
+		wantEmpty bool
+	}{
+		{"only whitespace", "   \t ", all, true},
+		{"only AND", "AND &&", all, true},
+		{"field without value", "stack:", all, true},
+		{"empty quotes", `name:""`, all, true},
+		{"lone bang is free text", "!", nil, false},
+		{"invalid regex falls back to a literal", "name:/web_[/", nil, false},
+		{"invalid regex literal matches", "/api-(/", nil, false},
+		{"unterminated quote swallows the rest", `image:"nginx:1.27 stack:qa`, nil, false},
+		{"unterminated regex is literal free text", "/web", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if empty := CompileQuery(tt.query, testFields).Empty(); empty != tt.wantEmpty {
+				t.Errorf("query %q Empty() = %v, want %v", tt.query, empty, tt.wantEmpty)
+			}
+			if got := matching(tt.query); !slices.Equal(got, tt.want) {
+				t.Errorf("query %q matched %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFreeTerms(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string
+	}{
+		{"", nil},
+		{"Web API", []string{"web", "api"}},
+		{"stack:web api", []string{"api"}},
+		{"image:~ngx", nil},
+		{"!test api", []string{"api"}},
+		{"/^web/", nil},
+		{"host:web", []string{"host:web"}},
+		{`"web api"`, []string{"web api"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			if got := CompileQuery(tt.query, testFields).FreeTerms(); !slices.Equal(got, tt.want) {
+				t.Errorf("FreeTerms(%q) = %q, want %q", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestApplyFilterRanking checks that only free-text terms rank the filtered
+// list: field terms narrow it down without changing the order.
+func TestApplyFilterRanking(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{"no query keeps items order", "", []string{"web_api", "web_worker", "db_primary", "api-test"}},
+		{"free term ranks best first", "api", []string{"api-test", "web_api"}},
+		{"field term keeps items order", "image:nginx", []string{"web_api", "api-test"}},
+		{"field term then free term ranks", "image:nginx api", []string{"api-test", "web_api"}},
+		{"negated term does not rank", "!stack:data wr", []string{"web_worker"}},
+		{"every free term must match", "web api", []string{"web_api"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := FilterableList[testService]{
+				Items:  testServices,
+				Query:  tt.query,
+				Fields: testFields,
+				Score:  testScore,
+			}
+			f.ApplyFilter()
+			var got []string
+			for _, s := range f.Filtered {
+				got = append(got, s.name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("query %q ranked %q, want %q", tt.query, got, tt.want)
+			}
+			if f.Ranked() != (tt.query != "") {
+				t.Errorf("query %q Ranked() = %v", tt.query, f.Ranked())
+			}
+		})
+	}
+}
@@ -38,6 +38,9 @@ func (f *FilterableList[T]) View() string {
 	lines := make([]string, len(f.Filtered))
 	for i, item := range f.Filtered {
 		lines[i] = f.RenderItem(item, i == f.Cursor, f.colWidth)
+		if f.IsMarked(item) {
+			lines[i] = markRow(lines[i])
+		}
 	}
 
 	// Ensure cursor is visible before setting content
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package ui
+
+import (
+	"swarmcli/core/diff"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	DiffInsertStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	DiffDeleteStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+	DiffContextStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+)
+
+// RenderDiff colours a diff like `diff -u`, keeping only context lines
+// around the changes; skipped lines show as "…".
+func RenderDiff(lines []diff.Line, context int) []string {
+	keep := make([]bool, len(lines))
+	for i, l := range lines {
+		if l.Op == diff.Equal {
+			continue
+		}
+		for j := max(i-context, 0); j <= min(i+context, len(lines)-1); j++ {
+			keep[j] = true
+		}
+	}
+
+	var out []string
+	skipped := false
+	for i, l := range lines {
+		if !keep[i] {
+			skipped = true
+			continue
+		}
+		if skipped && len(out) > 0 {
+			out = append(out, DiffContextStyle.Render("  …"))
+		}
+		skipped = false
+		text := string(l.Op) + " " + l.Text
+		switch l.Op {
+		case diff.Insert:
+			out = append(out, DiffInsertStyle.Render(text))
+		case diff.Delete:
+			out = append(out, DiffDeleteStyle.Render(text))
+		default:
+			out = append(out, DiffContextStyle.Render(text))
+		}
+	}
+	return out
+}
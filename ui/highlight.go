@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// MatchHighlightStyle is applied on top of a row's base style to mark the
+// runes that satisfied a fuzzy filter.
+var MatchHighlightStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("214")).
+	Bold(true)
+
+// HighlightRunes renders text with base, switching to base+hl for the rune
+// indices listed in positions. Positions past the end of text are ignored, so
+// callers can truncate or pad text after matching.
+func HighlightRunes(text string, positions []int, base, hl lipgloss.Style) string {
+	if len(positions) == 0 {
+		return base.Render(text)
+	}
+	marked := make(map[int]bool, len(positions))
+	for _, p := range positions {
+		marked[p] = true
+	}
+	hlStyle := base.Inherit(hl).Foreground(hl.GetForeground())
+
+	var b strings.Builder
+	var run []rune
+	runMarked := false
+	flush := func() {
+		if len(run) == 0 {
+			return
+		}
+		if runMarked {
+			b.WriteString(hlStyle.Render(string(run)))
+		} else {
+			b.WriteString(base.Render(string(run)))
+		}
+		run = run[:0]
+	}
+	for i, r := range []rune(text) {
+		if marked[i] != runMarked {
+			flush()
+			runMarked = marked[i]
+		}
+		run = append(run, r)
+	}
+	flush()
+	return b.String()
+}
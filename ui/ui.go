@@ -85,12 +85,14 @@ func RenderFramedBox(title, header, content, footer string, width int) string {
 	// Box lines start with top border
 	boxLines := []string{topLine}
 
-	// Optional header
+	// Optional header, possibly on several lines
 	if header != "" {
-		boxLines = append(boxLines, fmt.Sprintf("%s%s%s",
-			borderStyle.Render("│"),
-			padLine(headerStyled, borderWidth),
-			borderStyle.Render("│")))
+		for _, hl := range strings.Split(headerStyled, "\n") {
+			boxLines = append(boxLines, fmt.Sprintf("%s%s%s",
+				borderStyle.Render("│"),
+				padLine(hl, borderWidth),
+				borderStyle.Render("│")))
+		}
 	}
 
 	// Content
@@ -134,10 +136,9 @@ func RenderFramedBoxHeight(title, header, content, footer string, width, frameHe
 		footerLines = strings.Split(footer, "\n")
 	}
 
-	// Header occupies one line if present
 	headerLines := 0
 	if header != "" {
-		headerLines = 1
+		headerLines = len(strings.Split(header, "\n"))
 	}
 
 	// Desired content lines inside the box (not counting borders/top/bottom)
@@ -0,0 +1,103 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package config resolves the user's configuration and state directories and
+// persists UI preferences that should survive restarts.
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	appName = "swarmcli"
+	mu      sync.Mutex
+)
+
+// Init sets the application name used to build the config and state paths.
+func Init(name string) {
+	if name != "" {
+		appName = name
+	}
+}
+
+// Dir returns the configuration directory:
+// $XDG_CONFIG_HOME/<app>, falling back to ~/.config/<app>.
+func Dir() string {
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); xdg != "" {
+		return filepath.Join(xdg, appName)
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		return filepath.Join(home, ".config", appName)
+	}
+	return filepath.Join(os.TempDir(), appName, "config")
+}
+
+// StateDir returns the state directory (the same place the logs go):
+// $XDG_STATE_HOME/<app>, falling back to ~/.local/state/<app>.
+func StateDir() string {
+	if xdg := os.Getenv("XDG_STATE_HOME"); xdg != "" {
+		return filepath.Join(xdg, appName)
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		return filepath.Join(home, ".local", "state", appName)
+	}
+	return filepath.Join(os.TempDir(), appName)
+}
+
+// Path returns the location of a file inside the configuration directory.
+func Path(name string) string {
+	return filepath.Join(Dir(), name)
+}
+
+// loadYAML decodes a config file into v. A missing file leaves v untouched.
+func loadYAML(name string, v any) error {
+	data, err := os.ReadFile(Path(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := yaml.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("parse %s: %w", Path(name), err)
+	}
+	return nil
+}
+
+// saveYAML writes v to a config file, replacing it atomically.
+func saveYAML(name string, v any) error {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	data := buf.Bytes()
+	if err := os.MkdirAll(Dir(), 0755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(Dir(), name+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), Path(name))
+}
@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package config
+
+// ContextsFile holds per-context settings, keyed by Docker context name,
+// and the contexts shown by the clusters overview (all when unset):
+//
+//	clusters: [prod-eu, prod-us, staging]
+//	contexts:
+//	  prod:
+//	    color: "196"
+//	  staging:
+//	    color: "#2e8b57"
+const ContextsFile = "contexts.yaml"
+
+// ContextSettings are the stored settings of a Docker context.
+type ContextSettings struct {
+	// Color is a lipgloss colour (ANSI number or hex) used for the
+	// context's tab.
+	Color string `yaml:"color,omitempty"`
+}
+
+type contextsConfig struct {
+	Clusters []string                   `yaml:"clusters,omitempty"`
+	Contexts map[string]ContextSettings `yaml:"contexts"`
+}
+
+// Context returns the stored settings of a Docker context; the zero value
+// when there are none.
+func Context(name string) (ContextSettings, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var cfg contextsConfig
+	if err := loadYAML(ContextsFile, &cfg); err != nil {
+		return ContextSettings{}, err
+	}
+	return cfg.Contexts[name], nil
+}
+
+// Clusters returns the contexts configured for the clusters overview; nil
+// means every context.
+func Clusters() ([]string, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var cfg contextsConfig
+	if err := loadYAML(ContextsFile, &cfg); err != nil {
+		return nil, err
+	}
+	return cfg.Clusters, nil
+}
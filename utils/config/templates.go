@@ -0,0 +1,72 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package config
+
+import (
+	"maps"
+	"slices"
+)
+
+// TemplatesFile holds reusable service templates, keyed by template name,
+// offered when creating a service:
+//
+//	templates:
+//	  web:
+//	    description: nginx on the proxy network
+//	    name: web
+//	    image: nginx:1.27
+//	    replicas: 2
+//	    ports: ["8080:80"]
+//	    networks: [proxy]
+//	    env: [TZ=UTC]
+//	    labels:
+//	      team: web
+//	  cleanup:
+//	    image: alpine:3
+//	    mode: replicated-job
+//	    constraints: [node.role==worker]
+const TemplatesFile = "templates.yaml"
+
+// ServiceTemplate pre-fills the create service form.
+type ServiceTemplate struct {
+	// Template is the template's key in the file.
+	Template    string `yaml:"-"`
+	Description string `yaml:"description,omitempty"`
+	// Name is the default service name.
+	Name  string `yaml:"name,omitempty"`
+	Image string `yaml:"image,omitempty"`
+	// Mode is replicated, global, replicated-job or global-job.
+	Mode     string            `yaml:"mode,omitempty"`
+	Replicas *uint64           `yaml:"replicas,omitempty"`
+	Ports    []string          `yaml:"ports,omitempty"`
+	Networks []string          `yaml:"networks,omitempty"`
+	Configs  []string          `yaml:"configs,omitempty"`
+	Secrets  []string          `yaml:"secrets,omitempty"`
+	Env      []string          `yaml:"env,omitempty"`
+	Labels   map[string]string `yaml:"labels,omitempty"`
+	// Constraints are placement constraints, e.g. node.role==worker.
+	Constraints []string `yaml:"constraints,omitempty"`
+}
+
+type templatesConfig struct {
+	Templates map[string]ServiceTemplate `yaml:"templates"`
+}
+
+// ServiceTemplates returns the stored service templates, sorted by name.
+func ServiceTemplates() ([]ServiceTemplate, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var cfg templatesConfig
+	if err := loadYAML(TemplatesFile, &cfg); err != nil {
+		return nil, err
+	}
+	templates := make([]ServiceTemplate, 0, len(cfg.Templates))
+	for _, name := range slices.Sorted(maps.Keys(cfg.Templates)) {
+		t := cfg.Templates[name]
+		t.Template = name
+		templates = append(templates, t)
+	}
+	return templates, nil
+}
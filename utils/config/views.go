@@ -0,0 +1,73 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package config
+
+// ViewsFile holds the per-view column layouts, in the spirit of k9s' views.yaml:
+//
+//	views:
+//	  services:
+//	    columns:
+//	      - name: service
+//	      - name: team
+//	        label: team
+//	      - name: image
+//	        width: 40
+//	      - name: ports
+//	        hidden: true
+const ViewsFile = "views.yaml"
+
+// Column is one entry of a view's column layout.
+type Column struct {
+	// Name identifies a built-in column, or titles a label column.
+	Name string `yaml:"name"`
+	// Label makes this a label-derived column showing the given label key.
+	Label string `yaml:"label,omitempty"`
+	// Width pins the column to a fixed width; zero sizes it automatically.
+	Width int `yaml:"width,omitempty"`
+	// Hidden keeps the column out of the table.
+	Hidden bool `yaml:"hidden,omitempty"`
+}
+
+// ViewLayout is the stored layout of a single view.
+type ViewLayout struct {
+	Columns []Column `yaml:"columns"`
+}
+
+type viewsConfig struct {
+	Views map[string]ViewLayout `yaml:"views"`
+}
+
+// ViewColumns returns the stored column layout of a view, or nil when the
+// view uses its defaults.
+func ViewColumns(view string) ([]Column, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var cfg viewsConfig
+	if err := loadYAML(ViewsFile, &cfg); err != nil {
+		return nil, err
+	}
+	return cfg.Views[view].Columns, nil
+}
+
+// SaveViewColumns stores the column layout of a view. Passing nil removes the
+// view's entry so it falls back to its defaults.
+func SaveViewColumns(view string, cols []Column) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var cfg viewsConfig
+	if err := loadYAML(ViewsFile, &cfg); err != nil {
+		return err
+	}
+	if cfg.Views == nil {
+		cfg.Views = map[string]ViewLayout{}
+	}
+	if cols == nil {
+		delete(cfg.Views, view)
+	} else {
+		cfg.Views[view] = ViewLayout{Columns: cols}
+	}
+	return saveYAML(ViewsFile, cfg)
+}
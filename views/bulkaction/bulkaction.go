@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package bulkaction runs one operation over the items marked in a list view
+// and reports the per-item outcome.
+package bulkaction
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"swarmcli/docker"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// maxListed caps how many item names confirm dialogs and reports spell out.
+const maxListed = 10
+
+// Result is the outcome of the action on a single item.
+type Result struct {
+	Item string
+	Err  error
+}
+
+// DoneMsg is emitted once the action has run on every item.
+type DoneMsg struct {
+	Action  string // past tense verb, e.g. "Restarted"
+	Kind    string // plural noun, e.g. "services"
+	Results []Result
+}
+
+// Failed returns the number of items the action failed on.
+func (d DoneMsg) Failed() int {
+	n := 0
+	for _, r := range d.Results {
+		if r.Err != nil {
+			n++
+		}
+	}
+	return n
+}
+
+// Summary renders a report listing every failure and, space permitting, the
+// items that succeeded.
+func (d DoneMsg) Summary() string {
+	ok := len(d.Results) - d.Failed()
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %d of %d %s", d.Action, ok, len(d.Results), d.Kind)
+	if d.Failed() > 0 {
+		fmt.Fprintf(&b, " (%d failed)", d.Failed())
+	}
+	b.WriteString("\n")
+
+	listed := 0
+	for _, r := range d.Results {
+		if r.Err != nil {
+			fmt.Fprintf(&b, "\n✗ %s: %v", r.Item, r.Err)
+			listed++
+		}
+	}
+	for _, r := range d.Results {
+		if r.Err != nil {
+			continue
+		}
+		if listed >= maxListed {
+			fmt.Fprintf(&b, "\n… and %d more", len(d.Results)-listed)
+			break
+		}
+		fmt.Fprintf(&b, "\n✓ %s", r.Item)
+		listed++
+	}
+	return b.String()
+}
+
+// ConfirmMessage summarises a pending batch for a confirm dialog, e.g.
+// "Restart 3 services?" followed by the item names.
+func ConfirmMessage(verb, kind string, items []string, warning string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %d %s?\n", verb, len(items), kind)
+	for i, it := range items {
+		if i == maxListed {
+			fmt.Fprintf(&b, "\n  … and %d more", len(items)-maxListed)
+			break
+		}
+		fmt.Fprintf(&b, "\n  • %s", it)
+	}
+	if warning != "" {
+		b.WriteString("\n\n" + warning)
+	}
+	return b.String()
+}
+
+// Run applies fn to each item in order and returns a DoneMsg with the
+// per-item results, labelling each item with name. Items are processed
+// sequentially so the daemon is not flooded and the report follows the order
+// of the list. fn gets a context bound to the Docker context of the tab that
+// issued the action.
+func Run[T any](action, kind string, items []T, name func(T) string, fn func(context.Context, T) error) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
+		results := make([]Result, 0, len(items))
+		for _, it := range items {
+			results = append(results, Result{Item: name(it), Err: fn(ctx, it)})
+		}
+		return DoneMsg{Action: action, Kind: kind, Results: results}
+	})
+}
+
+// Names maps items to their display names, for ConfirmMessage.
+func Names[T any](items []T, name func(T) string) []string {
+	out := make([]string, len(items))
+	for i, it := range items {
+		out[i] = name(it)
+	}
+	return out
+}
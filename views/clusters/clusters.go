@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package clustersview is the federated overview: one row per configured
+// Docker context with the health of its swarm.
+package clustersview
+
+import swarmlog "swarmcli/utils/log"
+
+const ViewName = "clusters"
+
+func l() *swarmlog.SwarmLogger {
+	return swarmlog.L().With("view", "clusters")
+}
@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package clustersview
+
+import (
+	"fmt"
+	"strconv"
+	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	okColor   = lipgloss.Color("42")
+	warnColor = lipgloss.Color("214")
+	errColor  = lipgloss.Color("196")
+)
+
+// status sums up a cluster in one word.
+func status(c docker.ClusterSummary) string {
+	switch {
+	case c.Err != nil:
+		return "unreachable"
+	case !c.HasQuorum():
+		return "no quorum"
+	case c.NodesDown > 0 || c.ServicesConverged < c.Services || c.FailedTasks > 0:
+		return "degraded"
+	}
+	return "healthy"
+}
+
+// reachable formats a value that only exists for clusters we could reach.
+func reachable(f func(docker.ClusterSummary) string) func(docker.ClusterSummary) string {
+	return func(c docker.ClusterSummary) string {
+		if c.Err != nil {
+			return "-"
+		}
+		return f(c)
+	}
+}
+
+// clusterColumns are the columns of the clusters table.
+var clusterColumns = []columns.Column[docker.ClusterSummary]{
+	{Name: "context", Title: "CONTEXT", Min: 10, Fit: true, Value: func(c docker.ClusterSummary) string { return c.Context }},
+	{Name: "status", Title: "STATUS", Min: 11, Value: status,
+		Color: func(c docker.ClusterSummary) lipgloss.Color {
+			switch status(c) {
+			case "healthy":
+				return okColor
+			case "degraded":
+				return warnColor
+			}
+			return errColor
+		}},
+	{Name: "nodes", Title: "NODES", Min: 14,
+		Value: reachable(func(c docker.ClusterSummary) string {
+			s := fmt.Sprintf("%d/%d ready", c.NodesReady, c.Nodes)
+			if c.NodesDown > 0 {
+				s += fmt.Sprintf(", %d down", c.NodesDown)
+			}
+			return s
+		}),
+		Color: func(c docker.ClusterSummary) lipgloss.Color {
+			if c.NodesDown > 0 {
+				return errColor
+			}
+			return ""
+		}},
+	{Name: "managers", Title: "MANAGERS", Min: 12,
+		Value: reachable(func(c docker.ClusterSummary) string {
+			if !c.HasQuorum() {
+				return fmt.Sprintf("%d/%d no quorum", c.ManagersReachable, c.Managers)
+			}
+			return fmt.Sprintf("%d/%d quorum ok", c.ManagersReachable, c.Managers)
+		}),
+		Color: func(c docker.ClusterSummary) lipgloss.Color {
+			if c.Err == nil && !c.HasQuorum() {
+				return errColor
+			}
+			return ""
+		}},
+	{Name: "services", Title: "CONVERGED", Min: 10,
+		Value: reachable(func(c docker.ClusterSummary) string {
+			return fmt.Sprintf("%d/%d", c.ServicesConverged, c.Services)
+		}),
+		Color: func(c docker.ClusterSummary) lipgloss.Color {
+			if c.ServicesConverged < c.Services {
+				return warnColor
+			}
+			return ""
+		}},
+	{Name: "failed", Title: "FAILED 1H", Min: 9,
+		Value: reachable(func(c docker.ClusterSummary) string { return strconv.Itoa(c.FailedTasks) }),
+		Color: func(c docker.ClusterSummary) lipgloss.Color {
+			if c.FailedTasks > 0 {
+				return errColor
+			}
+			return ""
+		}},
+	{Name: "engine", Title: "ENGINE", Min: 10, Value: reachable(docker.ClusterSummary.Versions),
+		Color: func(c docker.ClusterSummary) lipgloss.Color {
+			if len(c.EngineVersions) > 1 {
+				return warnColor
+			}
+			return ""
+		}},
+	{Name: "host", Title: "HOST", Min: 10, Hidden: true, Value: func(c docker.ClusterSummary) string { return c.Host }},
+	{Name: "error", Title: "ERROR", Min: 10, Hidden: true, Value: func(c docker.ClusterSummary) string {
+		if c.Err == nil {
+			return ""
+		}
+		return c.Err.Error()
+	}},
+}
+
+func newClusterLayout() *columns.Layout[docker.ClusterSummary] {
+	layout, err := columns.NewLayout(ViewName, clusterColumns, nil)
+	if err != nil {
+		l().Warnf("Failed to load column layout: %v", err)
+	}
+	layout.SelectedBg = lipgloss.Color("63")
+	return layout
+}
@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package clustersview
+
+import (
+	"swarmcli/core/clipboard"
+	"swarmcli/docker"
+)
+
+// CopyText returns the context of the cluster under the cursor; alt copies
+// its Docker host.
+func (m *Model) CopyText(alt bool) (string, string) {
+	items := clipboard.Selection(nil, m.List.Filtered, m.List.Cursor)
+	if alt {
+		return clipboard.Describe(len(items), "docker host"), clipboard.Join(items, func(c docker.ClusterSummary) string { return c.Host })
+	}
+	return clipboard.Describe(len(items), "context name"), clipboard.Join(items, func(c docker.ClusterSummary) string { return c.Context })
+}
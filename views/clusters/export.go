@@ -0,0 +1,11 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package clustersview
+
+import "swarmcli/core/export"
+
+// ExportTable returns the rows currently listed, in display order.
+func (m *Model) ExportTable() export.Table {
+	return m.columns.Table(m.List.Filtered)
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package clustersview
+
+import (
+	"fmt"
+	"slices"
+	"swarmcli/docker"
+	"swarmcli/utils/config"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// RefreshInterval is how often the overview polls every cluster.
+const RefreshInterval = 15 * time.Second
+
+type Msg struct {
+	Clusters []docker.ClusterSummary
+	Err      error
+}
+
+type TickMsg time.Time
+
+func tickCmd() tea.Cmd {
+	return tea.Tick(RefreshInterval, func(t time.Time) tea.Msg {
+		return TickMsg(t)
+	})
+}
+
+// LoadCmd summarizes the given contexts; with none, the ones configured in
+// contexts.yaml, or every context.
+func LoadCmd(names []string) tea.Cmd {
+	return func() tea.Msg {
+		all, err := docker.ListContexts()
+		if err != nil {
+			return Msg{Err: err}
+		}
+		if len(names) == 0 {
+			if names, err = config.Clusters(); err != nil {
+				l().Warnf("reading configured clusters: %v", err)
+			}
+		}
+		if len(names) == 0 {
+			return Msg{Clusters: docker.LoadClusterSummaries(all)}
+		}
+
+		var selected []docker.ContextInfo
+		var missing []docker.ClusterSummary
+		for _, name := range names {
+			i := slices.IndexFunc(all, func(c docker.ContextInfo) bool { return c.Name == name })
+			if i < 0 {
+				missing = append(missing, docker.ClusterSummary{
+					Context: name,
+					Err:     fmt.Errorf("no such context"),
+				})
+				continue
+			}
+			selected = append(selected, all[i])
+		}
+		return Msg{Clusters: append(docker.LoadClusterSummaries(selected), missing...)}
+	}
+}
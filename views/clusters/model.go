@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package clustersview
+
+import (
+	"swarmcli/core/primitives/fuzzy"
+	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
+	"swarmcli/views/helpbar"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+
+	filterlist "swarmcli/ui/components/filterable/list"
+)
+
+type Model struct {
+	List    filterlist.FilterableList[docker.ClusterSummary]
+	columns *columns.Layout[docker.ClusterSummary]
+	width   int
+	height  int
+
+	// names are the contexts asked for on the command line; empty uses the
+	// configured set
+	names []string
+	err   error
+
+	loaded      bool
+	loading     bool
+	active      bool
+	tickPending bool
+}
+
+func New(width, height int, names []string) *Model {
+	vp := viewport.New(width, height)
+	list := filterlist.FilterableList[docker.ClusterSummary]{
+		Viewport: vp,
+		Score: func(c docker.ClusterSummary, query string) (int, bool) {
+			return fuzzy.Score(query, c.Context)
+		},
+	}
+	return &Model{
+		List:    list,
+		columns: newClusterLayout(),
+		width:   width,
+		height:  height,
+		names:   names,
+	}
+}
+
+func (m *Model) Init() tea.Cmd { return nil }
+
+func (m *Model) Name() string { return ViewName }
+
+func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
+	return []helpbar.HelpEntry{
+		{Key: "enter", Desc: "Open in tab"},
+		{Key: "r", Desc: "Refresh"},
+		{Key: "↑/↓", Desc: "Navigate"},
+		{Key: "/", Desc: "Filter"},
+		{Key: "?", Desc: "Help"},
+		{Key: "q", Desc: "Close"},
+	}
+}
+
+// OnEnter refreshes every cluster and resumes polling.
+func (m *Model) OnEnter() tea.Cmd {
+	m.active = true
+	return m.load()
+}
+
+// OnExit stops polling; six swarms are not worth watching off screen.
+func (m *Model) OnExit() tea.Cmd {
+	m.active = false
+	return nil
+}
+
+func (m *Model) load() tea.Cmd {
+	if m.loading {
+		return nil
+	}
+	m.loading = true
+	return LoadCmd(m.names)
+}
+
+// IsSearching reports whether the list is currently in search mode.
+func (m *Model) IsSearching() bool {
+	return m.List.Mode == filterlist.ModeSearching
+}
+
+func (m *Model) HasActiveFilter() bool {
+	return m.List.Query != ""
+}
+
+// SelectedCluster returns the cluster under the cursor.
+func (m *Model) SelectedCluster() (docker.ClusterSummary, bool) {
+	if m.List.Cursor < 0 || m.List.Cursor >= len(m.List.Filtered) {
+		return docker.ClusterSummary{}, false
+	}
+	return m.List.Filtered[m.List.Cursor], true
+}
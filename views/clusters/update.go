@@ -0,0 +1,117 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package clustersview
+
+import (
+	filterlist "swarmcli/ui/components/filterable/list"
+	helpview "swarmcli/views/help"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case Msg:
+		m.loading = false
+		m.loaded = true
+		m.err = msg.Err
+		if msg.Err != nil {
+			l().Errorf("loading clusters: %v", msg.Err)
+		} else {
+			m.setClusters(msg)
+		}
+		if m.active && !m.tickPending {
+			m.tickPending = true
+			return tickCmd()
+		}
+		return nil
+
+	case TickMsg:
+		m.tickPending = false
+		if m.active {
+			return m.load()
+		}
+		return nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.List.Viewport.Width = msg.Width
+		m.List.Viewport.Height = msg.Height
+		return nil
+
+	case tea.KeyMsg:
+		if m.List.Mode == filterlist.ModeSearching {
+			m.List.HandleKey(msg)
+			return nil
+		}
+		if msg.Type == tea.KeyEsc && m.List.Query != "" {
+			m.List.Query = ""
+			m.List.ApplyFilter()
+			m.List.Cursor = 0
+			return nil
+		}
+
+		m.List.HandleKey(msg)
+
+		switch msg.String() {
+		case "?":
+			return func() tea.Msg {
+				return view.NavigateToMsg{
+					ViewName: view.NameHelp,
+					Payload:  GetClustersHelpContent(),
+				}
+			}
+		case "r":
+			return m.load()
+		case "enter":
+			c, ok := m.SelectedCluster()
+			if !ok {
+				return nil
+			}
+			return func() tea.Msg {
+				return view.OpenTabMsg{Context: c.Context}
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+func (m *Model) setClusters(msg Msg) {
+	cursor := m.List.Cursor
+	m.List.Items = msg.Clusters
+	if m.List.Query != "" {
+		m.List.ApplyFilter()
+	} else {
+		m.List.Filtered = msg.Clusters
+	}
+	m.List.Cursor = min(cursor, max(len(m.List.Filtered)-1, 0))
+}
+
+// GetClustersHelpContent returns categorized help for the clusters view
+func GetClustersHelpContent() []helpview.HelpCategory {
+	return []helpview.HelpCategory{
+		{
+			Title: "General",
+			Items: []helpview.HelpItem{
+				{Keys: "<enter>", Description: "Open cluster in a tab"},
+				{Keys: "<r>", Description: "Refresh now"},
+				{Keys: "</>", Description: "Filter"},
+			},
+		},
+		{
+			Title: "Navigation",
+			Items: []helpview.HelpItem{
+				{Keys: "<↑/↓>", Description: "Navigate"},
+				{Keys: "<pgup>", Description: "Page up"},
+				{Keys: "<pgdown>", Description: "Page down"},
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
+				{Keys: "<q>", Description: "Close"},
+			},
+		},
+	}
+}
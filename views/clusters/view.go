@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package clustersview
+
+import (
+	"fmt"
+	"swarmcli/docker"
+	"swarmcli/ui"
+	filterlist "swarmcli/ui/components/filterable/list"
+)
+
+func (m *Model) View() string {
+	healthy := 0
+	for _, c := range m.List.Items {
+		if status(c) == "healthy" {
+			healthy++
+		}
+	}
+	title := fmt.Sprintf("Clusters (%d of %d healthy)", healthy, len(m.List.Items))
+	if m.loading {
+		title += " — refreshing…"
+	}
+
+	width := m.List.Viewport.Width
+	if width <= 0 {
+		if m.width > 0 {
+			width = m.width
+		} else {
+			width = 80
+		}
+	}
+	m.columns.Compute(width, m.List.Items)
+	header := ui.FrameHeaderStyle.Render(m.columns.Header(nil))
+	m.List.RenderItem = func(c docker.ClusterSummary, selected bool, _ int) string {
+		return m.columns.Row(c, selected)
+	}
+
+	status := fmt.Sprintf("Cluster %d of %d", m.List.Cursor+1, len(m.List.Filtered))
+	if c, ok := m.SelectedCluster(); ok && c.Err != nil {
+		status = fmt.Sprintf("%s: %v", c.Context, c.Err)
+	}
+	footer := ui.StatusBarStyle.Render(status)
+	if m.List.Mode == filterlist.ModeSearching {
+		footer += "\n" + ui.StatusBarStyle.Render("Filter (type then Enter): "+m.List.Query)
+	} else if m.List.Query != "" {
+		footer += "\n" + ui.StatusBarStyle.Render("Filter: "+m.List.Query)
+	}
+
+	frame := ui.ComputeFrameDimensions(
+		m.List.Viewport.Width,
+		m.List.Viewport.Height,
+		m.width,
+		m.height,
+		header,
+		footer,
+	)
+	if frame.DesiredContentLines < 1 {
+		frame.DesiredContentLines = 1
+	}
+
+	var content string
+	switch {
+	case m.err != nil:
+		content = fmt.Sprintf("Error listing contexts: %v", m.err)
+	case !m.loaded:
+		content = "Connecting to clusters..."
+	case len(m.List.Items) == 0:
+		content = "No contexts configured."
+	default:
+		content = m.List.VisibleContent(frame.DesiredContentLines)
+	}
+
+	return ui.RenderFramedBoxHeight(title, header, content, footer, frame.FrameWidth, frame.FrameHeight)
+}
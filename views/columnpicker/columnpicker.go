@@ -0,0 +1,263 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package columnpicker implements the dialog used to show, hide, reorder and
+// pin the columns of a list view, and to add label-derived columns.
+package columnpicker
+
+import (
+	"fmt"
+	"strings"
+	"swarmcli/ui/components/columns"
+	"swarmcli/utils/config"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// OpenMsg asks the current view to open its column picker (sent by :columns).
+type OpenMsg struct{}
+
+// ResultMsg is emitted when the picker closes.
+type ResultMsg struct {
+	Confirmed bool
+	// Reset restores the view's default layout.
+	Reset   bool
+	Columns []config.Column
+}
+
+const widthStep = 2
+
+type Model struct {
+	Visible bool
+	Target  string
+	Columns []config.Column
+	Width   int
+	Height  int
+
+	cursor  int
+	adding  bool
+	input   string
+	message string
+}
+
+func New(width, height int) *Model {
+	return &Model{Width: width, Height: height}
+}
+
+// Show opens the picker on a copy of the given layout.
+func (m *Model) Show(view string, cols []config.Column) *Model {
+	m.Visible = true
+	m.Target = view
+	m.Columns = append([]config.Column(nil), cols...)
+	m.cursor = 0
+	m.adding = false
+	m.input = ""
+	m.message = ""
+	return m
+}
+
+func (m *Model) Init() tea.Cmd { return nil }
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok || !m.Visible {
+		return nil
+	}
+	if m.adding {
+		return m.handleAddKey(key)
+	}
+
+	m.message = ""
+	switch key.String() {
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.Columns)-1 {
+			m.cursor++
+		}
+	case " ", "x":
+		if m.cursor < len(m.Columns) {
+			m.Columns[m.cursor].Hidden = !m.Columns[m.cursor].Hidden
+		}
+	case "K", "shift+up":
+		if m.cursor > 0 {
+			m.Columns[m.cursor-1], m.Columns[m.cursor] = m.Columns[m.cursor], m.Columns[m.cursor-1]
+			m.cursor--
+		}
+	case "J", "shift+down":
+		if m.cursor < len(m.Columns)-1 {
+			m.Columns[m.cursor+1], m.Columns[m.cursor] = m.Columns[m.cursor], m.Columns[m.cursor+1]
+			m.cursor++
+		}
+	case "+", "right", "l":
+		if m.cursor < len(m.Columns) {
+			c := &m.Columns[m.cursor]
+			if c.Width == 0 {
+				c.Width = 10
+			} else {
+				c.Width += widthStep
+			}
+		}
+	case "-", "left", "h":
+		if m.cursor < len(m.Columns) {
+			c := &m.Columns[m.cursor]
+			c.Width -= widthStep
+			if c.Width < 4 {
+				c.Width = 0
+			}
+		}
+	case "0":
+		if m.cursor < len(m.Columns) {
+			m.Columns[m.cursor].Width = 0
+		}
+	case "a":
+		m.adding = true
+		m.input = ""
+	case "d", "delete":
+		if m.cursor < len(m.Columns) {
+			if m.Columns[m.cursor].Label == "" {
+				m.message = "Built-in columns can only be hidden"
+				return nil
+			}
+			m.Columns = append(m.Columns[:m.cursor], m.Columns[m.cursor+1:]...)
+			if m.cursor >= len(m.Columns) && m.cursor > 0 {
+				m.cursor--
+			}
+		}
+	case "r":
+		m.Visible = false
+		return func() tea.Msg { return ResultMsg{Confirmed: true, Reset: true} }
+	case "enter":
+		m.Visible = false
+		cols := m.Columns
+		return func() tea.Msg { return ResultMsg{Confirmed: true, Columns: cols} }
+	case "esc", "q":
+		m.Visible = false
+		return func() tea.Msg { return ResultMsg{Confirmed: false} }
+	}
+	return nil
+}
+
+// handleAddKey reads a label column as "key" or "title=key".
+func (m *Model) handleAddKey(key tea.KeyMsg) tea.Cmd {
+	switch key.Type {
+	case tea.KeyEsc:
+		m.adding = false
+	case tea.KeyEnter:
+		m.adding = false
+		input := strings.TrimSpace(m.input)
+		if input == "" {
+			return nil
+		}
+		name, label := input, input
+		if before, after, ok := strings.Cut(input, "="); ok {
+			name, label = strings.TrimSpace(before), strings.TrimSpace(after)
+		}
+		if name == "" || label == "" {
+			m.message = "Use: label-key or title=label-key"
+			return nil
+		}
+		for _, c := range m.Columns {
+			if strings.EqualFold(c.Name, name) {
+				m.message = fmt.Sprintf("A column named %q already exists", name)
+				return nil
+			}
+		}
+		col := config.Column{Name: strings.ToLower(name), Label: label}
+		at := min(m.cursor+1, len(m.Columns))
+		m.Columns = append(m.Columns[:at], append([]config.Column{col}, m.Columns[at:]...)...)
+		m.cursor = at
+	case tea.KeyBackspace:
+		if r := []rune(m.input); len(r) > 0 {
+			m.input = string(r[:len(r)-1])
+		}
+	case tea.KeyRunes, tea.KeySpace:
+		m.input += string(key.Runes)
+	}
+	return nil
+}
+
+func (m *Model) View() string {
+	if !m.Visible {
+		return ""
+	}
+
+	contentWidth := 60
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("15")).
+		Background(lipgloss.Color("63")).
+		Padding(0, 1).
+		Width(contentWidth)
+
+	itemStyle := lipgloss.NewStyle().Padding(0, 2).Width(contentWidth)
+	selStyle := itemStyle.
+		Foreground(lipgloss.Color("230")).
+		Background(lipgloss.Color("25")).
+		Bold(true)
+	hiddenStyle := itemStyle.Foreground(lipgloss.Color("8"))
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Padding(0, 2).
+		Width(contentWidth)
+
+	keyStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("63")).
+		Bold(true)
+
+	borderStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("63")).
+		Width(contentWidth + 2)
+
+	var lines []string
+	lines = append(lines, titleStyle.Render(fmt.Sprintf(" Columns: %s ", m.Target)))
+	lines = append(lines, "")
+	for i, c := range m.Columns {
+		check := "[x]"
+		if c.Hidden {
+			check = "[ ]"
+		}
+		line := fmt.Sprintf("%s %s", check, columns.Describe(c))
+		switch {
+		case i == m.cursor:
+			lines = append(lines, selStyle.Render(line))
+		case c.Hidden:
+			lines = append(lines, hiddenStyle.Render(line))
+		default:
+			lines = append(lines, itemStyle.Render(line))
+		}
+	}
+	lines = append(lines, "")
+
+	if m.adding {
+		lines = append(lines, itemStyle.Render("Label column (key or title=key): "+m.input+"█"))
+	} else if m.message != "" {
+		lines = append(lines, itemStyle.Foreground(lipgloss.Color("11")).Render(m.message))
+	}
+
+	help := []string{
+		fmt.Sprintf("%s Show/hide • %s Move • %s Width • %s Auto width",
+			keyStyle.Render("<Space>"),
+			keyStyle.Render("<K/J>"),
+			keyStyle.Render("<+/->"),
+			keyStyle.Render("<0>")),
+		fmt.Sprintf("%s Add label column • %s Delete • %s Reset",
+			keyStyle.Render("<a>"),
+			keyStyle.Render("<d>"),
+			keyStyle.Render("<r>")),
+		fmt.Sprintf("%s Save • %s Cancel",
+			keyStyle.Render("<Enter>"),
+			keyStyle.Render("<Esc>")),
+	}
+	for _, h := range help {
+		lines = append(lines, helpStyle.Render(h))
+	}
+
+	return borderStyle.Render(strings.Join(lines, "\n"))
+}
@@ -5,6 +5,8 @@ package commandinput
 
 import (
 	"strings"
+	"swarmcli/core/primitives/fuzzy"
+	"swarmcli/ui"
 
 	"github.com/charmbracelet/lipgloss"
 )
@@ -43,12 +45,19 @@ func (m *Model) View() string {
 				inline = suffixStyle.Render(sel[len(typed):])
 			}
 		}
-		// populate suggestion list beneath as before
+		// populate suggestion list beneath, highlighting the runes that
+		// matched the typed text
 		for i, s := range m.suggestions {
+			var positions []int
+			if r, ok := fuzzy.Match(typed, s); ok {
+				positions = r.Positions
+			}
 			if i == m.selected {
-				suggestionLines = append(suggestionLines, selectedStyle.Render("> "+s))
+				suggestionLines = append(suggestionLines,
+					selectedStyle.Render("> ")+ui.HighlightRunes(s, positions, selectedStyle, ui.MatchHighlightStyle))
 			} else {
-				suggestionLines = append(suggestionLines, suggestionStyle.Render("  "+s))
+				suggestionLines = append(suggestionLines,
+					suggestionStyle.Render("  ")+ui.HighlightRunes(s, positions, suggestionStyle, ui.MatchHighlightStyle))
 			}
 		}
 	}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"swarmcli/core/primitives/hash"
 	"swarmcli/docker"
+	"swarmcli/views/bulkaction"
 	inspectview "swarmcli/views/inspect"
 	"swarmcli/views/view"
 
@@ -22,8 +23,8 @@ import (
 // --- Async commands ---
 
 func loadConfigsCmd() tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
 		cfgs, err := docker.ListConfigs(ctx)
 		if err != nil {
 			return errorMsg(fmt.Errorf("failed to list configs: %w", err))
@@ -34,15 +35,15 @@ func loadConfigsCmd() tea.Cmd {
 			wrapped[i] = docker.ConfigWithDecodedData{Config: c, Data: c.Spec.Data}
 		}
 		return configsLoadedMsg(wrapped)
-	}
+	})
 }
 
 // computeConfigUsedCmd checks which configs are used by services in background
 // and returns a usedStatusUpdatedMsg containing a map[id]bool.
 func computeConfigUsedCmd(cfgs []docker.ConfigWithDecodedData) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		usedMap := make(map[string]bool, len(cfgs))
-		ctx := context.Background()
+		ctx := docker.WithContextName(context.Background(), ctxName)
 		for _, c := range cfgs {
 			usedMap[c.Config.ID] = false
 			svcs, err := docker.ListServicesUsingConfigID(ctx, c.Config.ID)
@@ -51,15 +52,15 @@ func computeConfigUsedCmd(cfgs []docker.ConfigWithDecodedData) tea.Cmd {
 			}
 		}
 		return usedStatusUpdatedMsg(usedMap)
-	}
+	})
 }
 
 // CheckConfigsCmd checks if configs have changed and returns update message if so
 func CheckConfigsCmd(lastHash uint64) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		l().Info("CheckConfigsCmd: Polling for config changes")
 
-		ctx := context.Background()
+		ctx := docker.WithContextName(context.Background(), ctxName)
 		cfgs, err := docker.ListConfigs(ctx)
 		if err != nil {
 			l().Errorf("CheckConfigsCmd: ListConfigs failed: %v", err)
@@ -105,7 +106,7 @@ func CheckConfigsCmd(lastHash uint64) tea.Cmd {
 		l().Info("CheckConfigsCmd: No changes detected, scheduling next poll")
 		// Schedule next poll in 5 seconds
 		return tickCmd()
-	}
+	})
 }
 
 func rotateConfigCmd(oldCfg *docker.ConfigWithDecodedData, newCfg *docker.ConfigWithDecodedData) tea.Cmd {
@@ -114,8 +115,8 @@ func rotateConfigCmd(oldCfg *docker.ConfigWithDecodedData, newCfg *docker.Config
 	}
 
 	l().Debugln("Starting to rotate config", newCfg.Config.Spec.Name)
-	return func() tea.Msg {
-		ctx := context.Background()
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
 
 		oldSwarmCfg := &swarm.Config{}
 		if oldCfg != nil {
@@ -133,12 +134,12 @@ func rotateConfigCmd(oldCfg *docker.ConfigWithDecodedData, newCfg *docker.Config
 			result.Old = *oldCfg
 		}
 		return result
-	}
+	})
 }
 
 func inspectConfigCmd(name string) tea.Cmd {
-	return func() tea.Msg {
-		cfg, err := docker.InspectConfig(context.Background(), name)
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		cfg, err := docker.InspectConfig(docker.WithContextName(context.Background(), ctxName), name)
 		jsonStr := ""
 		if err != nil {
 			jsonStr = fmt.Sprintf("Error inspecting config %q: %v", name, err)
@@ -160,12 +161,12 @@ func inspectConfigCmd(name string) tea.Cmd {
 				},
 			},
 		}
-	}
+	})
 }
 
 func inspectRawConfigCmd(name string) tea.Cmd {
-	return func() tea.Msg {
-		cfg, err := docker.InspectConfig(context.Background(), name)
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		cfg, err := docker.InspectConfig(docker.WithContextName(context.Background(), ctxName), name)
 		if err != nil {
 			return view.NavigateToMsg{
 				ViewName: inspectview.ViewName,
@@ -187,18 +188,25 @@ func inspectRawConfigCmd(name string) tea.Cmd {
 				"format": inspectview.FormatRaw,
 			},
 		}
-	}
+	})
+}
+
+// deleteConfigsCmd deletes every given config and reports per-item results.
+func deleteConfigsCmd(items []configItem) tea.Cmd {
+	return bulkaction.Run("Deleted", "configs", items, configName, func(ctx context.Context, c configItem) error {
+		return docker.DeleteConfig(ctx, c.ID)
+	})
 }
 
 func deleteConfigCmd(name string) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
 		err := docker.DeleteConfig(ctx, name)
 		if err != nil {
 			return errorMsg(fmt.Errorf("failed to delete config %q: %w", name, err))
 		}
 		return configDeletedMsg{Name: name}
-	}
+	})
 }
 
 func loadFilesCmd(dirPath string) tea.Cmd {
@@ -253,7 +261,7 @@ func loadFilesCmd(dirPath string) tea.Cmd {
 }
 
 func createConfigFromFileCmd(name, filePath string, labels map[string]string) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		l().Infof("Creating config %s from file %s (labels=%v)", name, filePath, labels)
 
 		// Read file content
@@ -264,7 +272,7 @@ func createConfigFromFileCmd(name, filePath string, labels map[string]string) te
 		}
 
 		// Create the config
-		ctx := context.Background()
+		ctx := docker.WithContextName(context.Background(), ctxName)
 		newCfg, err := docker.CreateConfig(ctx, name, data, labels)
 		if err != nil {
 			l().Errorf("Failed to create config %s: %v", name, err)
@@ -274,14 +282,14 @@ func createConfigFromFileCmd(name, filePath string, labels map[string]string) te
 
 		l().Infof("Successfully created config %s from file", name)
 		return configCreatedMsg{Config: newCfg}
-	}
+	})
 }
 
 func createConfigFromContentCmd(name string, content []byte, labels map[string]string) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		l().Infof("Creating config %s from inline content (labels=%v)", name, labels)
 
-		ctx := context.Background()
+		ctx := docker.WithContextName(context.Background(), ctxName)
 		newCfg, err := docker.CreateConfig(ctx, name, content, labels)
 		if err != nil {
 			l().Errorf("Failed to create config %s: %v", name, err)
@@ -290,14 +298,14 @@ func createConfigFromContentCmd(name string, content []byte, labels map[string]s
 
 		l().Infof("Successfully created config %s", name)
 		return configCreatedMsg{Config: newCfg}
-	}
+	})
 }
 
 func getUsedByStacksCmd(configName string) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		l().Infof("Getting stacks/services that use config: %s", configName)
 
-		ctx := context.Background()
+		ctx := docker.WithContextName(context.Background(), ctxName)
 		// Get config ID for robust matching
 		cfg, err := docker.InspectConfig(ctx, configName)
 		if err != nil {
@@ -349,5 +357,5 @@ func getUsedByStacksCmd(configName string) tea.Cmd {
 
 		l().Infof("Config %s is used by %d service(s)", configName, len(usedBy))
 		return usedByMsg{ConfigName: configName, UsedBy: usedBy, Error: nil}
-	}
+	})
 }
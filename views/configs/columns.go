@@ -0,0 +1,108 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package configsview
+
+import (
+	"fmt"
+	"swarmcli/core/export"
+	"swarmcli/ui"
+	"swarmcli/ui/components/columns"
+	"swarmcli/ui/components/sorting"
+	"swarmcli/views/columnpicker"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// configColumns returns the built-in columns of the configs table. The used
+// column shows the view's spinner until the usage of a config is known, and
+// the labels column follows the view's horizontal label scroll offset.
+func (m *Model) configColumns() []columns.Column[configItem] {
+	return []columns.Column[configItem]{
+		{Name: "name", Title: "NAME", Min: 10, Fit: true, Search: true, Value: configName},
+		{Name: "id", Title: "ID", Min: 12, Search: true, Value: func(c configItem) string { return c.ID }},
+		{Name: "used", Title: "CONFIG USED", Min: 4,
+			Value: func(c configItem) string { return usedValue(c.Used, c.UsedKnown) },
+			Format: func(c configItem, _ int) string {
+				switch {
+				case !c.UsedKnown:
+					return ui.SpinnerCharAt(m.spinner)
+				case c.Used:
+					return "●"
+				}
+				return ""
+			}},
+		// Timestamps are shown as local times but exported as RFC 3339.
+		{Name: "created", Title: "CREATED AT", Min: 19,
+			Value:  func(c configItem) string { return export.Time(c.CreatedAt) },
+			Format: func(c configItem, _ int) string { return formatTime(c.CreatedAt) }},
+		{Name: "updated", Title: "UPDATED AT", Min: 19,
+			Value:  func(c configItem) string { return export.Time(c.UpdatedAt) },
+			Format: func(c configItem, _ int) string { return formatTime(c.UpdatedAt) }},
+		{Name: "labels", Title: "LABELS", Min: 8,
+			Value: func(c configItem) string { return formatLabels(c.Labels) },
+			Format: func(c configItem, width int) string {
+				return formatLabelsWithScroll(c.Labels, m.labelsScrollOffset, width)
+			}},
+	}
+}
+
+func (m *Model) newConfigLayout() *columns.Layout[configItem] {
+	layout, err := columns.NewLayout(ViewName, m.configColumns(), func(c configItem) map[string]string { return c.Labels })
+	if err != nil {
+		l().Warnf("Failed to load column layout: %v", err)
+	}
+	layout.SelectedBg = lipgloss.Color("63")
+	layout.Terms = m.configsList.FreeTerms
+	return layout
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return "N/A"
+	}
+	return t.Format("2006-01-02 15:04:05")
+}
+
+// sortArrow returns the sort indicator for the given column, if it is the
+// active sort column.
+func (m *Model) sortArrow(name string) string {
+	fields := map[string]SortField{
+		"name":    SortByName,
+		"id":      SortByID,
+		"used":    SortByUsed,
+		"created": SortByCreated,
+		"updated": SortByUpdated,
+		"labels":  SortByLabels,
+	}
+	if f, ok := fields[name]; !ok || f != m.sortField {
+		return ""
+	}
+	if m.sortAscending {
+		return sorting.SortArrow(sorting.Ascending)
+	}
+	return sorting.SortArrow(sorting.Descending)
+}
+
+func (m *Model) openColumnPicker() {
+	m.columnPicker.Show(ViewName, m.columns.Specs())
+}
+
+// applyColumnLayout applies and persists the picker's result.
+func (m *Model) applyColumnLayout(msg columnpicker.ResultMsg) tea.Cmd {
+	if !msg.Confirmed {
+		return nil
+	}
+	err := m.columns.Apply(msg.Reset, msg.Columns)
+	m.setRenderItem()
+	m.configsList.Viewport.SetContent(m.configsList.View())
+
+	if err != nil {
+		l().Errorf("Failed to save column layout: %v", err)
+		m.err = fmt.Errorf("failed to save column layout: %w", err)
+		m.errorDialogActive = true
+	}
+	return nil
+}
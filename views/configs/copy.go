@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package configsview
+
+import "swarmcli/core/clipboard"
+
+// CopyText returns the names (or IDs with alt) of the marked configs, or of the
+// config under the cursor. In the "used by" list it copies service names.
+func (m *Model) CopyText(alt bool) (string, string) {
+	if m.usedByViewActive {
+		items := clipboard.Selection(nil, m.usedByList.Filtered, m.usedByList.Cursor)
+		return clipboard.Describe(len(items), "service name"), clipboard.Join(items, func(i usedByItem) string { return i.ServiceName })
+	}
+	items := clipboard.Selection(m.configsList.Marked(), m.configsList.Filtered, m.configsList.Cursor)
+	if alt {
+		return clipboard.Describe(len(items), "config ID"), clipboard.Join(items, func(i configItem) string { return i.ID })
+	}
+	return clipboard.Describe(len(items), "config name"), clipboard.Join(items, configName)
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package configsview
+
+import (
+	"swarmcli/core/export"
+	"swarmcli/ui/components/columns"
+)
+
+var usedByExportColumns = []columns.Column[usedByItem]{
+	{Name: "stack", Value: func(i usedByItem) string { return i.StackName }},
+	{Name: "service", Value: func(i usedByItem) string { return i.ServiceName }},
+}
+
+// ExportTable returns the rows currently listed, in display order, with
+// every column of the layout including hidden ones.
+func (m *Model) ExportTable() export.Table {
+	if m.usedByViewActive {
+		return columns.Table(usedByExportColumns, m.usedByList.Filtered)
+	}
+	return m.columns.Table(m.configsList.Filtered)
+}
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package configsview
+
+import filterlist "swarmcli/ui/components/filterable/list"
+
+// configFilterFields are the attributes addressable from the filter query,
+// e.g. "label:env=prod !used:yes".
+var configFilterFields = []filterlist.Field[configItem]{
+	{Name: "name", Values: func(c configItem) []string { return []string{c.Name} }},
+	{Name: "id", Values: func(c configItem) []string { return []string{c.ID} }},
+	{Name: "used", Values: func(c configItem) []string { return []string{usedValue(c.Used, c.UsedKnown)} }, Exact: true},
+	{Name: "label", Values: func(c configItem) []string { return filterlist.LabelValues(c.Labels) }, Exact: true},
+}
+
+func usedValue(used, known bool) string {
+	switch {
+	case !known:
+		return "unknown"
+	case used:
+		return "yes"
+	default:
+		return "no"
+	}
+}
+
+func configName(c configItem) string { return c.Name }
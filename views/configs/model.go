@@ -7,8 +7,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"swarmcli/core/primitives/fuzzy"
 	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
 	filterlist "swarmcli/ui/components/filterable/list"
+	"swarmcli/views/columnpicker"
 	"swarmcli/views/confirmdialog"
 	"swarmcli/views/helpbar"
 	loading "swarmcli/views/loading"
@@ -73,9 +76,9 @@ type Model struct {
 	usedByList       filterlist.FilterableList[usedByItem]
 	usedByConfigName string
 
-	// Cached column widths for header alignment
-	colNameWidth int
-	colIdWidth   int
+	// Column layout of the configs table
+	columns      *columns.Layout[configItem]
+	columnPicker *columnpicker.Model
 
 	// Spinner for slow-used-status indicator
 	spinner int
@@ -98,10 +101,10 @@ func New(width, height int) *Model {
 
 	list := filterlist.FilterableList[configItem]{
 		Viewport: vp,
-		Match: func(c configItem, query string) bool {
-			q := strings.ToLower(query)
-			return strings.Contains(strings.ToLower(c.Name), q) ||
-				strings.Contains(strings.ToLower(c.ID), q)
+		Fields:   configFilterFields,
+		Key:      func(c configItem) string { return c.ID },
+		Score: func(c configItem, query string) (int, bool) {
+			return fuzzy.Score(query, c.Name, c.ID)
 		},
 	}
 
@@ -126,7 +129,7 @@ func New(width, height int) *Model {
 	labelsInput.CharLimit = 512
 	labelsInput.Width = 50
 
-	return &Model{
+	m := &Model{
 		configsList:       list,
 		width:             width,
 		height:            height,
@@ -140,7 +143,10 @@ func New(width, height int) *Model {
 		createLabelsInput: labelsInput,
 		sortField:         SortByName,
 		sortAscending:     true,
+		columnPicker:      columnpicker.New(width, height),
 	}
+	m.columns = m.newConfigLayout()
+	return m
 }
 
 func (m *Model) Name() string { return ViewName }
@@ -151,6 +157,11 @@ func (m *Model) HasActiveFilter() bool {
 }
 
 // IsSearching reports whether the configs or UsedBy list is in search mode.
+// HasMarkedItems reports whether configs are marked for a bulk delete.
+func (m *Model) HasMarkedItems() bool {
+	return m.configsList.MarkedCount() > 0
+}
+
 func (m *Model) IsSearching() bool {
 	if m.usedByViewActive {
 		return m.usedByList.Mode == filterlist.ModeSearching
@@ -198,6 +209,8 @@ func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
 		{Key: "Enter", Desc: "Check"},
 		{Key: "e", Desc: "Edit & Rotate"},
 		{Key: "ctrl+d", Desc: "Delete"},
+		{Key: "space", Desc: "Mark"},
+		{Key: "o", Desc: "Columns"},
 		{Key: "?", Desc: "Help"},
 		{Key: "esc/q", Desc: "Back"},
 	}
@@ -240,7 +253,7 @@ func (m *Model) OnExit() tea.Cmd {
 
 // HasActiveDialog returns true if a dialog is currently visible
 func (m *Model) HasActiveDialog() bool {
-	return m.confirmDialog.Visible || m.errorDialogActive || m.createDialogActive || m.fileBrowserActive
+	return m.confirmDialog.Visible || m.errorDialogActive || m.createDialogActive || m.fileBrowserActive || m.columnPicker.Visible
 }
 
 // IsInUsedByView returns true if currently viewing the used-by list
@@ -10,10 +10,12 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"swarmcli/core/primitives/fuzzy"
 	"swarmcli/core/primitives/hash"
 	"swarmcli/docker"
-	"swarmcli/ui"
 	filterlist "swarmcli/ui/components/filterable/list"
+	"swarmcli/views/bulkaction"
+	"swarmcli/views/columnpicker"
 	"swarmcli/views/confirmdialog"
 	helpview "swarmcli/views/help"
 	view "swarmcli/views/view"
@@ -84,6 +86,13 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		m.configsList.Viewport.SetContent(m.configsList.View())
 		return nil
 
+	case columnpicker.OpenMsg:
+		m.openColumnPicker()
+		return nil
+
+	case columnpicker.ResultMsg:
+		return m.applyColumnLayout(msg)
+
 	case tea.WindowSizeMsg:
 		m.configsList.Viewport.Width = msg.Width
 		// msg.Height is already adjusted by the app to account for the
@@ -181,6 +190,13 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			tea.Printf("Rotated %s → %s", msg.Old.Config.Spec.Name, msg.New.Config.Spec.Name),
 		)
 
+	case bulkaction.DoneMsg:
+		m.configsList.ClearMarks()
+		m.confirmDialog.ErrorMode = true
+		m.confirmDialog.Title = "Bulk Result"
+		m.confirmDialog.Show(msg.Summary())
+		return loadConfigsCmd()
+
 	case configDeletedMsg:
 		l().Infof("Config deleted successfully: %s", msg.Name)
 		return loadConfigsCmd()
@@ -312,9 +328,8 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 
 		m.usedByList = filterlist.FilterableList[usedByItem]{
 			Viewport: vp,
-			Match: func(item usedByItem, query string) bool {
-				return strings.Contains(strings.ToLower(item.StackName), strings.ToLower(query)) ||
-					strings.Contains(strings.ToLower(item.ServiceName), strings.ToLower(query))
+			Score: func(item usedByItem, query string) (int, bool) {
+				return fuzzy.Score(query, item.StackName, item.ServiceName)
 			},
 			RenderItem: func(item usedByItem, selected bool, _ int) string {
 				// Compute proportional widths for two columns based on viewport
@@ -405,6 +420,7 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		defer func() {
 			m.pendingAction = ""
 			m.confirmDialog.Visible = false
+			m.confirmDialog.ErrorMode = false
 			m.configToRotateFrom = nil
 			m.configToRotateInto = nil
 			m.configToDelete = nil
@@ -423,6 +439,10 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			}
 			l().Infof("Confirmed rotation for %s", m.configToRotateInto.Config.Spec.Name)
 			return rotateConfigCmd(m.configToRotateFrom, m.configToRotateInto)
+		case "bulk-delete":
+			marked := m.configsList.Marked()
+			l().Infof("Confirmed deletion of %d configs", len(marked))
+			return deleteConfigsCmd(marked)
 		case "delete":
 			if m.configToDelete == nil {
 				l().Warnln("Confirmed delete but configToDelete is nil")
@@ -462,6 +482,10 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			return m.confirmDialog.Update(msg)
 		}
 
+		if m.columnPicker.Visible {
+			return m.columnPicker.Update(msg)
+		}
+
 		// --- if in search mode, handle all keys via FilterableList ---
 		if m.configsList.Mode == filterlist.ModeSearching {
 			m.configsList.HandleKey(msg)
@@ -477,10 +501,20 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			m.configsList.Viewport.GotoTop()
 			return nil
 		}
+		if msg.Type == tea.KeyEsc && m.configsList.MarkedCount() > 0 {
+			m.configsList.ClearMarks()
+			m.configsList.Viewport.SetContent(m.configsList.View())
+			return nil
+		}
 
 		// Handle specific keys in switch, then navigation keys
 		switch msg.String() {
 		case "ctrl+d":
+			if marked := m.configsList.Marked(); len(marked) > 0 {
+				m.pendingAction = "bulk-delete"
+				m.confirmDialog.Show(bulkaction.ConfirmMessage("Delete", "configs", bulkaction.Names(marked, configName), ""))
+				return nil
+			}
 			if len(m.configsList.Filtered) == 0 {
 				return nil
 			}
@@ -567,6 +601,9 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			m.createInputFocus = 0
 			m.createNameInput.Focus()
 			return nil
+		case "o":
+			m.openColumnPicker()
+			return nil
 		case "i":
 			cfg := m.selectedConfig()
 			l().Infof("Inspect key pressed for config: %s", cfg)
@@ -666,138 +703,10 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 }
 
 func (m *Model) setRenderItem() {
-	// Use bright white for content (color 15) for better contrast
-	itemStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("15"))
-
-	m.configsList.RenderItem = func(cfg configItem, selected bool, _ int) string {
-		// Recompute proportional column widths on each render to adapt to viewport resizes.
-		width := m.configsList.Viewport.Width
-		if width <= 0 {
-			width = 80
-		}
-
-		// Columns: NAME | ID | USED | LABELS | CREATED | UPDATED
-		cols := 6
-		starts := make([]int, cols)
-		for i := 0; i < cols; i++ {
-			starts[i] = (i * width) / cols
-		}
-		colWidths := make([]int, cols)
-		for i := 0; i < cols; i++ {
-			if i == cols-1 {
-				colWidths[i] = width - starts[i]
-			} else {
-				colWidths[i] = starts[i+1] - starts[i]
-			}
-			if colWidths[i] < 1 {
-				colWidths[i] = 1
-			}
-		}
-
-		// Ensure CREATED and UPDATED columns have at least 19 chars
-		minTime := 19
-		// current sum of created + updated columns
-		cur := colWidths[3] + colWidths[4]
-		if cur < 2*minTime {
-			deficit := 2*minTime - cur
-			// steal space from earlier cols (prefer NAME then ID)
-			for i := 2; i >= 0 && deficit > 0; i-- {
-				take := deficit
-				if colWidths[i] > take+5 { // leave minimum 5 for each
-					colWidths[i] -= take
-					deficit = 0
-				} else {
-					take = colWidths[i] - 5
-					if take > 0 {
-						colWidths[i] -= take
-						deficit -= take
-					}
-				}
-			}
-			// recompute last two to have minTime each if possible
-			if colWidths[3] < minTime {
-				colWidths[3] = minTime
-			}
-			if colWidths[4] < minTime {
-				colWidths[4] = minTime
-			}
-		}
-
-		// Ensure USED column has at least 1 char
-		if colWidths[2] < 1 {
-			colWidths[2] = 1
-		}
-
-		// Update cached widths for header alignment
-		m.colNameWidth = colWidths[0]
-		m.colIdWidth = colWidths[1]
-
-		// Prepare cell texts (truncate where necessary)
-		// Reserve one character for the leading space in the first column
-		nameText := truncateWithEllipsis(cfg.Name, colWidths[0]-1)
-		idText := truncateWithEllipsis(cfg.ID, colWidths[1])
-		usedText := " "
-		if !cfg.UsedKnown {
-			// Use same spinner charset as systeminfo (14) for consistency
-			usedText = ui.SpinnerCharAt(m.spinner)
-		} else if cfg.Used {
-			usedText = "●"
-		}
-		createdStr := "N/A"
-		if !cfg.CreatedAt.IsZero() {
-			createdStr = cfg.CreatedAt.Format("2006-01-02 15:04:05")
-		}
-		updatedStr := "N/A"
-		if !cfg.UpdatedAt.IsZero() {
-			updatedStr = cfg.UpdatedAt.Format("2006-01-02 15:04:05")
-		}
-		createdText := truncateWithEllipsis(createdStr, colWidths[3])
-		updatedText := truncateWithEllipsis(updatedStr, colWidths[4])
-		// Format labels with scroll (sorted and in last column)
-		// Reserve 1 char for space before frame end
-		maxLabelsWidth := colWidths[5] - 1
-		if maxLabelsWidth < 1 {
-			maxLabelsWidth = 1
-		}
-		labelsText := formatLabelsWithScroll(cfg.Labels, m.labelsScrollOffset, maxLabelsWidth)
-
-		// Render all columns in one format string (no explicit separators, like secrets view)
-		if selected {
-			selBg := lipgloss.Color("63")
-			selStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("230")).Background(selBg).Bold(true)
-			return selStyle.Render(fmt.Sprintf(" %-*s%-*s%-*s%-*s%-*s%-*s",
-				colWidths[0]-1, nameText,
-				colWidths[1], idText,
-				colWidths[2], usedText,
-				colWidths[3], createdText,
-				colWidths[4], updatedText,
-				colWidths[5], labelsText,
-			))
-		}
-
-		return itemStyle.Render(fmt.Sprintf(" %-*s%-*s%-*s%-*s%-*s%-*s",
-			colWidths[0]-1, nameText,
-			colWidths[1], idText,
-			colWidths[2], usedText,
-			colWidths[3], createdText,
-			colWidths[4], updatedText,
-			colWidths[5], labelsText,
-		))
-	}
-}
-
-// truncateWithEllipsis truncates a string preserving room for an ellipsis
-func truncateWithEllipsis(s string, maxWidth int) string {
-	if len(s) <= maxWidth {
-		return s
-	}
-	if maxWidth <= 1 {
-		return "…"
-	}
-	if maxWidth == 2 {
-		return s[:1] + "…"
+	// Columns come from the view's layout (see columns.go)
+	m.configsList.RenderItem = func(item configItem, selected bool, _ int) string {
+		return m.columns.Row(item, selected)
 	}
-	return s[:maxWidth-1] + "…"
 }
 
 func (m *Model) handleCreateDialogKey(msg tea.KeyMsg) tea.Cmd {
@@ -1189,7 +1098,9 @@ func GetConfigsHelpContent() []helpview.HelpCategory {
 				{Keys: "<u>", Description: "Show Used By"},
 				{Keys: "<e>", Description: "Edit & Rotate config"},
 				{Keys: "<ctrl+d>", Description: "Delete config"},
-				{Keys: "</>", Description: "Filter"},
+				{Keys: "<space>", Description: "Mark for bulk delete"},
+				{Keys: "<ctrl+a>", Description: "Mark all filtered"},
+				{Keys: "</>", Description: "Filter (e.g. label:env=prod used:no)"},
 			},
 		},
 		{
@@ -1201,6 +1112,7 @@ func GetConfigsHelpContent() []helpview.HelpCategory {
 				{Keys: "<shift+c>", Description: "Order by Created"},
 				{Keys: "<shift+d>", Description: "Order by Updated"},
 				{Keys: "<shift+l>", Description: "Order by Labels"},
+				{Keys: "<o>", Description: "Columns: show/hide, reorder, pin, add label columns"},
 			},
 		},
 		{
@@ -1209,6 +1121,8 @@ func GetConfigsHelpContent() []helpview.HelpCategory {
 				{Keys: "<↑/↓>", Description: "Navigate"},
 				{Keys: "<pgup>", Description: "Page up"},
 				{Keys: "<pgdown>", Description: "Page down"},
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
 				{Keys: "<esc/q>", Description: "Back to stacks"},
 			},
 		},
@@ -1217,6 +1131,7 @@ func GetConfigsHelpContent() []helpview.HelpCategory {
 
 // applySorting applies the current sort configuration to the filtered list
 func (m *Model) applySorting() {
+	defer m.configsList.PrepareSort()()
 	if len(m.configsList.Filtered) == 0 {
 		return
 	}
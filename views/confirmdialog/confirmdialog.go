@@ -19,6 +19,9 @@ type Model struct {
 	Width     int
 	Height    int
 	ErrorMode bool // If true, shows "Close" instead of "Yes/No"
+	// Title overrides the default dialog title (e.g. to report results in
+	// ErrorMode). It is cleared whenever the dialog closes.
+	Title string
 }
 
 func New(width, height int) *Model { return &Model{Width: width, Height: height} }
@@ -36,6 +39,7 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			switch msg.String() {
 			case "enter", "esc", " ":
 				m.Visible = false
+				m.Title = ""
 				return func() tea.Msg { return ResultMsg{Confirmed: false} }
 			}
 		} else {
@@ -43,8 +47,10 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			switch msg.String() {
 			case "y", "Y":
 				m.Visible = false
+				m.Title = ""
 				return func() tea.Msg { return ResultMsg{Confirmed: true} }
 			case "n", "N", "esc":
+				m.Title = ""
 				return func() tea.Msg { return ResultMsg{Confirmed: false} }
 			}
 		}
@@ -94,9 +100,12 @@ func (m *Model) View() string {
 
 	// Build content
 	var lines []string
-	if m.ErrorMode {
+	switch {
+	case m.Title != "":
+		lines = append(lines, titleStyle.Render(" "+m.Title+" "))
+	case m.ErrorMode:
 		lines = append(lines, titleStyle.Render(" Error "))
-	} else {
+	default:
 		lines = append(lines, titleStyle.Render(" Confirm Action "))
 	}
 	lines = append(lines, messageStyle.Render(m.Message))
@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package contexts
+
+import (
+	"swarmcli/core/clipboard"
+	"swarmcli/docker"
+)
+
+// CopyText returns the name (or Docker host with alt) of the context under
+// the cursor.
+func (m *Model) CopyText(alt bool) (string, string) {
+	items := clipboard.Selection(m.List.Marked(), m.List.Filtered, m.List.Cursor)
+	if alt {
+		return clipboard.Describe(len(items), "Docker host"), clipboard.Join(items, func(c docker.ContextInfo) string { return c.DockerHost })
+	}
+	return clipboard.Describe(len(items), "context name"), clipboard.Join(items, func(c docker.ContextInfo) string { return c.Name })
+}
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package contexts
+
+import (
+	"strconv"
+	"swarmcli/core/export"
+	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
+)
+
+// contextExportColumns are the columns written by :export.
+var contextExportColumns = []columns.Column[docker.ContextInfo]{
+	{Name: "name", Value: func(c docker.ContextInfo) string { return c.Name }},
+	{Name: "current", Value: func(c docker.ContextInfo) string { return strconv.FormatBool(c.Current) }},
+	{Name: "description", Value: func(c docker.ContextInfo) string { return c.Description }},
+	{Name: "docker_host", Value: func(c docker.ContextInfo) string { return c.DockerHost }},
+	{Name: "tls", Value: func(c docker.ContextInfo) string { return strconv.FormatBool(c.TLS) }},
+	{Name: "error", Value: func(c docker.ContextInfo) string { return c.Error }},
+}
+
+// ExportTable returns the rows currently listed, in display order.
+func (m *Model) ExportTable() export.Table {
+	return columns.Table(contextExportColumns, m.List.Filtered)
+}
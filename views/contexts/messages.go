@@ -61,7 +61,9 @@ type ContextUpdatedMsg struct {
 
 // ContextChangedNotification is sent to notify the app that the Docker context has changed
 // and should navigate to stacks view
-type ContextChangedNotification struct{}
+type ContextChangedNotification struct {
+	Name string
+}
 
 // LoadContextsCmd loads all Docker contexts
 func LoadContextsCmd() tea.Msg {
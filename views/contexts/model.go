@@ -4,7 +4,7 @@
 package contexts
 
 import (
-	"strings"
+	"swarmcli/core/primitives/fuzzy"
 	"swarmcli/docker"
 	swarmlog "swarmcli/utils/log"
 	"swarmcli/views/confirmdialog"
@@ -127,8 +127,8 @@ func New() *Model {
 
 	list := filterlist.FilterableList[docker.ContextInfo]{
 		Viewport: vp,
-		Match: func(item docker.ContextInfo, query string) bool {
-			return strings.Contains(strings.ToLower(item.Name), strings.ToLower(query))
+		Score: func(item docker.ContextInfo, query string) (int, bool) {
+			return fuzzy.Score(query, item.Name)
 		},
 	}
 
@@ -400,6 +400,7 @@ func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
 	return []helpbar.HelpEntry{
 		{Key: "↑/↓", Desc: "Navigate"},
 		{Key: "Enter", Desc: "Switch"},
+		{Key: "t", Desc: "New tab"},
 		{Key: "i", Desc: "Inspect"},
 		{Key: "e", Desc: "Edit"},
 		{Key: "x", Desc: "Export"},
@@ -111,7 +111,7 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		m.SetLoading(true)
 		return tea.Batch(
 			func() tea.Msg { return LoadContextsCmd() },
-			func() tea.Msg { return ContextChangedNotification{} },
+			func() tea.Msg { return ContextChangedNotification{Name: msg.ContextName} },
 		)
 
 	case ContextExportedMsg:
@@ -708,6 +708,16 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			m.SetSuccess("")
 			return SwitchContextCmd(ctx.Name)
 
+		case "t":
+			// Open selected context in a new tab
+			ctx, ok := m.GetSelectedContext()
+			if !ok {
+				return nil
+			}
+			return func() tea.Msg {
+				return view.OpenTabMsg{Context: ctx.Name}
+			}
+
 		case "i":
 			// Inspect selected context
 			ctx, ok := m.GetSelectedContext()
@@ -851,6 +861,7 @@ func GetContextsHelpContent() []helpview.HelpCategory {
 			Title: "General",
 			Items: []helpview.HelpItem{
 				{Keys: "<enter>", Description: "Switch to context"},
+				{Keys: "<t>", Description: "Open context in a new tab"},
 				{Keys: "<i>", Description: "Inspect context"},
 				{Keys: "<c>", Description: "Create new context"},
 				{Keys: "<e>", Description: "Edit context description"},
@@ -875,6 +886,8 @@ func GetContextsHelpContent() []helpview.HelpCategory {
 				{Keys: "<↑/↓>", Description: "Navigate"},
 				{Keys: "<pgup>", Description: "Page up"},
 				{Keys: "<pgdown>", Description: "Page down"},
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
 				{Keys: "<esc>", Description: "Back to stacks"},
 			},
 		},
@@ -883,6 +896,7 @@ func GetContextsHelpContent() []helpview.HelpCategory {
 
 // applySorting applies the current sort configuration to the filtered list
 func (m *Model) applySorting() {
+	defer m.List.PrepareSort()()
 	if len(m.List.Filtered) == 0 {
 		return
 	}
@@ -0,0 +1,616 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package createdialog implements the create service dialog: an optional
+// template to start from, a form over the common fields of a service, then
+// the spec to review before the service is created. Cloning a service uses
+// the same dialog with the fields a copy changes.
+package createdialog
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"swarmcli/docker"
+	"swarmcli/utils/config"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// LoadedMsg carries the templates and the names the form offers.
+type LoadedMsg struct {
+	Templates []config.ServiceTemplate
+	// TemplatesErr is a broken templates file; the form still opens.
+	TemplatesErr error
+	Choices      docker.CreateChoices
+	Err          error
+}
+
+// PreparedMsg carries the spec built from the form.
+type PreparedMsg struct {
+	Create *docker.ServiceCreate
+	Err    error
+}
+
+// ResultMsg is emitted once the service was created.
+type ResultMsg struct {
+	ServiceName string
+	ServiceID   string
+	Warnings    []string
+	Err         error
+}
+
+// CloneLoadedMsg carries a service to clone.
+type CloneLoadedMsg struct {
+	Clone *docker.ServiceClone
+	Err   error
+}
+
+// LoadCmd reads the templates and lists the networks, configs and secrets.
+func LoadCmd() tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx, cancel := context.WithTimeout(docker.WithContextName(context.Background(), ctxName), 10*time.Second)
+		defer cancel()
+		choices, err := docker.LoadCreateChoices(ctx)
+		if err != nil {
+			return LoadedMsg{Err: err}
+		}
+		templates, templatesErr := config.ServiceTemplates()
+		return LoadedMsg{Templates: templates, TemplatesErr: templatesErr, Choices: choices}
+	})
+}
+
+// LoadCloneCmd inspects the service to clone.
+func LoadCloneCmd(serviceID string) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx, cancel := context.WithTimeout(docker.WithContextName(context.Background(), ctxName), 10*time.Second)
+		defer cancel()
+		clone, err := docker.LoadServiceClone(ctx, serviceID)
+		return CloneLoadedMsg{Clone: clone, Err: err}
+	})
+}
+
+type step int
+
+const (
+	stepTemplate step = iota
+	stepEdit
+	stepPreparing
+	stepReview
+	stepCreating
+)
+
+// field is one input of the form, bound to a ServiceCreateForm field.
+// Fields with choices pick from them; multi fields hold several.
+type field struct {
+	label       string
+	placeholder string
+	value       func(*docker.ServiceCreateForm) *string
+	choices     func(docker.CreateChoices) []string
+	multi       bool
+}
+
+var fields = []field{
+	{"Name", "my-service", func(f *docker.ServiceCreateForm) *string { return &f.Name }, nil, false},
+	{"Image", "nginx:1.27", func(f *docker.ServiceCreateForm) *string { return &f.Image }, nil, false},
+	{"Mode", docker.ModeReplicated, func(f *docker.ServiceCreateForm) *string { return &f.Mode },
+		func(docker.CreateChoices) []string { return docker.ServiceModes }, false},
+	{"Replicas", "1", func(f *docker.ServiceCreateForm) *string { return &f.Replicas }, nil, false},
+	{"Ports", "8080:80, 53:53/udp", func(f *docker.ServiceCreateForm) *string { return &f.Ports }, nil, false},
+	{"Networks", "proxy, backend", func(f *docker.ServiceCreateForm) *string { return &f.Networks },
+		func(c docker.CreateChoices) []string { return c.Networks }, true},
+	{"Configs", "name or name:/target", func(f *docker.ServiceCreateForm) *string { return &f.Configs },
+		func(c docker.CreateChoices) []string { return c.Configs }, true},
+	{"Secrets", "name or name:target", func(f *docker.ServiceCreateForm) *string { return &f.Secrets },
+		func(c docker.CreateChoices) []string { return c.Secrets }, true},
+	{"Env", "KEY=value, OTHER=value", func(f *docker.ServiceCreateForm) *string { return &f.Env }, nil, false},
+	{"Constraints", "node.role==worker, node.labels.zone==eu-1", func(f *docker.ServiceCreateForm) *string { return &f.Constraints }, nil, false},
+	{"Labels", "key=value, other=value", func(f *docker.ServiceCreateForm) *string { return &f.Labels }, nil, false},
+}
+
+// cloneFields are the fields a clone can change; the rest of the spec is
+// copied.
+var cloneFields = slices.DeleteFunc(slices.Clone(fields), func(f field) bool {
+	return !slices.Contains([]string{"Name", "Image", "Replicas", "Ports", "Labels"}, f.label)
+})
+
+const (
+	labelWidth = 14
+	maxWidth   = 110
+)
+
+type Model struct {
+	Visible bool
+	Width   int
+	Height  int
+
+	title     string
+	fields    []field
+	clone     *docker.ServiceClone
+	note      string
+	templates []config.ServiceTemplate
+	template  int // cursor in the template list; 0 is a blank service
+	choices   docker.CreateChoices
+	pick      int // highlighted choice of the focused field
+	initial   docker.ServiceCreateForm
+	inputs    []textinput.Model
+	focus     int
+	step      step
+	create    *docker.ServiceCreate
+	spec      viewport.Model
+	err       string
+}
+
+func New(width, height int) *Model {
+	return &Model{Width: width, Height: height, spec: viewport.New(0, 0)}
+}
+
+func (m *Model) Init() tea.Cmd { return nil }
+
+// SetSize fits the dialog to the view.
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+}
+
+func (m *Model) contentWidth() int {
+	w := m.Width - 8
+	if w > maxWidth {
+		w = maxWidth
+	}
+	if w < 60 {
+		w = 60
+	}
+	return w
+}
+
+// Show opens the dialog: on the template list when there are templates,
+// on a blank form otherwise.
+func (m *Model) Show(msg LoadedMsg) *Model {
+	m.Visible = true
+	m.title = "Create Service"
+	m.fields = fields
+	m.clone = nil
+	m.note = ""
+	m.templates = msg.Templates
+	m.choices = msg.Choices
+	m.template = 0
+	m.err = ""
+	if msg.TemplatesErr != nil {
+		m.err = fmt.Sprintf("Templates not loaded: %v", msg.TemplatesErr)
+	}
+	if len(m.templates) > 0 {
+		m.step = stepTemplate
+		return m
+	}
+	m.edit(docker.ServiceCreateForm{})
+	return m
+}
+
+// ShowClone opens the form of a service's copy.
+func (m *Model) ShowClone(clone *docker.ServiceClone) *Model {
+	m.Visible = true
+	m.title = "Clone Service: " + clone.Source.Spec.Name
+	m.fields = cloneFields
+	m.clone = clone
+	m.note = ""
+	if len(clone.Removed) > 0 {
+		m.note = "Published ports already in use were removed: " + strings.Join(clone.Removed, ", ")
+	}
+	m.templates = nil
+	m.choices = docker.CreateChoices{}
+	m.err = ""
+	m.edit(clone.Form)
+	return m
+}
+
+func (m *Model) Hide() *Model {
+	m.Visible = false
+	return m
+}
+
+// edit fills the form and switches to it.
+func (m *Model) edit(form docker.ServiceCreateForm) {
+	m.step = stepEdit
+	m.initial = form
+	m.create = nil
+	m.focus = 0
+	m.pick = 0
+	m.inputs = make([]textinput.Model, len(m.fields))
+	for i, f := range m.fields {
+		in := textinput.New()
+		in.Prompt = ""
+		in.Placeholder = f.placeholder
+		in.CharLimit = 4096
+		in.Width = m.contentWidth() - labelWidth - 6
+		in.SetValue(*f.value(&form))
+		m.inputs[i] = in
+	}
+	m.inputs[0].Focus()
+}
+
+// formFromTemplate fills the form from a template.
+func formFromTemplate(t config.ServiceTemplate) docker.ServiceCreateForm {
+	form := docker.ServiceCreateForm{
+		Name:        t.Name,
+		Image:       t.Image,
+		Mode:        t.Mode,
+		Ports:       docker.FormatList(t.Ports),
+		Networks:    docker.FormatList(t.Networks),
+		Configs:     docker.FormatList(t.Configs),
+		Secrets:     docker.FormatList(t.Secrets),
+		Env:         docker.FormatList(t.Env),
+		Constraints: docker.FormatList(t.Constraints),
+		Labels:      docker.FormatMap(t.Labels),
+	}
+	if t.Replicas != nil {
+		form.Replicas = strconv.FormatUint(*t.Replicas, 10)
+	}
+	return form
+}
+
+func (m *Model) form() docker.ServiceCreateForm {
+	var form docker.ServiceCreateForm
+	for i, f := range m.fields {
+		*f.value(&form) = m.inputs[i].Value()
+	}
+	return form
+}
+
+func (m *Model) setFocus(i int) {
+	m.inputs[m.focus].Blur()
+	m.focus = (i + len(m.inputs)) % len(m.inputs)
+	m.inputs[m.focus].Focus()
+	m.pick = 0
+	if f := m.fields[m.focus]; f.choices != nil && !f.multi {
+		// Start from the current value
+		if i := slices.Index(f.choices(m.choices), m.inputs[m.focus].Value()); i >= 0 {
+			m.pick = i
+		}
+	}
+}
+
+// movePick moves the highlighted choice of the focused field; a single
+// value field takes it right away.
+func (m *Model) movePick(delta int) {
+	f := m.fields[m.focus]
+	if f.choices == nil {
+		return
+	}
+	choices := f.choices(m.choices)
+	if len(choices) == 0 {
+		return
+	}
+	m.pick = (m.pick + delta + len(choices)) % len(choices)
+	if !f.multi {
+		m.inputs[m.focus].SetValue(choices[m.pick])
+		m.inputs[m.focus].CursorEnd()
+	}
+}
+
+// togglePick adds or removes the highlighted choice of a multi field.
+func (m *Model) togglePick() {
+	f := m.fields[m.focus]
+	if f.choices == nil || !f.multi {
+		return
+	}
+	choices := f.choices(m.choices)
+	if m.pick >= len(choices) {
+		return
+	}
+	value, err := docker.ToggleListItem(m.inputs[m.focus].Value(), choices[m.pick])
+	if err != nil {
+		m.err = err.Error()
+		return
+	}
+	m.err = ""
+	m.inputs[m.focus].SetValue(value)
+	m.inputs[m.focus].CursorEnd()
+}
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	if !m.Visible {
+		return nil
+	}
+	switch msg := msg.(type) {
+	case PreparedMsg:
+		if m.step != stepPreparing {
+			return nil
+		}
+		if msg.Err != nil {
+			m.step = stepEdit
+			m.err = msg.Err.Error()
+			return nil
+		}
+		m.step = stepReview
+		m.create = msg.Create
+		m.err = ""
+		m.spec.SetContent(strings.TrimRight(msg.Create.YAML, "\n"))
+		m.spec.GotoTop()
+		return nil
+
+	case ResultMsg:
+		if msg.Err != nil {
+			m.step = stepReview
+			m.err = msg.Err.Error()
+			return nil
+		}
+		m.Visible = false
+		return nil
+
+	case tea.KeyMsg:
+		switch m.step {
+		case stepTemplate:
+			return m.handleTemplateKey(msg)
+		case stepEdit:
+			return m.handleEditKey(msg)
+		case stepReview:
+			return m.handleReviewKey(msg)
+		}
+	}
+	return nil
+}
+
+func (m *Model) handleTemplateKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "q":
+		m.Visible = false
+	case "up", "k":
+		if m.template > 0 {
+			m.template--
+		}
+	case "down", "j":
+		if m.template < len(m.templates) {
+			m.template++
+		}
+	case "enter":
+		m.err = ""
+		if m.template == 0 {
+			m.edit(docker.ServiceCreateForm{})
+		} else {
+			m.edit(formFromTemplate(m.templates[m.template-1]))
+		}
+	}
+	return nil
+}
+
+func (m *Model) handleEditKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.err = ""
+		if len(m.templates) > 0 {
+			m.step = stepTemplate
+			return nil
+		}
+		m.Visible = false
+		return nil
+	case "tab", "down":
+		m.setFocus(m.focus + 1)
+		return nil
+	case "shift+tab", "up":
+		m.setFocus(m.focus - 1)
+		return nil
+	case "ctrl+n":
+		m.movePick(1)
+		return nil
+	case "ctrl+p":
+		m.movePick(-1)
+		return nil
+	case "ctrl+t":
+		m.togglePick()
+		return nil
+	case "ctrl+z":
+		// Restore the focused field from the template or cloned service
+		m.inputs[m.focus].SetValue(*m.fields[m.focus].value(&m.initial))
+		return nil
+	case "enter":
+		m.step = stepPreparing
+		m.err = ""
+		form, clone := m.form(), m.clone
+		return docker.ContextCmd(func(ctxName string) tea.Msg {
+			if clone != nil {
+				create, err := docker.PrepareServiceClone(clone, form)
+				return PreparedMsg{Create: create, Err: err}
+			}
+			create, err := docker.PrepareServiceCreate(docker.WithContextName(context.Background(), ctxName), form)
+			return PreparedMsg{Create: create, Err: err}
+		})
+	}
+	var cmd tea.Cmd
+	m.inputs[m.focus], cmd = m.inputs[m.focus].Update(msg)
+	return cmd
+}
+
+func (m *Model) handleReviewKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "b":
+		m.step = stepEdit
+		m.err = ""
+		return nil
+	case "enter", "y":
+		m.step = stepCreating
+		m.err = ""
+		spec := m.create.Spec
+		return docker.ContextCmd(func(ctxName string) tea.Msg {
+			ctx, cancel := context.WithTimeout(docker.WithContextName(context.Background(), ctxName), 30*time.Second)
+			defer cancel()
+			id, warnings, err := docker.CreateServiceFromSpec(ctx, spec)
+			return ResultMsg{ServiceName: spec.Name, ServiceID: id, Warnings: warnings, Err: err}
+		})
+	}
+	var cmd tea.Cmd
+	m.spec, cmd = m.spec.Update(msg)
+	return cmd
+}
+
+// choicesLine renders the choices of the focused field, the highlighted
+// one in reverse, scrolled to keep it visible.
+func (m *Model) choicesLine(width int, keyStyle lipgloss.Style) string {
+	f := m.fields[m.focus]
+	choices := f.choices(m.choices)
+	if len(choices) == 0 {
+		return fmt.Sprintf("No %s to pick from", strings.ToLower(f.label))
+	}
+	current, _ := docker.ParseListNames(m.inputs[m.focus].Value())
+	parts := make([]string, len(choices))
+	for i, c := range choices {
+		text := c
+		if f.multi && slices.Contains(current, c) {
+			text = "✓" + c
+		}
+		if i == m.pick {
+			text = keyStyle.Reverse(true).Render(text)
+		}
+		parts[i] = text
+	}
+	// Drop choices from the front until the highlighted one fits
+	start := 0
+	for start < m.pick && lipgloss.Width(strings.Join(parts[start:m.pick+1], "  ")) > width-12 {
+		start++
+	}
+	line := strings.Join(parts[start:], "  ")
+	if start > 0 {
+		line = "… " + line
+	}
+	return "Available: " + line
+}
+
+func (m *Model) View() string {
+	if !m.Visible {
+		return ""
+	}
+	contentWidth := m.contentWidth()
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("15")).
+		Background(lipgloss.Color("63")).
+		Padding(0, 1).
+		Width(contentWidth)
+
+	labelStyle := lipgloss.NewStyle().Width(labelWidth).Foreground(lipgloss.Color("245"))
+	focusStyle := labelStyle.Foreground(lipgloss.Color("63")).Bold(true)
+	itemStyle := lipgloss.NewStyle().Padding(0, 2).Width(contentWidth)
+	errStyle := itemStyle.Foreground(lipgloss.Color("9"))
+	descStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("245"))
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Padding(0, 2).
+		Width(contentWidth)
+
+	keyStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("63")).
+		Bold(true)
+
+	borderStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("63")).
+		Width(contentWidth + 2)
+
+	var lines []string
+	var help string
+	switch m.step {
+	case stepTemplate:
+		lines = append(lines, titleStyle.Render(" "+m.title+": Template "))
+		lines = append(lines, "")
+		names := []string{"Blank service"}
+		descs := []string{""}
+		for _, t := range m.templates {
+			names = append(names, t.Template)
+			descs = append(descs, t.Description)
+		}
+		for i, name := range names {
+			prefix := "  "
+			if i == m.template {
+				prefix = "➜ "
+				name = keyStyle.Render(name)
+			}
+			line := prefix + name
+			if descs[i] != "" {
+				line += "  " + descStyle.Render(descs[i])
+			}
+			lines = append(lines, itemStyle.Render(line))
+		}
+		lines = append(lines, "")
+		lines = append(lines, helpStyle.Render("Templates are read from "+config.Path(config.TemplatesFile)))
+		help = fmt.Sprintf("%s Navigate • %s Use template • %s Cancel",
+			keyStyle.Render("<↑/↓>"),
+			keyStyle.Render("<Enter>"),
+			keyStyle.Render("<Esc>"))
+
+	case stepEdit, stepPreparing:
+		lines = append(lines, titleStyle.Render(" "+m.title+" "))
+		if m.note != "" {
+			lines = append(lines, itemStyle.Foreground(lipgloss.Color("11")).Render(m.note))
+		}
+		lines = append(lines, "")
+		for i, f := range m.fields {
+			label := labelStyle.Render(f.label)
+			if i == m.focus {
+				label = focusStyle.Render(f.label)
+			}
+			lines = append(lines, itemStyle.Render(label+m.inputs[i].View()))
+		}
+		lines = append(lines, "")
+		f := m.fields[m.focus]
+		switch {
+		case f.choices == nil:
+			lines = append(lines, helpStyle.Render(`Lists are comma separated; quote items containing commas: "A=x,y"`))
+		default:
+			lines = append(lines, itemStyle.Render(m.choicesLine(contentWidth-4, keyStyle)))
+		}
+		if m.step == stepPreparing {
+			lines = append(lines, itemStyle.Render("Building the spec…"))
+		}
+		switch {
+		case f.choices != nil && f.multi:
+			help = fmt.Sprintf("%s Next/previous field • %s Choose • %s Add/remove • %s Review • %s Back",
+				keyStyle.Render("<Tab/↑↓>"),
+				keyStyle.Render("<Ctrl+N/P>"),
+				keyStyle.Render("<Ctrl+T>"),
+				keyStyle.Render("<Enter>"),
+				keyStyle.Render("<Esc>"))
+		case f.choices != nil:
+			help = fmt.Sprintf("%s Next/previous field • %s Choose • %s Review • %s Back",
+				keyStyle.Render("<Tab/↑↓>"),
+				keyStyle.Render("<Ctrl+N/P>"),
+				keyStyle.Render("<Enter>"),
+				keyStyle.Render("<Esc>"))
+		default:
+			help = fmt.Sprintf("%s Next/previous field • %s Undo field • %s Review • %s Back",
+				keyStyle.Render("<Tab/↑↓>"),
+				keyStyle.Render("<Ctrl+Z>"),
+				keyStyle.Render("<Enter>"),
+				keyStyle.Render("<Esc>"))
+		}
+
+	case stepReview, stepCreating:
+		lines = append(lines, titleStyle.Render(fmt.Sprintf(" Review New Service: %s ", m.create.Spec.Name)))
+		lines = append(lines, "")
+		m.spec.Width = contentWidth - 4
+		m.spec.Height = min(m.spec.TotalLineCount(), max(m.Height-14, 5))
+		lines = append(lines, lipgloss.NewStyle().Padding(0, 2).Render(m.spec.View()))
+		if m.step == stepCreating {
+			lines = append(lines, "", itemStyle.Render("Creating service…"))
+		}
+		help = fmt.Sprintf("%s Create • %s Scroll • %s Back to form",
+			keyStyle.Render("<Enter>"),
+			keyStyle.Render("<↑/↓>"),
+			keyStyle.Render("<Esc>"))
+	}
+
+	if m.err != "" {
+		lines = append(lines, "", errStyle.Render(m.err))
+	}
+	lines = append(lines, "")
+	lines = append(lines, helpStyle.Render(help))
+
+	return borderStyle.Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package deployview
+
+import (
+	"fmt"
+	"strings"
+	"swarmcli/ui/components/columns"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	okColor      = lipgloss.Color("42")
+	runningColor = lipgloss.Color("214")
+	errColor     = lipgloss.Color("196")
+)
+
+func statusColor(r *Row) lipgloss.Color {
+	switch r.Status {
+	case statusFailed:
+		return errColor
+	case statusRunning, statusPending:
+		return runningColor
+	}
+	if r.Replicas != nil && r.Replicas.Running < r.Replicas.Desired {
+		return runningColor
+	}
+	return okColor
+}
+
+func replicas(r *Row) string {
+	if r.Replicas == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", r.Replicas.Running, r.Replicas.Desired)
+}
+
+// detail is the error of a row, what it waits for, or the warnings of the
+// daemon.
+func detail(r *Row) string {
+	switch {
+	case r.Err != nil:
+		return r.Err.Error()
+	case r.Detail != "" && !r.Done:
+		return r.Detail
+	}
+	return strings.Join(r.Warnings, "; ")
+}
+
+// deployColumns are the columns of the deploy table.
+var deployColumns = []columns.Column[*Row]{
+	{Name: "kind", Title: "KIND", Min: 7, Value: func(r *Row) string { return r.Kind }},
+	{Name: "name", Title: "NAME", Min: 12, Fit: true, Value: func(r *Row) string { return r.Name }},
+	{Name: "action", Title: "ACTION", Min: 9, Value: func(r *Row) string { return r.Action }},
+	{Name: "status", Title: "STATUS", Min: 7, Value: func(r *Row) string { return r.Status }, Color: statusColor},
+	{Name: "replicas", Title: "REPLICAS", Min: 8, Value: replicas, Color: statusColor},
+	{Name: "detail", Title: "DETAIL", Min: 20, Fit: true, Value: detail},
+}
+
+func newDeployLayout() *columns.Layout[*Row] {
+	layout, err := columns.NewLayout(ViewName, deployColumns, nil)
+	if err != nil {
+		l().Warnf("Failed to load column layout: %v", err)
+	}
+	layout.SelectedBg = lipgloss.Color("63")
+	return layout
+}
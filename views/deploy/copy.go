@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package deployview
+
+import "swarmcli/core/clipboard"
+
+// CopyText returns the name of the object under the cursor; alt copies its
+// error or warnings.
+func (m *Model) CopyText(alt bool) (string, string) {
+	items := clipboard.Selection(nil, m.List.Filtered, m.List.Cursor)
+	if alt {
+		return clipboard.Describe(len(items), "detail"), clipboard.Join(items, detail)
+	}
+	return clipboard.Describe(len(items), "name"), clipboard.Join(items, func(r *Row) string { return r.Name })
+}
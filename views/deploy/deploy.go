@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package deployview deploys a compose file as a stack, or removes a
+// stack, and follows it: one row per network, config, secret and service,
+// then the replicas of deployed services until they converge.
+package deployview
+
+import swarmlog "swarmcli/utils/log"
+
+const ViewName = "deploy"
+
+func l() *swarmlog.SwarmLogger {
+	return swarmlog.L().With("view", "deploy")
+}
@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package deployview
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"swarmcli/core/compose"
+	"swarmcli/docker"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// RefreshInterval is how often the replicas are polled once deployed.
+const RefreshInterval = 2 * time.Second
+
+// Request is the payload of the view: the compose file to deploy and the
+// stack to deploy it as; the stack defaults to the name of the file's
+// project, or of its directory. With Remove set, the view removes those
+// objects of the stack instead.
+type Request struct {
+	File    string
+	Stack   string
+	Options docker.StackDeployOptions
+	Remove  *docker.StackResources
+}
+
+// StartedMsg carries the progress of an operation that started; Err is set
+// when the compose file could not be loaded.
+type StartedMsg struct {
+	Stack    string
+	Warnings []string
+	Err      error
+
+	steps chan docker.StackStep
+	done  chan error
+}
+
+type StepMsg struct {
+	Step docker.StackStep
+
+	src chan docker.StackStep
+}
+
+type DoneMsg struct {
+	Err error
+
+	src chan docker.StackStep
+}
+
+type ReplicasMsg struct {
+	Replicas map[string]docker.StackServiceReplicas
+	Err      error
+}
+
+type TickMsg time.Time
+
+func tickCmd() tea.Cmd {
+	return tea.Tick(RefreshInterval, func(t time.Time) tea.Msg {
+		return TickMsg(t)
+	})
+}
+
+// StartCmd loads the compose file and starts deploying it, or starts
+// removing the stack; the operation goes on when the view is closed.
+func StartCmd(req Request) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
+		if req.Remove != nil {
+			progress := make(chan docker.StackStep)
+			done := make(chan error, 1)
+			go func() {
+				done <- docker.RemoveStack(ctx, *req.Remove, progress)
+				close(done)
+				close(progress)
+			}()
+			return StartedMsg{Stack: req.Stack, steps: queueSteps(progress), done: done}
+		}
+		if req.File == "" {
+			return StartedMsg{Stack: req.Stack, Err: fmt.Errorf("no compose file given, usage: deploy <compose.yml> [stack]")}
+		}
+		p, err := compose.Load(req.File)
+		if err != nil {
+			return StartedMsg{Stack: req.Stack, Err: err}
+		}
+		if req.Stack == "" {
+			req.Stack = p.Name
+		}
+		if req.Stack == "" {
+			req.Stack = filepath.Base(p.Dir)
+		}
+		for _, w := range p.Warnings {
+			l().Warnf("%s: %s", req.File, w)
+		}
+		progress := make(chan docker.StackStep)
+		done := make(chan error, 1)
+		go func() {
+			done <- docker.DeployStack(ctx, p, req.Stack, req.Options, progress)
+			close(done)
+			close(progress)
+		}()
+		return StartedMsg{Stack: req.Stack, Warnings: p.Warnings, steps: queueSteps(progress), done: done}
+	})
+}
+
+// queueSteps relays the steps of an operation without ever blocking it, so it
+// completes even when nobody reads them any more.
+func queueSteps(in <-chan docker.StackStep) chan docker.StackStep {
+	out := make(chan docker.StackStep)
+	go func() {
+		defer close(out)
+		var queue []docker.StackStep
+		for in != nil || len(queue) > 0 {
+			var send chan docker.StackStep
+			var next docker.StackStep
+			if len(queue) > 0 {
+				send, next = out, queue[0]
+			}
+			select {
+			case step, ok := <-in:
+				if !ok {
+					in = nil
+					continue
+				}
+				queue = append(queue, step)
+			case send <- next:
+				queue = queue[1:]
+			}
+		}
+	}()
+	return out
+}
+
+// readStepCmd waits for the next step of the operation, or its result.
+func readStepCmd(steps chan docker.StackStep, done chan error) tea.Cmd {
+	return func() tea.Msg {
+		step, ok := <-steps
+		if !ok {
+			return DoneMsg{Err: <-done, src: steps}
+		}
+		return StepMsg{Step: step, src: steps}
+	}
+}
+
+func loadReplicasCmd(stack string) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		replicas, err := docker.StackReplicas(docker.WithContextName(context.Background(), ctxName), stack)
+		return ReplicasMsg{Replicas: replicas, Err: err}
+	})
+}
@@ -0,0 +1,141 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package deployview
+
+import (
+	"swarmcli/core/primitives/fuzzy"
+	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
+	"swarmcli/views/helpbar"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+
+	filterlist "swarmcli/ui/components/filterable/list"
+)
+
+// Status of a row.
+const (
+	statusPending = "pending"
+	statusRunning = "running"
+	statusDone    = "done"
+	statusFailed  = "failed"
+)
+
+// Row is a network, config, secret or service of the operation.
+type Row struct {
+	docker.StackStep
+	Status   string
+	Replicas *docker.StackServiceReplicas
+}
+
+type Model struct {
+	List    filterlist.FilterableList[*Row]
+	columns *columns.Layout[*Row]
+	width   int
+	height  int
+
+	req      Request
+	warnings []string
+	steps    chan docker.StackStep
+	done     chan error
+
+	started  bool
+	finished bool
+	err      error
+
+	active      bool
+	polling     bool
+	tickPending bool
+}
+
+func New(width, height int, req Request) *Model {
+	vp := viewport.New(width, height)
+	list := filterlist.FilterableList[*Row]{
+		Viewport: vp,
+		Score: func(r *Row, query string) (int, bool) {
+			return fuzzy.Score(query, r.Name)
+		},
+	}
+	m := &Model{
+		List:    list,
+		columns: newDeployLayout(),
+		width:   width,
+		height:  height,
+		req:     req,
+	}
+	if res := req.Remove; res != nil {
+		// Everything to remove is known upfront
+		for _, group := range []struct {
+			kind  string
+			items []docker.StackResource
+		}{{"service", res.Services}, {"network", res.Networks}, {"config", res.Configs}, {"secret", res.Secrets}} {
+			for _, item := range group.items {
+				m.List.Items = append(m.List.Items, &Row{
+					StackStep: docker.StackStep{Kind: group.kind, Name: item.Name, Action: docker.StepRemove},
+					Status:    statusPending,
+				})
+			}
+		}
+		m.List.Filtered = m.List.Items
+	}
+	return m
+}
+
+func (m *Model) Init() tea.Cmd { return nil }
+
+func (m *Model) Name() string { return ViewName }
+
+func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
+	return []helpbar.HelpEntry{
+		{Key: "↑/↓", Desc: "Navigate"},
+		{Key: "/", Desc: "Filter"},
+		{Key: "?", Desc: "Help"},
+		{Key: "q", Desc: "Close"},
+	}
+}
+
+// OnEnter resumes following the operation, whose steps other views
+// dropped meanwhile, or polling the replicas once a deploy is over.
+func (m *Model) OnEnter() tea.Cmd {
+	m.active = true
+	switch {
+	case m.finished && m.req.Remove == nil:
+		return m.poll()
+	case m.steps != nil:
+		return readStepCmd(m.steps, m.done)
+	}
+	return nil
+}
+
+// OnExit stops polling; the operation itself goes on.
+func (m *Model) OnExit() tea.Cmd {
+	m.active = false
+	return nil
+}
+
+func (m *Model) poll() tea.Cmd {
+	if m.polling {
+		return nil
+	}
+	m.polling = true
+	return loadReplicasCmd(m.req.Stack)
+}
+
+// IsSearching reports whether the list is currently in search mode.
+func (m *Model) IsSearching() bool {
+	return m.List.Mode == filterlist.ModeSearching
+}
+
+func (m *Model) HasActiveFilter() bool {
+	return m.List.Query != ""
+}
+
+// SelectedRow returns the row under the cursor.
+func (m *Model) SelectedRow() (*Row, bool) {
+	if m.List.Cursor < 0 || m.List.Cursor >= len(m.List.Filtered) {
+		return nil, false
+	}
+	return m.List.Filtered[m.List.Cursor], true
+}
@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package deployview
+
+import (
+	"swarmcli/docker"
+	filterlist "swarmcli/ui/components/filterable/list"
+	helpview "swarmcli/views/help"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case StartedMsg:
+		m.started = true
+		m.req.Stack = msg.Stack
+		if msg.Err != nil {
+			m.finished = true
+			m.err = msg.Err
+			l().Errorf("loading %s: %v", m.req.File, msg.Err)
+			return nil
+		}
+		m.warnings = msg.Warnings
+		m.steps, m.done = msg.steps, msg.done
+		return readStepCmd(m.steps, m.done)
+
+	case StepMsg:
+		if msg.src != m.steps {
+			return nil
+		}
+		m.applyStep(msg.Step)
+		return readStepCmd(m.steps, m.done)
+
+	case DoneMsg:
+		if msg.src != m.steps || m.finished {
+			return nil
+		}
+		m.finished = true
+		m.err = msg.Err
+		if msg.Err != nil {
+			l().Errorf("%s stack %s: %v", m.verb(), m.req.Stack, msg.Err)
+		}
+		if m.active && m.req.Remove == nil {
+			return m.poll()
+		}
+		return nil
+
+	case ReplicasMsg:
+		m.polling = false
+		if msg.Err != nil {
+			l().Warnf("polling replicas of %s: %v", m.req.Stack, msg.Err)
+		} else {
+			m.setReplicas(msg.Replicas)
+		}
+		if m.active && !m.tickPending {
+			m.tickPending = true
+			return tickCmd()
+		}
+		return nil
+
+	case TickMsg:
+		m.tickPending = false
+		if m.active {
+			return m.poll()
+		}
+		return nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.List.Viewport.Width = msg.Width
+		m.List.Viewport.Height = msg.Height
+		return nil
+
+	case tea.KeyMsg:
+		if m.List.Mode == filterlist.ModeSearching {
+			m.List.HandleKey(msg)
+			return nil
+		}
+		if msg.Type == tea.KeyEsc && m.List.Query != "" {
+			m.List.Query = ""
+			m.List.ApplyFilter()
+			m.List.Cursor = 0
+			return nil
+		}
+
+		m.List.HandleKey(msg)
+
+		if msg.String() == "?" {
+			return func() tea.Msg {
+				return view.NavigateToMsg{
+					ViewName: view.NameHelp,
+					Payload:  GetDeployHelpContent(),
+				}
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+// applyStep adds the object of a step, or updates its row.
+func (m *Model) applyStep(step docker.StackStep) {
+	status := statusRunning
+	switch {
+	case step.Err != nil:
+		status = statusFailed
+	case step.Done:
+		status = statusDone
+	}
+	for _, r := range m.List.Items {
+		if r.Kind == step.Kind && r.Name == step.Name {
+			r.StackStep, r.Status = step, status
+			return
+		}
+	}
+	m.List.Items = append(m.List.Items, &Row{StackStep: step, Status: status})
+	m.refilter()
+}
+
+func (m *Model) setReplicas(replicas map[string]docker.StackServiceReplicas) {
+	for _, r := range m.List.Items {
+		if r.Kind != "service" || r.Action == docker.StepRemove {
+			continue
+		}
+		if n, ok := replicas[r.Name]; ok {
+			r.Replicas = &n
+		}
+	}
+}
+
+func (m *Model) refilter() {
+	cursor := m.List.Cursor
+	if m.List.Query != "" {
+		m.List.ApplyFilter()
+	} else {
+		m.List.Filtered = m.List.Items
+	}
+	m.List.Cursor = min(cursor, max(len(m.List.Filtered)-1, 0))
+}
+
+// GetDeployHelpContent returns categorized help for the deploy view
+func GetDeployHelpContent() []helpview.HelpCategory {
+	return []helpview.HelpCategory{
+		{
+			Title: "General",
+			Items: []helpview.HelpItem{
+				{Keys: "</>", Description: "Filter objects"},
+			},
+		},
+		{
+			Title: "Navigation",
+			Items: []helpview.HelpItem{
+				{Keys: "<↑/↓>", Description: "Navigate"},
+				{Keys: "<pgup>", Description: "Page up"},
+				{Keys: "<pgdown>", Description: "Page down"},
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
+				{Keys: "<q>", Description: "Close (the deploy or removal goes on)"},
+			},
+		},
+	}
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package deployview
+
+import (
+	"fmt"
+	"strings"
+	"swarmcli/ui"
+	filterlist "swarmcli/ui/components/filterable/list"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	labelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("8")).Bold(true)
+	warnStyle  = lipgloss.NewStyle().Foreground(runningColor)
+	errStyle   = lipgloss.NewStyle().Foreground(errColor)
+)
+
+func (m *Model) verb() string {
+	if m.req.Remove != nil {
+		return "removing"
+	}
+	return "deploying"
+}
+
+// summary is the block above the table: the file, the options and what
+// loading it reported, or what a removal goes through.
+func (m *Model) summary(width int) string {
+	wrap := lipgloss.NewStyle().Width(width)
+	if m.req.Remove != nil {
+		line := wrap.Render(labelStyle.Render("Order: ") + "services, then once their tasks are gone, networks, configs and secrets")
+		if m.finished && m.err != nil {
+			line += "\n" + wrap.Render(labelStyle.Render("Error: ")+errStyle.Render(m.err.Error()))
+		}
+		return line
+	}
+	var opts []string
+	if m.req.Options.Prune {
+		opts = append(opts, "prune")
+	}
+	if m.req.Options.WithRegistryAuth {
+		opts = append(opts, "with registry auth")
+	}
+	file := m.req.File
+	if len(opts) > 0 {
+		file += " (" + strings.Join(opts, ", ") + ")"
+	}
+	lines := []string{wrap.Render(labelStyle.Render("File: ") + file)}
+	for _, w := range m.warnings {
+		lines = append(lines, wrap.Render(labelStyle.Render("Warning: ")+warnStyle.Render(w)))
+	}
+	if m.finished && m.err != nil {
+		lines = append(lines, wrap.Render(labelStyle.Render("Error: ")+errStyle.Render(m.err.Error())))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m *Model) converged() (running, total int) {
+	for _, r := range m.List.Items {
+		if r.Replicas == nil {
+			continue
+		}
+		total++
+		if r.Replicas.Running >= r.Replicas.Desired {
+			running++
+		}
+	}
+	return running, total
+}
+
+func (m *Model) View() string {
+	title := "Deploy: " + m.req.Stack
+	if m.req.Remove != nil {
+		title = "Remove: " + m.req.Stack
+	}
+	switch {
+	case !m.finished:
+		title += " — " + m.verb() + "…"
+	case m.err != nil:
+		title += " — failed"
+	case m.req.Remove != nil:
+		title += " — removed"
+	default:
+		ok, total := m.converged()
+		title += fmt.Sprintf(" — deployed (%d of %d services converged)", ok, total)
+	}
+
+	width := m.List.Viewport.Width
+	if width <= 0 {
+		if m.width > 0 {
+			width = m.width
+		} else {
+			width = 80
+		}
+	}
+	m.columns.Compute(width, m.List.Items)
+	header := m.summary(width) + "\n\n" + ui.FrameHeaderStyle.Render(m.columns.Header(nil))
+	m.List.RenderItem = func(r *Row, selected bool, _ int) string {
+		return m.columns.Row(r, selected)
+	}
+
+	status := fmt.Sprintf("Object %d of %d", m.List.Cursor+1, len(m.List.Filtered))
+	if r, ok := m.SelectedRow(); ok && detail(r) != "" {
+		status = r.Name + ": " + detail(r)
+	}
+	footer := ui.StatusBarStyle.Render(status)
+	if m.List.Mode == filterlist.ModeSearching {
+		footer += "\n" + ui.StatusBarStyle.Render("Filter (type then Enter): "+m.List.Query)
+	} else if m.List.Query != "" {
+		footer += "\n" + ui.StatusBarStyle.Render("Filter: "+m.List.Query)
+	}
+
+	frame := ui.ComputeFrameDimensions(
+		m.List.Viewport.Width,
+		m.List.Viewport.Height,
+		m.width,
+		m.height,
+		header,
+		footer,
+	)
+	if frame.DesiredContentLines < 1 {
+		frame.DesiredContentLines = 1
+	}
+
+	var content string
+	switch {
+	case !m.started && m.req.Remove == nil:
+		content = "Loading compose file..."
+	case len(m.List.Items) == 0 && m.finished:
+		content = "Nothing to do."
+	case len(m.List.Items) == 0:
+		content = "Working..."
+	default:
+		content = m.List.VisibleContent(frame.DesiredContentLines)
+	}
+
+	return ui.RenderFramedBoxHeight(title, header, content, footer, frame.FrameWidth, frame.FrameHeight)
+}
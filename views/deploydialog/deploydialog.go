@@ -0,0 +1,270 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package deploydialog asks for the compose file, stack name and options of
+// a stack deploy, or for the file and stack to compare.
+package deploydialog
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"swarmcli/docker"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ResultMsg is sent when the dialog closes; File and Stack are only set
+// when confirmed. Compare is set when the dialog was opened to compare.
+type ResultMsg struct {
+	Confirmed bool
+	Compare   bool
+	File      string
+	Stack     string
+	Options   docker.StackDeployOptions
+}
+
+const (
+	focusFile = iota
+	focusStack
+	focusPrune
+	focusRegistryAuth
+	focusCount
+)
+
+const (
+	labelWidth = 14
+	maxWidth   = 100
+)
+
+type Model struct {
+	Visible bool
+	Width   int
+	Height  int
+
+	file    textinput.Model
+	stack   textinput.Model
+	options docker.StackDeployOptions
+	focus   int
+	err     string
+	// compare asks for the file and stack only.
+	compare bool
+}
+
+func New(width, height int) *Model {
+	m := &Model{Width: width, Height: height}
+	m.file = newInput("docker-compose.yml")
+	m.stack = newInput("default: the project or directory name")
+	return m
+}
+
+func newInput(placeholder string) textinput.Model {
+	in := textinput.New()
+	in.Prompt = ""
+	in.Placeholder = placeholder
+	in.CharLimit = 4096
+	return in
+}
+
+func (m *Model) contentWidth() int {
+	return min(max(m.Width-8, 60), maxWidth)
+}
+
+// SetSize adapts the inputs to the terminal size.
+func (m *Model) SetSize(width, height int) {
+	m.Width, m.Height = width, height
+	m.file.Width = m.contentWidth() - labelWidth - 6
+	m.stack.Width = m.file.Width
+}
+
+func (m *Model) Init() tea.Cmd { return nil }
+
+// Show opens the dialog for a stack, with the file last deployed.
+func (m *Model) Show(file, stack string) *Model {
+	m.compare = false
+	return m.show(file, stack)
+}
+
+// ShowCompare opens the dialog to compare a file with a stack.
+func (m *Model) ShowCompare(file, stack string) *Model {
+	m.compare = true
+	return m.show(file, stack)
+}
+
+func (m *Model) show(file, stack string) *Model {
+	m.Visible = true
+	m.err = ""
+	m.options = docker.StackDeployOptions{}
+	m.file.SetValue(file)
+	m.file.CursorEnd()
+	m.stack.SetValue(stack)
+	m.stack.CursorEnd()
+	m.setFocus(focusFile)
+	m.SetSize(m.Width, m.Height)
+	return m
+}
+
+func (m *Model) Hide() *Model {
+	m.Visible = false
+	return m
+}
+
+func (m *Model) setFocus(focus int) {
+	count := focusCount
+	if m.compare {
+		count = focusPrune
+	}
+	m.focus = (focus + count) % count
+	m.file.Blur()
+	m.stack.Blur()
+	switch m.focus {
+	case focusFile:
+		m.file.Focus()
+	case focusStack:
+		m.stack.Focus()
+	}
+}
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	if !m.Visible {
+		return nil
+	}
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+	switch key.String() {
+	case "esc":
+		m.Visible = false
+		result := ResultMsg{Compare: m.compare}
+		return func() tea.Msg { return result }
+	case "tab", "down":
+		m.setFocus(m.focus + 1)
+		return nil
+	case "shift+tab", "up":
+		m.setFocus(m.focus - 1)
+		return nil
+	case "enter":
+		return m.submit()
+	case " ":
+		switch m.focus {
+		case focusPrune:
+			m.options.Prune = !m.options.Prune
+			return nil
+		case focusRegistryAuth:
+			m.options.WithRegistryAuth = !m.options.WithRegistryAuth
+			return nil
+		}
+	}
+
+	var cmd tea.Cmd
+	switch m.focus {
+	case focusFile:
+		m.file, cmd = m.file.Update(msg)
+	case focusStack:
+		m.stack, cmd = m.stack.Update(msg)
+	}
+	return cmd
+}
+
+func (m *Model) submit() tea.Cmd {
+	file := strings.TrimSpace(m.file.Value())
+	if file == "" {
+		m.err = "a compose file is required"
+		m.setFocus(focusFile)
+		return nil
+	}
+	if st, err := os.Stat(file); err != nil || st.IsDir() {
+		m.err = fmt.Sprintf("no such file: %s", file)
+		m.setFocus(focusFile)
+		return nil
+	}
+	m.Visible = false
+	result := ResultMsg{Confirmed: true, Compare: m.compare, File: file, Stack: strings.TrimSpace(m.stack.Value()), Options: m.options}
+	return func() tea.Msg { return result }
+}
+
+func (m *Model) View() string {
+	if !m.Visible {
+		return ""
+	}
+	contentWidth := m.contentWidth()
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("15")).
+		Background(lipgloss.Color("63")).
+		Padding(0, 1).
+		Width(contentWidth)
+
+	labelStyle := lipgloss.NewStyle().Width(labelWidth).Foreground(lipgloss.Color("245"))
+	focusStyle := labelStyle.Foreground(lipgloss.Color("63")).Bold(true)
+	itemStyle := lipgloss.NewStyle().Padding(0, 2).Width(contentWidth)
+	errStyle := itemStyle.Foreground(lipgloss.Color("9"))
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Padding(0, 2).
+		Width(contentWidth)
+
+	keyStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("63")).
+		Bold(true)
+
+	borderStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("63")).
+		Width(contentWidth + 2)
+
+	label := func(i int, text string) string {
+		if i == m.focus {
+			return focusStyle.Render(text)
+		}
+		return labelStyle.Render(text)
+	}
+	checkbox := func(on bool, text string) string {
+		if on {
+			return "[x] " + text
+		}
+		return "[ ] " + text
+	}
+
+	title, action := " Deploy Stack ", "Deploy"
+	if m.compare {
+		title, action = " Compare Stack ", "Compare"
+	}
+
+	var lines []string
+	lines = append(lines, titleStyle.Render(title))
+	lines = append(lines, "")
+	lines = append(lines, itemStyle.Render(label(focusFile, "Compose file")+m.file.View()))
+	lines = append(lines, itemStyle.Render(label(focusStack, "Stack")+m.stack.View()))
+	if !m.compare {
+		lines = append(lines, itemStyle.Render(label(focusPrune, "Prune")+
+			checkbox(m.options.Prune, "remove services no longer in the file")))
+		lines = append(lines, itemStyle.Render(label(focusRegistryAuth, "Registry auth")+
+			checkbox(m.options.WithRegistryAuth, "send registry logins to the swarm agents")))
+	}
+	lines = append(lines, "")
+	if m.err != "" {
+		lines = append(lines, errStyle.Render(m.err))
+	}
+
+	help := fmt.Sprintf("%s Next/previous field • %s Toggle • %s %s • %s Cancel",
+		keyStyle.Render("<Tab/Shift+Tab>"),
+		keyStyle.Render("<Space>"),
+		keyStyle.Render("<Enter>"), action,
+		keyStyle.Render("<Esc>"))
+	if m.compare {
+		help = fmt.Sprintf("%s Next/previous field • %s %s • %s Cancel",
+			keyStyle.Render("<Tab/Shift+Tab>"),
+			keyStyle.Render("<Enter>"), action,
+			keyStyle.Render("<Esc>"))
+	}
+	lines = append(lines, helpStyle.Render(help))
+
+	return borderStyle.Render(strings.Join(lines, "\n"))
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package diffview
+
+import (
+	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	changedColor = lipgloss.Color("214")
+	missingColor = lipgloss.Color("196")
+	extraColor   = lipgloss.Color("75")
+	unknownColor = lipgloss.Color("245")
+)
+
+func stateColor(d docker.StackDrift) lipgloss.Color {
+	switch d.State {
+	case docker.DriftMissing:
+		return missingColor
+	case docker.DriftExtra:
+		return extraColor
+	case docker.DriftUnknown:
+		return unknownColor
+	}
+	return changedColor
+}
+
+// diffColumns are the columns of the diff table.
+var diffColumns = []columns.Column[docker.StackDrift]{
+	{Name: "kind", Title: "KIND", Min: 7, Value: func(d docker.StackDrift) string { return d.Kind }},
+	{Name: "name", Title: "NAME", Min: 12, Fit: true, Value: func(d docker.StackDrift) string { return d.Name }},
+	{Name: "state", Title: "STATE", Min: 7, Value: func(d docker.StackDrift) string { return d.State }, Color: stateColor},
+	{Name: "field", Title: "FIELD", Min: 10, Fit: true, Value: func(d docker.StackDrift) string { return d.Field }},
+	{Name: "file", Title: "FILE", Min: 12, Fit: true, Value: func(d docker.StackDrift) string { return d.File }},
+	{Name: "live", Title: "LIVE", Min: 12, Fit: true, Value: func(d docker.StackDrift) string { return d.Live }},
+}
+
+func newDiffLayout() *columns.Layout[docker.StackDrift] {
+	layout, err := columns.NewLayout(ViewName, diffColumns, nil)
+	if err != nil {
+		l().Warnf("Failed to load column layout: %v", err)
+	}
+	layout.SelectedBg = lipgloss.Color("63")
+	return layout
+}
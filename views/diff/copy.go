@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package diffview
+
+import (
+	"swarmcli/core/clipboard"
+	"swarmcli/docker"
+)
+
+// CopyText returns the name of the object under the cursor; alt copies the
+// difference.
+func (m *Model) CopyText(alt bool) (string, string) {
+	items := clipboard.Selection(nil, m.List.Filtered, m.List.Cursor)
+	if alt {
+		return clipboard.Describe(len(items), "difference"), clipboard.Join(items, describe)
+	}
+	return clipboard.Describe(len(items), "name"), clipboard.Join(items, func(d docker.StackDrift) string { return d.Name })
+}
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package diffview compares a compose file with the stack deployed from
+// it: one row per field changed by hand, per object missing on either side,
+// and per config or secret whose content differs.
+package diffview
+
+import swarmlog "swarmcli/utils/log"
+
+const ViewName = "diff"
+
+func l() *swarmlog.SwarmLogger {
+	return swarmlog.L().With("view", "diff")
+}
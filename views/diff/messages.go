@@ -0,0 +1,55 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package diffview
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"swarmcli/core/compose"
+	"swarmcli/docker"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Request is the payload of the view: the compose file and the stack it
+// is compared with; the stack defaults to the name of the file's project,
+// or of its directory, like for a deploy.
+type Request struct {
+	File  string
+	Stack string
+}
+
+// ResultMsg carries the differences found; Err is set when the file could
+// not be loaded or the stack listed.
+type ResultMsg struct {
+	Stack    string
+	Warnings []string
+	Drifts   docker.StackDrifts
+	Err      error
+}
+
+// CompareCmd loads the compose file and compares it with the stack.
+func CompareCmd(req Request) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		if req.File == "" {
+			return ResultMsg{Stack: req.Stack, Err: fmt.Errorf("no compose file given, usage: diff <compose.yml> [stack]")}
+		}
+		p, err := compose.Load(req.File)
+		if err != nil {
+			return ResultMsg{Stack: req.Stack, Err: err}
+		}
+		if req.Stack == "" {
+			req.Stack = p.Name
+		}
+		if req.Stack == "" {
+			req.Stack = filepath.Base(p.Dir)
+		}
+		ctx, cancel := context.WithTimeout(docker.WithContextName(context.Background(), ctxName), 30*time.Second)
+		defer cancel()
+		drifts, err := docker.DiffStack(ctx, p, req.Stack)
+		return ResultMsg{Stack: req.Stack, Warnings: p.Warnings, Drifts: drifts, Err: err}
+	})
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package diffview
+
+import (
+	"swarmcli/core/primitives/fuzzy"
+	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
+	"swarmcli/views/helpbar"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+
+	filterlist "swarmcli/ui/components/filterable/list"
+)
+
+type Model struct {
+	List    filterlist.FilterableList[docker.StackDrift]
+	columns *columns.Layout[docker.StackDrift]
+	width   int
+	height  int
+
+	req      Request
+	warnings []string
+	compared int
+	loading  bool
+	err      error
+}
+
+func New(width, height int, req Request) *Model {
+	vp := viewport.New(width, height)
+	list := filterlist.FilterableList[docker.StackDrift]{
+		Viewport: vp,
+		Score: func(d docker.StackDrift, query string) (int, bool) {
+			return fuzzy.Score(query, d.Name+" "+d.Field)
+		},
+	}
+	return &Model{
+		List:    list,
+		columns: newDiffLayout(),
+		width:   width,
+		height:  height,
+		req:     req,
+		loading: true,
+	}
+}
+
+func (m *Model) Init() tea.Cmd { return nil }
+
+func (m *Model) Name() string { return ViewName }
+
+func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
+	return []helpbar.HelpEntry{
+		{Key: "r", Desc: "Compare again"},
+		{Key: "↑/↓", Desc: "Navigate"},
+		{Key: "/", Desc: "Filter"},
+		{Key: "?", Desc: "Help"},
+		{Key: "q", Desc: "Close"},
+	}
+}
+
+func (m *Model) OnEnter() tea.Cmd { return nil }
+func (m *Model) OnExit() tea.Cmd  { return nil }
+
+// SelectedRow returns the difference under the cursor.
+func (m *Model) SelectedRow() (docker.StackDrift, bool) {
+	if m.List.Cursor < 0 || m.List.Cursor >= len(m.List.Filtered) {
+		return docker.StackDrift{}, false
+	}
+	return m.List.Filtered[m.List.Cursor], true
+}
+
+// describe spells out a difference, values included.
+func describe(d docker.StackDrift) string {
+	switch d.State {
+	case docker.DriftMissing:
+		return d.Kind + " " + d.Name + " is in the file but not deployed"
+	case docker.DriftExtra:
+		return d.Kind + " " + d.Name + " is deployed but not in the file"
+	}
+	return d.Name + " " + d.Field + ": " + orNone(d.File) + " in the file, " + orNone(d.Live) + " deployed"
+}
+
+func orNone(v string) string {
+	if v == "" {
+		return "(none)"
+	}
+	return v
+}
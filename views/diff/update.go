@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package diffview
+
+import (
+	filterlist "swarmcli/ui/components/filterable/list"
+	helpview "swarmcli/views/help"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case ResultMsg:
+		m.loading = false
+		m.req.Stack = msg.Stack
+		m.warnings = msg.Warnings
+		m.err = msg.Err
+		if msg.Err != nil {
+			l().Errorf("comparing %s with stack %s: %v", m.req.File, msg.Stack, msg.Err)
+			return nil
+		}
+		m.compared = msg.Drifts.Compared
+		m.List.Items = msg.Drifts.Drifts
+		m.refilter()
+		return nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.List.Viewport.Width = msg.Width
+		m.List.Viewport.Height = msg.Height
+		return nil
+
+	case tea.KeyMsg:
+		if m.List.Mode == filterlist.ModeSearching {
+			m.List.HandleKey(msg)
+			return nil
+		}
+		if msg.Type == tea.KeyEsc && m.List.Query != "" {
+			m.List.Query = ""
+			m.List.ApplyFilter()
+			m.List.Cursor = 0
+			return nil
+		}
+
+		m.List.HandleKey(msg)
+
+		switch msg.String() {
+		case "?":
+			return func() tea.Msg {
+				return view.NavigateToMsg{
+					ViewName: view.NameHelp,
+					Payload:  GetDiffHelpContent(),
+				}
+			}
+		case "r":
+			if m.loading {
+				return nil
+			}
+			// The file is read again too, to check a fix made to it
+			m.loading = true
+			return CompareCmd(m.req)
+		}
+		return nil
+	}
+	return nil
+}
+
+func (m *Model) refilter() {
+	cursor := m.List.Cursor
+	if m.List.Query != "" {
+		m.List.ApplyFilter()
+	} else {
+		m.List.Filtered = m.List.Items
+	}
+	m.List.Cursor = min(cursor, max(len(m.List.Filtered)-1, 0))
+}
+
+// GetDiffHelpContent returns categorized help for the diff view
+func GetDiffHelpContent() []helpview.HelpCategory {
+	return []helpview.HelpCategory{
+		{
+			Title: "General",
+			Items: []helpview.HelpItem{
+				{Keys: "<r>", Description: "Compare again, reading the file again"},
+				{Keys: "</>", Description: "Filter by name or field"},
+			},
+		},
+		{
+			Title: "Navigation",
+			Items: []helpview.HelpItem{
+				{Keys: "<↑/↓>", Description: "Navigate"},
+				{Keys: "<pgup>", Description: "Page up"},
+				{Keys: "<pgdown>", Description: "Page down"},
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
+				{Keys: "<q>", Description: "Close"},
+			},
+		},
+	}
+}
@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package diffview
+
+import (
+	"fmt"
+	"strings"
+	"swarmcli/docker"
+	"swarmcli/ui"
+	filterlist "swarmcli/ui/components/filterable/list"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	labelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("8")).Bold(true)
+	warnStyle  = lipgloss.NewStyle().Foreground(changedColor)
+	errStyle   = lipgloss.NewStyle().Foreground(missingColor)
+)
+
+// summary is the block above the table: the file, what loading it
+// reported, and the differences by state.
+func (m *Model) summary(width int) string {
+	wrap := lipgloss.NewStyle().Width(width)
+	lines := []string{wrap.Render(labelStyle.Render("File: ") + m.req.File)}
+	for _, w := range m.warnings {
+		lines = append(lines, wrap.Render(labelStyle.Render("Warning: ")+warnStyle.Render(w)))
+	}
+	if m.err != nil {
+		lines = append(lines, wrap.Render(labelStyle.Render("Error: ")+errStyle.Render(m.err.Error())))
+	} else if len(m.List.Items) > 0 {
+		counts := map[string]int{}
+		for _, d := range m.List.Items {
+			counts[d.State]++
+		}
+		var parts []string
+		for _, state := range []string{docker.DriftChanged, docker.DriftMissing, docker.DriftExtra, docker.DriftUnknown} {
+			if counts[state] > 0 {
+				parts = append(parts, fmt.Sprintf("%d %s", counts[state], state))
+			}
+		}
+		lines = append(lines, wrap.Render(labelStyle.Render("Drift: ")+strings.Join(parts, ", ")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m *Model) View() string {
+	title := "Diff: " + m.req.Stack
+	switch {
+	case m.loading:
+		title += " — comparing…"
+	case m.err != nil:
+		title += " — failed"
+	case len(m.List.Items) == 0:
+		title += fmt.Sprintf(" — in sync (%d objects)", m.compared)
+	default:
+		title += fmt.Sprintf(" — %d differences", len(m.List.Items))
+	}
+
+	width := m.List.Viewport.Width
+	if width <= 0 {
+		if m.width > 0 {
+			width = m.width
+		} else {
+			width = 80
+		}
+	}
+	m.columns.Compute(width, m.List.Items)
+	header := m.summary(width) + "\n\n" + ui.FrameHeaderStyle.Render(m.columns.Header(nil))
+	m.List.RenderItem = func(d docker.StackDrift, selected bool, _ int) string {
+		return m.columns.Row(d, selected)
+	}
+
+	status := "No differences"
+	if d, ok := m.SelectedRow(); ok {
+		status = describe(d)
+	}
+	footer := ui.StatusBarStyle.Render(status)
+	if m.List.Mode == filterlist.ModeSearching {
+		footer += "\n" + ui.StatusBarStyle.Render("Filter (type then Enter): "+m.List.Query)
+	} else if m.List.Query != "" {
+		footer += "\n" + ui.StatusBarStyle.Render("Filter: "+m.List.Query)
+	}
+
+	frame := ui.ComputeFrameDimensions(
+		m.List.Viewport.Width,
+		m.List.Viewport.Height,
+		m.width,
+		m.height,
+		header,
+		footer,
+	)
+	if frame.DesiredContentLines < 1 {
+		frame.DesiredContentLines = 1
+	}
+
+	var content string
+	switch {
+	case m.loading && len(m.List.Items) == 0:
+		content = "Comparing..."
+	case m.err != nil:
+		content = "Could not compare."
+	case len(m.List.Items) == 0:
+		content = "The stack matches the compose file."
+	default:
+		content = m.List.VisibleContent(frame.DesiredContentLines)
+	}
+
+	return ui.RenderFramedBoxHeight(title, header, content, footer, frame.FrameWidth, frame.FrameHeight)
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package explainview
+
+import (
+	"strings"
+	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	okColor  = lipgloss.Color("42")
+	errColor = lipgloss.Color("196")
+)
+
+func result(p docker.NodePlacement) string {
+	if p.Eligible() {
+		return "eligible"
+	}
+	return "rejected"
+}
+
+// placementColumns are the columns of the explain table.
+var placementColumns = []columns.Column[docker.NodePlacement]{
+	{Name: "node", Title: "NODE", Min: 10, Value: func(p docker.NodePlacement) string { return p.Hostname }},
+	{Name: "result", Title: "RESULT", Min: 8, Value: result,
+		Color: func(p docker.NodePlacement) lipgloss.Color {
+			if p.Eligible() {
+				return okColor
+			}
+			return errColor
+		}},
+	{Name: "reasons", Title: "REASONS", Min: 20, Fit: true, Value: func(p docker.NodePlacement) string {
+		return strings.Join(p.Reasons, "; ")
+	}},
+	{Name: "spread", Title: "SPREAD", Min: 10, Hidden: true, Value: func(p docker.NodePlacement) string { return p.Spread }},
+	{Name: "id", Title: "ID", Min: 12, Hidden: true, Value: func(p docker.NodePlacement) string { return p.NodeID }},
+}
+
+func newPlacementLayout() *columns.Layout[docker.NodePlacement] {
+	layout, err := columns.NewLayout(ViewName, placementColumns, nil)
+	if err != nil {
+		l().Warnf("Failed to load column layout: %v", err)
+	}
+	layout.SelectedBg = lipgloss.Color("63")
+	return layout
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package explainview
+
+import (
+	"strings"
+	"swarmcli/core/clipboard"
+	"swarmcli/docker"
+)
+
+// CopyText returns the hostname and reasons of the node under the cursor;
+// alt copies its node ID.
+func (m *Model) CopyText(alt bool) (string, string) {
+	items := clipboard.Selection(nil, m.List.Filtered, m.List.Cursor)
+	if alt {
+		return clipboard.Describe(len(items), "node ID"), clipboard.Join(items, func(p docker.NodePlacement) string { return p.NodeID })
+	}
+	return clipboard.Describe(len(items), "placement"), clipboard.Join(items, func(p docker.NodePlacement) string {
+		if p.Eligible() {
+			return p.Hostname + ": eligible"
+		}
+		return p.Hostname + ": " + strings.Join(p.Reasons, "; ")
+	})
+}
@@ -0,0 +1,14 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package explainview tells why a service's tasks stay pending: one row per
+// node with the placement filters it fails.
+package explainview
+
+import swarmlog "swarmcli/utils/log"
+
+const ViewName = "explain"
+
+func l() *swarmlog.SwarmLogger {
+	return swarmlog.L().With("view", "explain")
+}
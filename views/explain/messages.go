@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package explainview
+
+import (
+	"fmt"
+	"swarmcli/docker"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// RefreshInterval is how often the view re-evaluates the nodes, so it shows
+// when a pending task finally finds one.
+const RefreshInterval = 5 * time.Second
+
+// Target is the payload of the view: a service ID or name, and optionally
+// the (possibly shortened) ID of the task to explain.
+type Target struct {
+	Service string
+	TaskID  string
+}
+
+type Msg struct {
+	Report docker.PlacementReport
+	Err    error
+}
+
+type TickMsg time.Time
+
+func tickCmd() tea.Cmd {
+	return tea.Tick(RefreshInterval, func(t time.Time) tea.Msg {
+		return TickMsg(t)
+	})
+}
+
+// LoadCmd refreshes the snapshot and replays the placement filters of the
+// target's service on every node.
+func LoadCmd(target Target) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		if target.Service == "" {
+			return Msg{Err: fmt.Errorf("no service given, usage: explain <service>")}
+		}
+		snap, err := docker.RefreshSnapshotFor(ctxName)
+		if err != nil {
+			return Msg{Err: err}
+		}
+		svc := snap.FindService(target.Service)
+		if svc == nil {
+			svc = snap.FindServiceByName(target.Service)
+		}
+		if svc == nil {
+			return Msg{Err: fmt.Errorf("no such service: %s", target.Service)}
+		}
+		report, err := docker.ExplainPlacement(snap, svc.ID, target.TaskID)
+		return Msg{Report: report, Err: err}
+	})
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package explainview
+
+import (
+	"swarmcli/core/primitives/fuzzy"
+	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
+	"swarmcli/views/helpbar"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+
+	filterlist "swarmcli/ui/components/filterable/list"
+)
+
+type Model struct {
+	List    filterlist.FilterableList[docker.NodePlacement]
+	columns *columns.Layout[docker.NodePlacement]
+	width   int
+	height  int
+
+	target Target
+	report docker.PlacementReport
+	err    error
+
+	loaded      bool
+	loading     bool
+	active      bool
+	tickPending bool
+}
+
+func New(width, height int, target Target) *Model {
+	vp := viewport.New(width, height)
+	list := filterlist.FilterableList[docker.NodePlacement]{
+		Viewport: vp,
+		Score: func(p docker.NodePlacement, query string) (int, bool) {
+			return fuzzy.Score(query, p.Hostname)
+		},
+	}
+	return &Model{
+		List:    list,
+		columns: newPlacementLayout(),
+		width:   width,
+		height:  height,
+		target:  target,
+	}
+}
+
+func (m *Model) Init() tea.Cmd { return nil }
+
+func (m *Model) Name() string { return ViewName }
+
+func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
+	return []helpbar.HelpEntry{
+		{Key: "r", Desc: "Refresh"},
+		{Key: "↑/↓", Desc: "Navigate"},
+		{Key: "/", Desc: "Filter"},
+		{Key: "?", Desc: "Help"},
+		{Key: "q", Desc: "Close"},
+	}
+}
+
+// OnEnter re-evaluates the nodes and resumes polling.
+func (m *Model) OnEnter() tea.Cmd {
+	m.active = true
+	return m.load()
+}
+
+// OnExit stops polling.
+func (m *Model) OnExit() tea.Cmd {
+	m.active = false
+	return nil
+}
+
+func (m *Model) load() tea.Cmd {
+	if m.loading {
+		return nil
+	}
+	m.loading = true
+	return LoadCmd(m.target)
+}
+
+// IsSearching reports whether the list is currently in search mode.
+func (m *Model) IsSearching() bool {
+	return m.List.Mode == filterlist.ModeSearching
+}
+
+func (m *Model) HasActiveFilter() bool {
+	return m.List.Query != ""
+}
+
+// SelectedNode returns the node under the cursor.
+func (m *Model) SelectedNode() (docker.NodePlacement, bool) {
+	if m.List.Cursor < 0 || m.List.Cursor >= len(m.List.Filtered) {
+		return docker.NodePlacement{}, false
+	}
+	return m.List.Filtered[m.List.Cursor], true
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package explainview
+
+import (
+	"swarmcli/docker"
+	filterlist "swarmcli/ui/components/filterable/list"
+	helpview "swarmcli/views/help"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case Msg:
+		m.loading = false
+		m.loaded = true
+		m.err = msg.Err
+		if msg.Err != nil {
+			l().Errorf("explaining placement of %s: %v", m.target.Service, msg.Err)
+		} else {
+			m.setReport(msg.Report)
+		}
+		if m.active && !m.tickPending {
+			m.tickPending = true
+			return tickCmd()
+		}
+		return nil
+
+	case TickMsg:
+		m.tickPending = false
+		if m.active {
+			return m.load()
+		}
+		return nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.List.Viewport.Width = msg.Width
+		m.List.Viewport.Height = msg.Height
+		return nil
+
+	case tea.KeyMsg:
+		if m.List.Mode == filterlist.ModeSearching {
+			m.List.HandleKey(msg)
+			return nil
+		}
+		if msg.Type == tea.KeyEsc && m.List.Query != "" {
+			m.List.Query = ""
+			m.List.ApplyFilter()
+			m.List.Cursor = 0
+			return nil
+		}
+
+		m.List.HandleKey(msg)
+
+		switch msg.String() {
+		case "?":
+			return func() tea.Msg {
+				return view.NavigateToMsg{
+					ViewName: view.NameHelp,
+					Payload:  GetExplainHelpContent(),
+				}
+			}
+		case "r":
+			return m.load()
+		}
+		return nil
+	}
+	return nil
+}
+
+func (m *Model) setReport(report docker.PlacementReport) {
+	cursor := m.List.Cursor
+	m.report = report
+	m.List.Items = report.Nodes
+	if m.List.Query != "" {
+		m.List.ApplyFilter()
+	} else {
+		m.List.Filtered = report.Nodes
+	}
+	m.List.Cursor = min(cursor, max(len(m.List.Filtered)-1, 0))
+}
+
+// GetExplainHelpContent returns categorized help for the explain view
+func GetExplainHelpContent() []helpview.HelpCategory {
+	return []helpview.HelpCategory{
+		{
+			Title: "General",
+			Items: []helpview.HelpItem{
+				{Keys: "<r>", Description: "Re-evaluate now"},
+				{Keys: "</>", Description: "Filter nodes"},
+			},
+		},
+		{
+			Title: "Navigation",
+			Items: []helpview.HelpItem{
+				{Keys: "<↑/↓>", Description: "Navigate"},
+				{Keys: "<pgup>", Description: "Page up"},
+				{Keys: "<pgdown>", Description: "Page down"},
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
+				{Keys: "<q>", Description: "Close"},
+			},
+		},
+	}
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package explainview
+
+import (
+	"fmt"
+	"strings"
+	"swarmcli/docker"
+	"swarmcli/ui"
+	filterlist "swarmcli/ui/components/filterable/list"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	labelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("8")).Bold(true)
+	errStyle   = lipgloss.NewStyle().Foreground(errColor)
+)
+
+// summary is the block above the table: what the service asks for and what
+// the scheduler said about its pending tasks, wrapped to the frame.
+func (m *Model) summary(width int) string {
+	wrap := lipgloss.NewStyle().Width(width)
+	requires := "nothing beyond a ready, active node"
+	if len(m.report.Requirements) > 0 {
+		requires = strings.Join(m.report.Requirements, "; ")
+	}
+	lines := []string{wrap.Render(labelStyle.Render("Requires: ") + requires)}
+	for _, e := range m.report.Errors {
+		lines = append(lines, wrap.Render(labelStyle.Render("Scheduler: ")+errStyle.Render(e)))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (m *Model) View() string {
+	title := "Explain: " + m.target.Service
+	if m.report.Service != "" {
+		title = fmt.Sprintf("Explain: %s (%d of %d nodes eligible, %d pending)",
+			m.report.Service, m.report.Eligible(), len(m.report.Nodes), m.report.Pending)
+	}
+	if m.loading {
+		title += " — refreshing…"
+	}
+
+	width := m.List.Viewport.Width
+	if width <= 0 {
+		if m.width > 0 {
+			width = m.width
+		} else {
+			width = 80
+		}
+	}
+	m.columns.Compute(width, m.List.Items)
+	header := ui.FrameHeaderStyle.Render(m.columns.Header(nil))
+	if m.loaded && m.err == nil {
+		header = m.summary(width) + "\n\n" + header
+	}
+	m.List.RenderItem = func(p docker.NodePlacement, selected bool, _ int) string {
+		return m.columns.Row(p, selected)
+	}
+
+	status := fmt.Sprintf("Node %d of %d", m.List.Cursor+1, len(m.List.Filtered))
+	if p, ok := m.SelectedNode(); ok && !p.Eligible() {
+		status = p.Hostname + ": " + strings.Join(p.Reasons, "; ")
+	}
+	footer := ui.StatusBarStyle.Render(status)
+	if m.List.Mode == filterlist.ModeSearching {
+		footer += "\n" + ui.StatusBarStyle.Render("Filter (type then Enter): "+m.List.Query)
+	} else if m.List.Query != "" {
+		footer += "\n" + ui.StatusBarStyle.Render("Filter: "+m.List.Query)
+	}
+
+	frame := ui.ComputeFrameDimensions(
+		m.List.Viewport.Width,
+		m.List.Viewport.Height,
+		m.width,
+		m.height,
+		header,
+		footer,
+	)
+	if frame.DesiredContentLines < 1 {
+		frame.DesiredContentLines = 1
+	}
+
+	var content string
+	switch {
+	case m.err != nil:
+		content = fmt.Sprintf("Error: %v", m.err)
+	case !m.loaded:
+		content = "Evaluating nodes..."
+	case len(m.List.Items) == 0:
+		content = "No nodes in the swarm."
+	default:
+		content = m.List.VisibleContent(frame.DesiredContentLines)
+	}
+
+	return ui.RenderFramedBoxHeight(title, header, content, footer, frame.FrameWidth, frame.FrameHeight)
+}
@@ -0,0 +1,10 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package graphview
+
+// CopyText returns the whole graph as plain text, independent of the
+// scroll position.
+func (m *Model) CopyText(alt bool) (string, string) {
+	return "stack graph", render(m.graph, false)
+}
@@ -0,0 +1,25 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package graphview
+
+import (
+	"strconv"
+	"strings"
+	"swarmcli/core/export"
+)
+
+// ExportTable returns one row per edge of the graph: a service and a
+// resource it uses.
+func (m *Model) ExportTable() export.Table {
+	t := export.Table{Columns: []string{"SERVICE", "KIND", "RESOURCE", "EXTERNAL", "SHARED WITH"}}
+	for _, svc := range m.graph.Services {
+		for _, r := range svc.Resources {
+			res := m.graph.Resources[r]
+			t.Rows = append(t.Rows, []string{
+				svc.Name, res.Kind, res.Name, strconv.FormatBool(res.External), strings.Join(res.SharedWith, ", "),
+			})
+		}
+	}
+	return t
+}
@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package graphview draws what the services of a stack use: one lane per
+// service, joined to the networks, configs, secrets and volumes it uses,
+// with the resources other stacks use too highlighted.
+package graphview
+
+import swarmlog "swarmcli/utils/log"
+
+const ViewName = "graph"
+
+func l() *swarmlog.SwarmLogger {
+	return swarmlog.L().With("view", "graph")
+}
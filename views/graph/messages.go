@@ -0,0 +1,33 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package graphview
+
+import (
+	"context"
+	"fmt"
+	"swarmcli/docker"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// GraphMsg carries the graph of a stack; Err is set when it could not be
+// listed.
+type GraphMsg struct {
+	Graph docker.StackGraph
+	Err   error
+}
+
+// LoadCmd lists what the services of the stack use.
+func LoadCmd(stack string) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		if stack == "" {
+			return GraphMsg{Err: fmt.Errorf("no stack given, usage: graph <stack>")}
+		}
+		ctx, cancel := context.WithTimeout(docker.WithContextName(context.Background(), ctxName), 30*time.Second)
+		defer cancel()
+		g, err := docker.LoadStackGraph(ctx, stack)
+		return GraphMsg{Graph: g, Err: err}
+	})
+}
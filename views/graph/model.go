@@ -0,0 +1,51 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package graphview
+
+import (
+	"swarmcli/docker"
+	"swarmcli/views/helpbar"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Model struct {
+	viewport viewport.Model
+	width    int
+	height   int
+
+	stack   string
+	graph   docker.StackGraph
+	loading bool
+	err     error
+}
+
+func New(width, height int, stack string) *Model {
+	vp := viewport.New(width, height)
+	vp.SetContent("")
+	return &Model{
+		viewport: vp,
+		width:    width,
+		height:   height,
+		stack:    stack,
+		loading:  true,
+	}
+}
+
+func (m *Model) Init() tea.Cmd { return nil }
+
+func (m *Model) Name() string { return ViewName }
+
+func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
+	return []helpbar.HelpEntry{
+		{Key: "r", Desc: "Reload"},
+		{Key: "j/k", Desc: "Down/up"},
+		{Key: "?", Desc: "Help"},
+		{Key: "q", Desc: "Close"},
+	}
+}
+
+func (m *Model) OnEnter() tea.Cmd { return nil }
+func (m *Model) OnExit() tea.Cmd  { return nil }
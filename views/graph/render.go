@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package graphview
+
+import (
+	"strings"
+	"swarmcli/docker"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// laneColors tell the lanes of the services apart.
+var laneColors = []lipgloss.Color{"39", "170", "214", "42", "75", "205", "141", "178", "81", "209"}
+
+var (
+	edgeStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	kindStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("8")).Bold(true)
+	portStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+	externalStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("244")).Italic(true)
+	sharedStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("208")).Bold(true)
+)
+
+// kindTitles head the groups of resources.
+var kindTitles = map[string]string{
+	docker.GraphNetwork: "networks",
+	docker.GraphConfig:  "configs",
+	docker.GraphSecret:  "secrets",
+	docker.GraphVolume:  "volumes",
+}
+
+// canvas builds the lines of the graph, styled or as plain text.
+type canvas struct {
+	styled bool
+	lines  []string
+	line   strings.Builder
+}
+
+func (c *canvas) draw(style lipgloss.Style, s string) {
+	if c.styled && s != "" && strings.TrimSpace(s) != "" {
+		s = style.Render(s)
+	}
+	c.line.WriteString(s)
+}
+
+func (c *canvas) newline() {
+	c.lines = append(c.lines, strings.TrimRight(c.line.String(), " "))
+	c.line.Reset()
+}
+
+// render draws one lane per service, top to bottom, and one row per
+// resource with an edge from every service using it:
+//
+//	● api  8080→80/tcp
+//	│  ● worker
+//	│  │  ● db
+//	│  │  │
+//	│  │  │    networks
+//	├──┼──┼─── backend
+//	├──│──│─── proxy  (external)  ⇄ shared with web
+//	│  │  │    secrets
+//	└──│──│─── api-key  (external)
+//	   └──┴─── db-password
+func render(g docker.StackGraph, styled bool) string {
+	n := len(g.Services)
+	lane := func(j int) lipgloss.Style {
+		return lipgloss.NewStyle().Foreground(laneColors[j%len(laneColors)])
+	}
+	// last is the last resource a lane reaches, -1 for none
+	last := make([]int, n)
+	users := make([]map[int]bool, len(g.Resources))
+	for r := range users {
+		users[r] = map[int]bool{}
+	}
+	for j, svc := range g.Services {
+		last[j] = -1
+		for _, r := range svc.Resources {
+			users[r][j] = true
+			last[j] = max(last[j], r)
+		}
+	}
+	active := func(j, r int) bool { return r <= last[j] }
+
+	c := &canvas{styled: styled}
+	// lanes draws the verticals of the lanes still reaching resource r.
+	lanes := func(upto, r int) {
+		for j := 0; j < upto; j++ {
+			if active(j, r) {
+				c.draw(lane(j), "│")
+			} else {
+				c.draw(edgeStyle, " ")
+			}
+			c.draw(edgeStyle, "  ")
+		}
+	}
+
+	prefix := g.Stack + "_"
+	for i, svc := range g.Services {
+		lanes(i, 0)
+		node := "●"
+		if last[i] < 0 {
+			node = "○"
+		}
+		c.draw(lane(i), node+" "+strings.TrimPrefix(svc.Name, prefix))
+		if len(svc.Ports) > 0 {
+			c.draw(portStyle, "  "+strings.Join(svc.Ports, ", "))
+		}
+		c.newline()
+	}
+	if len(g.Resources) == 0 {
+		return strings.Join(c.lines, "\n")
+	}
+	lanes(n, 0)
+	c.newline()
+
+	kind := ""
+	for r, res := range g.Resources {
+		if res.Kind != kind {
+			kind = res.Kind
+			lanes(n, r)
+			c.draw(kindStyle, "  "+kindTitles[kind])
+			c.newline()
+		}
+		first := n
+		for j := 0; j < n; j++ {
+			if users[r][j] {
+				first = j
+				break
+			}
+		}
+		lanes(first, r)
+		for j := first; j < n; j++ {
+			// Lanes the edge only passes keep their vertical, so it does
+			// not read as a junction
+			switch {
+			case users[r][j] && j == first && r == last[j]:
+				c.draw(lane(j), "└")
+			case users[r][j] && j == first:
+				c.draw(lane(j), "├")
+			case users[r][j] && r == last[j]:
+				c.draw(lane(j), "┴")
+			case users[r][j]:
+				c.draw(lane(j), "┼")
+			case active(j, r):
+				c.draw(lane(j), "│")
+			default:
+				c.draw(edgeStyle, "─")
+			}
+			c.draw(edgeStyle, "──")
+		}
+		c.draw(edgeStyle, "─ ")
+		if len(res.SharedWith) > 0 {
+			c.draw(sharedStyle, res.Name)
+		} else {
+			c.line.WriteString(res.Name)
+		}
+		if res.External {
+			c.draw(externalStyle, "  (external)")
+		}
+		if len(res.SharedWith) > 0 {
+			c.draw(sharedStyle, "  ⇄ shared with "+strings.Join(res.SharedWith, ", "))
+		}
+		c.newline()
+	}
+	return strings.Join(c.lines, "\n")
+}
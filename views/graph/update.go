@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package graphview
+
+import (
+	helpview "swarmcli/views/help"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case GraphMsg:
+		m.loading = false
+		m.err = msg.Err
+		if msg.Err != nil {
+			l().Errorf("loading graph of stack %s: %v", m.stack, msg.Err)
+			m.viewport.SetContent("")
+			return nil
+		}
+		m.graph = msg.Graph
+		m.viewport.SetContent(render(m.graph, true))
+		return nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.viewport.Width = msg.Width
+		m.viewport.Height = msg.Height
+		return nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			m.viewport.ScrollUp(1)
+		case "down", "j":
+			m.viewport.ScrollDown(1)
+		case "pgup":
+			m.viewport.ScrollUp(m.viewport.Height)
+		case "pgdown":
+			m.viewport.ScrollDown(m.viewport.Height)
+		case "?":
+			return func() tea.Msg {
+				return view.NavigateToMsg{
+					ViewName: view.NameHelp,
+					Payload:  GetGraphHelpContent(),
+				}
+			}
+		case "r":
+			if m.loading {
+				return nil
+			}
+			m.loading = true
+			return LoadCmd(m.stack)
+		}
+		return nil
+	}
+	return nil
+}
+
+// GetGraphHelpContent returns categorized help for the graph view
+func GetGraphHelpContent() []helpview.HelpCategory {
+	return []helpview.HelpCategory{
+		{
+			Title: "General",
+			Items: []helpview.HelpItem{
+				{Keys: "<r>", Description: "Reload the graph"},
+			},
+		},
+		{
+			Title: "Navigation",
+			Items: []helpview.HelpItem{
+				{Keys: "<↑/↓>", Description: "Scroll"},
+				{Keys: "<pgup>", Description: "Page up"},
+				{Keys: "<pgdown>", Description: "Page down"},
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
+				{Keys: "<q>", Description: "Close"},
+			},
+		},
+	}
+}
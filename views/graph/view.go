@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package graphview
+
+import (
+	"fmt"
+	"swarmcli/ui"
+)
+
+// legend explains the glyphs of the graph.
+const legend = "● service  ○ service using nothing  ├ uses  │ passes  ⇄ shared with other stacks"
+
+func (m *Model) View() string {
+	title := "Graph: " + m.stack
+	switch {
+	case m.loading:
+		title += " — loading…"
+	case m.err != nil:
+		title += " — failed"
+	default:
+		title += fmt.Sprintf(" — %d services, %d resources, %d shared",
+			len(m.graph.Services), len(m.graph.Resources), m.graph.Shared())
+	}
+
+	width := m.viewport.Width
+	if width <= 0 {
+		width = m.width
+	}
+	header := ui.FrameHeaderStyle.Render(legend)
+
+	frame := ui.ComputeFrameDimensions(
+		width,
+		m.viewport.Height,
+		m.width,
+		m.height,
+		header,
+		"",
+	)
+
+	var content string
+	switch {
+	case m.loading && len(m.graph.Services) == 0:
+		content = "Loading..."
+	case m.err != nil:
+		content = "Could not load the graph: " + m.err.Error()
+	default:
+		content = m.viewport.View()
+	}
+	content = ui.TrimOrPadContentToLines(content, frame.DesiredContentLines)
+
+	return ui.RenderFramedBox(title, header, content, "", frame.FrameWidth)
+}
@@ -0,0 +1,149 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package historypicker implements the breadcrumb picker: a dialog listing
+// the navigation history (views behind and ahead of the current one) that
+// jumps to the chosen view.
+package historypicker
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// OpenMsg asks the app to open the picker (sent by :history).
+type OpenMsg struct{}
+
+// JumpMsg moves through the history by Offset steps; negative goes back.
+type JumpMsg struct {
+	Offset int
+}
+
+type Model struct {
+	Visible bool
+	// Entries are the history labels, oldest first.
+	Entries []string
+	// Current is the index of the current view in Entries.
+	Current int
+
+	cursor int
+}
+
+func New() *Model {
+	return &Model{}
+}
+
+// Show opens the picker with the cursor on the view before the current one.
+func (m *Model) Show(entries []string, current int) {
+	m.Visible = true
+	m.Entries = entries
+	m.Current = current
+	m.cursor = max(current-1, 0)
+}
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok || !m.Visible {
+		return nil
+	}
+
+	switch key.String() {
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.Entries)-1 {
+			m.cursor++
+		}
+	case "home", "g":
+		m.cursor = 0
+	case "end", "G":
+		m.cursor = len(m.Entries) - 1
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		i := int(key.Runes[0] - '1')
+		if i < len(m.Entries) {
+			m.cursor = i
+			return m.jump()
+		}
+	case "enter":
+		return m.jump()
+	case "esc", "q":
+		m.Visible = false
+	}
+	return nil
+}
+
+func (m *Model) jump() tea.Cmd {
+	m.Visible = false
+	offset := m.cursor - m.Current
+	if offset == 0 {
+		return nil
+	}
+	return func() tea.Msg { return JumpMsg{Offset: offset} }
+}
+
+func (m *Model) View() string {
+	if !m.Visible {
+		return ""
+	}
+
+	contentWidth := 50
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("15")).
+		Background(lipgloss.Color("63")).
+		Padding(0, 1).
+		Width(contentWidth)
+
+	itemStyle := lipgloss.NewStyle().Padding(0, 2).Width(contentWidth)
+	selStyle := itemStyle.
+		Foreground(lipgloss.Color("230")).
+		Background(lipgloss.Color("25")).
+		Bold(true)
+	forwardStyle := itemStyle.Foreground(lipgloss.Color("8"))
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Padding(0, 2).
+		Width(contentWidth)
+
+	keyStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("63")).
+		Bold(true)
+
+	borderStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("63")).
+		Width(contentWidth + 2)
+
+	var lines []string
+	lines = append(lines, titleStyle.Render(" History "))
+	lines = append(lines, "")
+	for i, e := range m.Entries {
+		marker := " "
+		if i == m.Current {
+			marker = "●"
+		}
+		line := fmt.Sprintf("%s %d  %s", marker, i+1, e)
+		switch {
+		case i == m.cursor:
+			lines = append(lines, selStyle.Render(line))
+		case i > m.Current:
+			lines = append(lines, forwardStyle.Render(line))
+		default:
+			lines = append(lines, itemStyle.Render(line))
+		}
+	}
+	lines = append(lines, "")
+	lines = append(lines, helpStyle.Render(fmt.Sprintf("%s Jump • %s Select • %s Cancel",
+		keyStyle.Render("<Enter>"),
+		keyStyle.Render("<1-9>"),
+		keyStyle.Render("<Esc>"))))
+
+	return borderStyle.Render(strings.Join(lines, "\n"))
+}
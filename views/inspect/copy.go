@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package inspectview
+
+// CopyText returns the full inspect document as loaded, independent of the
+// current format, search term and scroll position.
+func (m *Model) CopyText(alt bool) (string, string) {
+	return "inspect JSON", m.RawContent
+}
+
+// IsSearching reports whether a search term is being typed, so the app
+// routes letter keys and ESC to the view.
+func (m *Model) IsSearching() bool {
+	return m.searchMode
+}
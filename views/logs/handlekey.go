@@ -71,6 +71,28 @@ func HandleKey(m *Model, k tea.KeyMsg) tea.Cmd {
 		return nil
 	}
 
+	// Line selection: movement keys extend the selection, y (handled by the
+	// app) copies it
+	if m.mode == "select" {
+		switch k.String() {
+		case "esc", "v":
+			m.stopSelection()
+		case "up", "k":
+			m.moveSelection(-1)
+		case "down", "j":
+			m.moveSelection(1)
+		case "pgup":
+			m.moveSelection(-m.viewport.Height)
+		case "pgdown":
+			m.moveSelection(m.viewport.Height)
+		case "home", "g":
+			m.moveSelection(-len(m.lines))
+		case "end", "G":
+			m.moveSelection(len(m.lines))
+		}
+		return nil
+	}
+
 	switch k.String() {
 	case "q":
 		m.Visible = false
@@ -195,6 +217,13 @@ func HandleKey(m *Model, k tea.KeyMsg) tea.Cmd {
 			}
 		}
 		return nil
+	case "v":
+		// Start selecting lines; in search mode 'v' is part of the query
+		if m.mode != "normal" {
+			break
+		}
+		m.startSelection()
+		return nil
 	case "o":
 		// Only handle 'o' as a command in normal mode
 		// In search mode, let it fall through to be captured as a rune
@@ -3,21 +3,49 @@
 
 package logsview
 
+import (
+	"context"
+	"swarmcli/docker"
+)
+
+// TaskTarget is the payload that opens the logs of one task of a service
+// instead of those of all its tasks.
+type TaskTarget struct {
+	Service docker.ServiceEntry
+	TaskID  string
+	// Task names the task in the title, e.g. "web.1".
+	Task string
+}
+
+// Stream messages carry the stream they belong to, so a view ignores the
+// messages of a stream it stopped or of another logs view (e.g. in a split
+// pane).
+
 type InitStreamMsg struct {
 	Lines    chan string
 	Errs     chan error
 	MaxLines int
+	// Context is the Docker context the logs are streamed from.
+	Context string
+
+	ctx context.Context
 }
 
 type LineMsg struct {
 	Line string
+
+	src chan string
 }
 
 type StreamErrMsg struct {
 	Err error
+
+	src chan string
 }
 
-type StreamDoneMsg struct{}
+type StreamDoneMsg struct {
+	src chan string
+}
 
 type WrapToggledMsg struct{}
 
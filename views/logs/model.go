@@ -9,6 +9,7 @@ import (
 	"swarmcli/docker"
 	"swarmcli/views/helpbar"
 	"sync"
+	"time"
 
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
@@ -19,7 +20,7 @@ import (
 type Model struct {
 	viewport      viewport.Model
 	Visible       bool
-	mode          string // "normal" or "search"
+	mode          string // "normal", "search" or "select"
 	searchTerm    string
 	searchIndex   int
 	searchMatches []int
@@ -29,12 +30,16 @@ type Model struct {
 	ready         bool
 
 	ServiceEntry docker.ServiceEntry
+	// taskID limits the logs to one task of the service, named taskName.
+	taskID   string
+	taskName string
 
 	// streaming control
 	StreamCtx    context.Context
 	StreamCancel context.CancelFunc // cancel context for streaming goroutine
 	streamMu     sync.Mutex         // protects below
 	streamActive bool               // whether a stream is active
+	stoppedAt    time.Time          // when the stream was stopped, for resuming
 
 	// read pump channels (internal to tea)
 	linesChan chan string
@@ -57,6 +62,11 @@ type Model struct {
 	nodeSelectVisible bool
 	nodeSelectCursor  int
 	nodeSelectNodes   []string
+	// dockerContext is the Docker context the logs are streamed from
+	dockerContext string
+	// line selection (mode "select"): indices into the node-filtered lines
+	selAnchor int
+	selCursor int
 }
 
 // New creates a logs model with sensible defaults.
@@ -87,6 +97,13 @@ func New(width, height int, maxLines int, service docker.ServiceEntry) *Model {
 	}
 }
 
+// NewTask creates a logs model showing a single task of a service.
+func NewTask(width, height int, maxLines int, target TaskTarget) *Model {
+	m := New(width, height, maxLines, target.Service)
+	m.taskID, m.taskName = target.TaskID, target.Task
+	return m
+}
+
 func (m *Model) Init() tea.Cmd { return nil }
 
 func (m *Model) Name() string { return ViewName }
@@ -176,6 +193,13 @@ func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
 			{Key: "n/N", Desc: "Next/prev"},
 		}
 	}
+	if m.mode == "select" {
+		return []helpbar.HelpEntry{
+			{Key: "↑/↓", Desc: "Extend selection"},
+			{Key: "y", Desc: "Copy selection"},
+			{Key: "esc/v", Desc: "Cancel"},
+		}
+	}
 
 	entries := []helpbar.HelpEntry{
 		{Key: "/", Desc: "Search"},
@@ -184,6 +208,7 @@ func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
 		{Key: "w", Desc: "Toggle wrap"},
 		{Key: "o", Desc: "Filter node"},
 		{Key: "f", Desc: "Fullscreen"},
+		{Key: "v", Desc: "Select lines"},
 	}
 
 	// Show left/right help only when wrap is off
@@ -196,17 +221,20 @@ func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
 }
 
 func (m *Model) OnEnter() tea.Cmd {
-	// We start streaming with the factory method
-	return nil
+	// We start streaming with the factory method; a view restored from the
+	// navigation history picks up where it stopped
+	return m.ResumeStreamingCmd()
 }
 
 func (m *Model) OnExit() tea.Cmd {
-	return m.StopStreamingCmd()
+	// Stop right away so a quick return through the history resumes cleanly
+	m.stopStreaming()
+	return nil
 }
 
 // extractUniqueNodes returns a sorted list of nodes where the service has running tasks
 func (m *Model) extractUniqueNodes() []string {
-	snap := docker.GetSnapshot()
+	snap := docker.SnapshotFor(m.dockerContext)
 	if snap == nil {
 		return []string{"All nodes"}
 	}
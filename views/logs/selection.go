@@ -0,0 +1,154 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package logsview
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/wordwrap"
+)
+
+var selectedLineStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("230")).
+	Background(lipgloss.Color("25"))
+
+// filteredLinesLocked returns the lines shown for the current node filter.
+// m.mu must be held.
+func (m *Model) filteredLinesLocked() []string {
+	if m.nodeFilter == "" {
+		return m.lines
+	}
+	var out []string
+	for i, line := range m.lines {
+		if i < len(m.lineNodes) && m.lineNodes[i] == m.nodeFilter {
+			out = append(out, line)
+		}
+	}
+	return out
+}
+
+// lineRowsLocked returns the first viewport row of every line, followed by
+// the total row count. Wrapped lines span several rows. m.mu must be held.
+func (m *Model) lineRowsLocked(lines []string) []int {
+	rows := make([]int, len(lines)+1)
+	wrap := m.wrap && m.viewport.Width > 0 && !m.nodeSelectVisible
+	for i, line := range lines {
+		n := 1
+		if wrap {
+			n = strings.Count(wordwrap.String(line, m.viewport.Width), "\n") + 1
+		}
+		rows[i+1] = rows[i] + n
+	}
+	return rows
+}
+
+// lineAtRow returns the index of the line shown at the given viewport row.
+func lineAtRow(rows []int, row int) int {
+	if len(rows) < 2 {
+		return 0
+	}
+	i := sort.Search(len(rows)-1, func(i int) bool { return rows[i+1] > row })
+	return min(i, len(rows)-2)
+}
+
+// selectionRange returns the selected lines as a sorted, inclusive range.
+func (m *Model) selectionRange() (int, int) {
+	return min(m.selAnchor, m.selCursor), max(m.selAnchor, m.selCursor)
+}
+
+// isSelected reports whether line i is part of the selection.
+func (m *Model) isSelected(i int) bool {
+	if m.mode != "select" {
+		return false
+	}
+	from, to := m.selectionRange()
+	return i >= from && i <= to
+}
+
+// startSelection enters line selection at the top visible line. Auto-scroll
+// is paused so the selection does not move away while lines stream in.
+func (m *Model) startSelection() {
+	m.mu.Lock()
+	lines := m.filteredLinesLocked()
+	if len(lines) == 0 {
+		m.mu.Unlock()
+		return
+	}
+	rows := m.lineRowsLocked(lines)
+	m.selAnchor = lineAtRow(rows, m.viewport.YOffset)
+	m.selCursor = m.selAnchor
+	m.mode = "select"
+	m.follow = false
+	m.mu.Unlock()
+
+	m.viewport.SetContent(m.buildContent())
+}
+
+// stopSelection leaves line selection.
+func (m *Model) stopSelection() {
+	m.mode = "normal"
+	m.viewport.SetContent(m.buildContent())
+}
+
+// moveSelection moves the selection cursor by delta lines and scrolls so the
+// cursor stays visible.
+func (m *Model) moveSelection(delta int) {
+	m.mu.Lock()
+	lines := m.filteredLinesLocked()
+	rows := m.lineRowsLocked(lines)
+	m.selCursor = max(0, min(m.selCursor+delta, len(lines)-1))
+	m.selAnchor = min(m.selAnchor, max(len(lines)-1, 0))
+	top, bottom := rows[m.selCursor], rows[m.selCursor+1]
+	m.mu.Unlock()
+
+	m.viewport.SetContent(m.buildContent())
+	if top < m.viewport.YOffset {
+		m.viewport.SetYOffset(top)
+	} else if bottom > m.viewport.YOffset+m.viewport.Height {
+		m.viewport.SetYOffset(bottom - m.viewport.Height)
+	}
+}
+
+// CopyText returns the selected lines while selecting, otherwise the lines
+// on screen; alt copies every loaded line. The node filter applies in all
+// cases.
+func (m *Model) CopyText(alt bool) (string, string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	lines := m.filteredLinesLocked()
+	if len(lines) == 0 {
+		return "log lines", ""
+	}
+	var from, to int
+	switch {
+	case alt:
+		from, to = 0, len(lines)-1
+	case m.mode == "select":
+		from, to = m.selectionRange()
+		to = min(to, len(lines)-1)
+	default:
+		rows := m.lineRowsLocked(lines)
+		from = lineAtRow(rows, m.viewport.YOffset)
+		to = lineAtRow(rows, m.viewport.YOffset+m.viewport.Height-1)
+	}
+	if from > to {
+		return "log lines", ""
+	}
+	n := to - from + 1
+	what := "log line"
+	if n != 1 {
+		what = fmt.Sprintf("%d log lines", n)
+	}
+	return what, strings.Join(lines[from:to+1], "\n")
+}
+
+// HasLineSelection reports whether lines are being selected, so the app
+// routes ESC to the view to end the selection.
+func (m *Model) HasLineSelection() bool {
+	return m.mode == "select"
+}
@@ -12,6 +12,7 @@ import (
 	"strings"
 	"swarmcli/docker"
 	"sync"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/docker/docker/api/types/container"
@@ -19,15 +20,25 @@ import (
 )
 
 // StartStreamingCmd returns a tea.Cmd that starts streaming logs for the given service
-// using the provided docker client. It reads the last `tail` lines and then follows.
-// - cli: Docker client
+// from the Docker context of the tab that issued it. It reads the last `tail` lines
+// and then follows.
 // - service: your ServiceEntry (we use ServiceID)
 // - tail: number of lines to request as initial history (0 means all)
 // - MaxLines: the maximum number of lines to keep in memory (circular buffer behavior)
 func StartStreamingCmd(ctx context.Context, service docker.ServiceEntry, tail int, maxLines int) tea.Cmd {
-	cli, _ := docker.GetClient()
+	return streamCmd(ctx, service, "", tail, time.Time{}, maxLines)
+}
 
-	return func() tea.Msg {
+// StartTaskStreamingCmd is StartStreamingCmd for the logs of a single task.
+func StartTaskStreamingCmd(ctx context.Context, target TaskTarget, tail int, maxLines int) tea.Cmd {
+	return streamCmd(ctx, target.Service, target.TaskID, tail, time.Time{}, maxLines)
+}
+
+// streamCmd starts the stream of a service, or of one of its tasks when
+// taskID is set; a non-zero since requests every line written after that
+// time instead of the last tail lines.
+func streamCmd(ctx context.Context, service docker.ServiceEntry, taskID string, tail int, since time.Time, maxLines int) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		lines := make(chan string, 512)
 		errs := make(chan error, 1)
 
@@ -35,6 +46,14 @@ func StartStreamingCmd(ctx context.Context, service docker.ServiceEntry, tail in
 			defer close(lines)
 			defer close(errs)
 
+			cli, err := docker.ClientForContext(ctxName)
+			if err != nil {
+				l().With("service", service.ServiceID).Errorf("docker client: %v", err)
+				errs <- err
+				return
+			}
+			defer func() { _ = cli.Close() }()
+
 			// prepare the logs options using container.LogsOptions (ServiceLogs expects this)
 			opts := container.LogsOptions{
 				ShowStdout: true,
@@ -43,15 +62,23 @@ func StartStreamingCmd(ctx context.Context, service docker.ServiceEntry, tail in
 				Timestamps: false,
 				Details:    true, // Include task and node information in log prefix
 			}
-			if tail > 0 {
+			if tail > 0 && since.IsZero() {
 				opts.Tail = fmt.Sprintf("%d", tail)
 			} else {
 				opts.Tail = "all"
 			}
+			if !since.IsZero() {
+				opts.Since = since.Format(time.RFC3339Nano)
+			}
 			l().Debugf("[logsview] requesting service logs with Tail=%s", opts.Tail)
 
 			// call ServiceLogs (streams a multiplexed stream)
-			reader, err := cli.ServiceLogs(ctx, service.ServiceID, opts)
+			var reader io.ReadCloser
+			if taskID != "" {
+				reader, err = cli.TaskLogs(ctx, taskID, opts)
+			} else {
+				reader, err = cli.ServiceLogs(ctx, service.ServiceID, opts)
+			}
 			if err != nil {
 				l().With("service", service.ServiceID).Errorf("ServiceLogs error: %v", err)
 				errs <- err
@@ -81,7 +108,7 @@ func StartStreamingCmd(ctx context.Context, service docker.ServiceEntry, tail in
 				for sc.Scan() {
 					line := sc.Text()
 					// Format the log line with node information
-					formattedLine, nodeName := formatLogLineWithNode(service.ServiceName, line)
+					formattedLine, nodeName := formatLogLineWithNode(ctxName, service.ServiceName, line)
 					// Store both the formatted line and node name (separated by a special marker)
 					// Format: "NODENAME\x00formatted_line" where \x00 is a null byte separator
 					lines <- nodeName + "\x00" + formattedLine
@@ -112,31 +139,52 @@ func StartStreamingCmd(ctx context.Context, service docker.ServiceEntry, tail in
 			Lines:    lines,
 			Errs:     errs,
 			MaxLines: maxLines,
+			Context:  ctxName,
+			ctx:      ctx,
 		}
-	}
+	})
 }
 
 // StopStreamingCmd returns a cmd that cancels the streaming context (if set on model).
 // Use this to stop the docker log stream (kills follow).
 func (m *Model) StopStreamingCmd() tea.Cmd {
 	return func() tea.Msg {
-		m.streamMu.Lock()
-		defer m.streamMu.Unlock()
-		if m.StreamCancel != nil {
-			l().Debugf("[logsview] stop streaming requested")
-			m.StreamCancel()
-			m.StreamCancel = nil
-			m.streamActive = false
-		}
+		m.stopStreaming()
+		return nil
+	}
+}
+
+func (m *Model) stopStreaming() {
+	m.streamMu.Lock()
+	defer m.streamMu.Unlock()
+	if m.StreamCancel != nil {
+		l().Debugf("[logsview] stop streaming requested")
+		m.StreamCancel()
+		m.StreamCancel = nil
+		m.streamActive = false
+		m.stoppedAt = time.Now()
+	}
+}
+
+// ResumeStreamingCmd restarts a stopped stream, e.g. when the view is
+// restored from the navigation history. It fetches the lines written since
+// the stream stopped, so the buffer continues without gaps or duplicates.
+func (m *Model) ResumeStreamingCmd() tea.Cmd {
+	m.streamMu.Lock()
+	defer m.streamMu.Unlock()
+	if m.StreamCancel != nil || m.stoppedAt.IsZero() {
 		return nil
 	}
+	l().Debugf("[logsview] resuming stream since %s", m.stoppedAt.Format(time.RFC3339))
+	m.StreamCtx, m.StreamCancel = context.WithCancel(context.Background())
+	return streamCmd(m.StreamCtx, m.ServiceEntry, m.taskID, 0, m.stoppedAt, m.MaxLines)
 }
 
 // formatLogLineWithNode parses the Docker log details and formats the line with node information
 // Input format: "com.docker.swarm.node.id=xxx,com.docker.swarm.task.id=yyy actual log message"
 // Output format: formatted line and node name for filtering
 // Returns: ("service_name.task_id@node_name | actual log message", "node_name")
-func formatLogLineWithNode(serviceName string, line string) (string, string) {
+func formatLogLineWithNode(ctxName, serviceName string, line string) (string, string) {
 	// Check if line has Docker details prefix
 	if !strings.Contains(line, "com.docker.swarm.") {
 		return line, ""
@@ -169,7 +217,7 @@ func formatLogLineWithNode(serviceName string, line string) (string, string) {
 	}
 
 	// Get node hostname from node ID
-	nodeName := getNodeHostname(nodeID)
+	nodeName := getNodeHostname(ctxName, nodeID)
 	if nodeName == "" {
 		nodeName = nodeID[:12] // fallback to short ID
 	}
@@ -187,9 +235,10 @@ func formatLogLineWithNode(serviceName string, line string) (string, string) {
 	return fmt.Sprintf("%s | %s", prefix, message), nodeName
 }
 
-// getNodeHostname retrieves the hostname for a node ID from the snapshot
-func getNodeHostname(nodeID string) string {
-	snap := docker.GetSnapshot()
+// getNodeHostname retrieves the hostname for a node ID from the snapshot of
+// the named Docker context
+func getNodeHostname(ctxName, nodeID string) string {
+	snap := docker.SnapshotFor(ctxName)
 	if snap == nil {
 		return ""
 	}
@@ -17,14 +17,22 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 	switch msg := msg.(type) {
 
 	case InitStreamMsg:
+		// Ignore streams this view no longer owns (stopped or replaced)
+		if msg.ctx != nil && msg.ctx != m.StreamCtx {
+			return nil
+		}
 		// store channels and begin the read-once pump
 		m.linesChan = msg.Lines
 		m.errChan = msg.Errs
+		m.dockerContext = msg.Context
 		m.Visible = true
 		l().Debugf("[logsview] stream initialized")
 		return m.readOneLineCmd()
 
 	case LineMsg:
+		if msg.src != m.linesChan {
+			return nil
+		}
 		// Parse node name from the line (format: "nodename\x00actual_line")
 		parts := strings.SplitN(msg.Line, "\x00", 2)
 		var nodeName, actualLine string
@@ -104,6 +112,9 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		return m.readOneLineCmd()
 
 	case StreamErrMsg:
+		if msg.src != m.linesChan {
+			return nil
+		}
 		// append an error line and stop
 		m.mu.Lock()
 		m.lines = append(m.lines, fmt.Sprintf("Error: %v", msg.Err))
@@ -115,6 +126,9 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		return nil
 
 	case StreamDoneMsg:
+		if msg.src != m.linesChan {
+			return nil
+		}
 		m.mu.Lock()
 		m.lines = append(m.lines, "--- stream closed ---")
 		m.mu.Unlock()
@@ -199,6 +213,11 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			return nil
 		}
 
+		// While selecting lines, movement keys extend the selection
+		if m.mode == "select" {
+			return HandleKey(m, msg)
+		}
+
 		// 1) allow viewport to handle scrolling keys
 		switch msg.String() {
 		case "up", "down", "pgup", "pgdown", "home", "end", "k", "j":
@@ -223,21 +242,22 @@ func (m *Model) readOneLineCmd() tea.Cmd {
 	if m.linesChan == nil && m.errChan == nil {
 		return nil
 	}
+	lines, errs := m.linesChan, m.errChan
 	return func() tea.Msg {
 		select {
-		case line, ok := <-m.linesChan:
+		case line, ok := <-lines:
 			if !ok {
-				return StreamDoneMsg{}
+				return StreamDoneMsg{src: lines}
 			}
-			return LineMsg{Line: line}
-		case err, ok := <-m.errChan:
+			return LineMsg{Line: line, src: lines}
+		case err, ok := <-errs:
 			if !ok {
-				return StreamDoneMsg{}
+				return StreamDoneMsg{src: lines}
 			}
 			if err != nil {
-				return StreamErrMsg{Err: err}
+				return StreamErrMsg{Err: err, src: lines}
 			}
-			return StreamDoneMsg{}
+			return StreamDoneMsg{src: lines}
 		}
 	}
 }
@@ -297,18 +317,23 @@ func (m *Model) buildContent() string {
 	defer m.mu.Unlock()
 
 	// Apply node filter
-	nodeFilter := m.nodeFilter
-	var filteredLines []string
-	if nodeFilter != "" {
-		// Filter lines by node
-		for i, line := range m.lines {
-			if i < len(m.lineNodes) && m.lineNodes[i] == nodeFilter {
-				filteredLines = append(filteredLines, line)
+	filteredLines := m.filteredLinesLocked()
+
+	// Highlight the selected lines row by row when wrapping, so every row of
+	// a wrapped line is marked
+	if m.mode == "select" && m.wrap && m.viewport.Width > 0 {
+		highlighted := make([]string, len(filteredLines))
+		for i, line := range filteredLines {
+			if m.isSelected(i) {
+				rows := strings.Split(wordwrap.String(line, m.viewport.Width), "\n")
+				for j, row := range rows {
+					rows[j] = selectedLineStyle.Render(row)
+				}
+				line = strings.Join(rows, "\n")
 			}
+			highlighted[i] = line
 		}
-	} else {
-		// No filter, use all lines
-		filteredLines = m.lines
+		filteredLines = highlighted
 	}
 
 	// Join lines first
@@ -342,6 +367,9 @@ func (m *Model) buildContent() string {
 					processedLines[i] = visiblePart
 				}
 			}
+			if m.isSelected(i) {
+				processedLines[i] = selectedLineStyle.Render(processedLines[i])
+			}
 		}
 		full = strings.Join(processedLines, "\n")
 	}
@@ -36,9 +36,13 @@ func (m *Model) View() string {
 		filterStatus = fmt.Sprintf("node: %s", nodeFilter)
 	}
 
+	source := "Service: " + m.ServiceEntry.ServiceName
+	if m.taskID != "" {
+		source = "Task: " + m.taskName
+	}
 	title := fmt.Sprintf(
-		"Service: %s • AutoScroll: %s • wrap: %s • Filter: %s",
-		m.ServiceEntry.ServiceName,
+		"%s • AutoScroll: %s • wrap: %s • Filter: %s",
+		source,
 		followStatus,
 		wrapStatus,
 		filterStatus,
@@ -48,6 +52,9 @@ func (m *Model) View() string {
 	header := "Logs"
 	if m.mode == "search" {
 		header = fmt.Sprintf("Logs — Search: %s", m.searchTerm)
+	} else if m.mode == "select" {
+		from, to := m.selectionRange()
+		header = fmt.Sprintf("Logs — Selected %d lines", to-from+1)
 	}
 
 	headerRendered := ui.FrameHeaderStyle.Render(header)
@@ -10,7 +10,6 @@ import (
 	"time"
 
 	"github.com/docker/docker/api/types/network"
-	"github.com/docker/docker/api/types/swarm"
 )
 
 type networkItem struct {
@@ -58,8 +57,8 @@ func (nw *networkWithUsage) PrettyJSON() ([]byte, error) {
 }
 
 // fetchNetworks retrieves all networks and their usage information
-func fetchNetworks() ([]networkItem, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func fetchNetworks(ctx context.Context) ([]networkItem, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	networks, err := docker.ListNetworks(ctx)
@@ -85,8 +84,8 @@ func fetchNetworks() ([]networkItem, error) {
 }
 
 // fetchNetworkWithUsage retrieves detailed information about a network
-func fetchNetworkWithUsage(networkID string) (*networkWithUsage, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func fetchNetworkWithUsage(ctx context.Context, networkID string) (*networkWithUsage, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	net, err := docker.InspectNetwork(ctx, networkID)
@@ -126,16 +125,11 @@ func fetchNetworkWithUsage(networkID string) (*networkWithUsage, error) {
 }
 
 // fetchUsedBy retrieves the services using a network
-func fetchUsedBy(networkID, networkName string) ([]usedByItem, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+func fetchUsedBy(ctx context.Context, networkID, networkName string) ([]usedByItem, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
-	client, err := docker.GetClient()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get docker client: %w", err)
-	}
-
-	allServices, err := client.ServiceList(ctx, swarm.ServiceListOptions{})
+	allServices, err := docker.ListServices(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list services: %w", err)
 	}
@@ -180,8 +174,8 @@ func fetchUsedBy(networkID, networkName string) ([]usedByItem, error) {
 }
 
 // deleteNetwork removes a network
-func deleteNetwork(networkID string) error {
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+func deleteNetwork(ctx context.Context, networkID string) error {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	err := docker.RemoveNetwork(ctx, networkID)
@@ -193,8 +187,8 @@ func deleteNetwork(networkID string) error {
 }
 
 // pruneNetworks removes all unused networks
-func pruneNetworks() ([]string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+func pruneNetworks(ctx context.Context) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	// Build an ID->Name map from the current network list so we can show
@@ -227,8 +221,8 @@ func pruneNetworks() ([]string, error) {
 	return deleted, nil
 }
 
-func createNetwork(name, driver string, attachable, internal bool, ipv4Subnet, ipv4Gateway string, enableIPv6 bool, ipv6Subnet, ipv6Gateway string) (string, []string, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+func createNetwork(ctx context.Context, name, driver string, attachable, internal bool, ipv4Subnet, ipv4Gateway string, enableIPv6 bool, ipv6Subnet, ipv6Gateway string) (string, []string, error) {
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Second)
 	defer cancel()
 
 	opts := network.CreateOptions{
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package networksview
+
+import (
+	"fmt"
+	"strconv"
+	"swarmcli/core/export"
+	"swarmcli/ui"
+	"swarmcli/ui/components/columns"
+	"swarmcli/ui/components/sorting"
+	"swarmcli/views/columnpicker"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// networkColumns returns the built-in columns of the networks table. The used
+// column shows the view's spinner until the usage of a network is known.
+func (m *Model) networkColumns() []columns.Column[networkItem] {
+	return []columns.Column[networkItem]{
+		{Name: "name", Title: "NAME", Min: 10, Fit: true, Search: true, Value: networkName},
+		{Name: "driver", Title: "DRIVER", Min: 6, Search: true, Value: func(n networkItem) string { return n.Driver }},
+		{Name: "scope", Title: "SCOPE", Min: 5, Search: true, Value: func(n networkItem) string { return n.Scope }},
+		{Name: "used", Title: "USED", Min: 4,
+			Value: func(n networkItem) string { return usedValue(n.Used, n.UsedKnown) },
+			Format: func(n networkItem, _ int) string {
+				switch {
+				case !n.UsedKnown:
+					return ui.SpinnerCharAt(m.spinner)
+				case n.Used:
+					return "●"
+				}
+				return ""
+			}},
+		{Name: "id", Title: "ID", Min: 8, Search: true, Value: func(n networkItem) string { return n.ID }},
+		{Name: "ingress", Title: "INGRESS", Min: 7, Hidden: true, Value: func(n networkItem) string { return strconv.FormatBool(n.Ingress) }},
+		{Name: "created", Title: "CREATED", Min: 8, Hidden: true, Value: func(n networkItem) string { return export.Time(n.CreatedAt) }},
+	}
+}
+
+func (m *Model) newNetworkLayout() *columns.Layout[networkItem] {
+	layout, err := columns.NewLayout(ViewName, m.networkColumns(), nil)
+	if err != nil {
+		l().Warnf("Failed to load column layout: %v", err)
+	}
+	layout.SelectedBg = lipgloss.Color("63")
+	layout.Terms = m.networksList.FreeTerms
+	return layout
+}
+
+// sortArrow returns the sort indicator for the given column, if it is the
+// active sort column.
+func (m *Model) sortArrow(name string) string {
+	fields := map[string]SortField{
+		"name":    SortByName,
+		"driver":  SortByDriver,
+		"scope":   SortByScope,
+		"used":    SortByUsed,
+		"id":      SortByID,
+		"created": SortByCreated,
+	}
+	if f, ok := fields[name]; !ok || f != m.sortField {
+		return ""
+	}
+	if m.sortAscending {
+		return sorting.SortArrow(sorting.Ascending)
+	}
+	return sorting.SortArrow(sorting.Descending)
+}
+
+func (m *Model) openColumnPicker() {
+	m.columnPicker.Show(ViewName, m.columns.Specs())
+}
+
+// applyColumnLayout applies and persists the picker's result.
+func (m *Model) applyColumnLayout(msg columnpicker.ResultMsg) tea.Cmd {
+	if !msg.Confirmed {
+		return nil
+	}
+	err := m.columns.Apply(msg.Reset, msg.Columns)
+	m.setRenderItem()
+
+	if err != nil {
+		l().Errorf("Failed to save column layout: %v", err)
+		m.err = fmt.Errorf("failed to save column layout: %w", err)
+		m.errorDialogActive = true
+	}
+	return nil
+}
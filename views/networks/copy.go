@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package networksview
+
+import "swarmcli/core/clipboard"
+
+// CopyText returns the names (or IDs with alt) of the marked networks, or of
+// the network under the cursor. In the "used by" list it copies service names.
+func (m *Model) CopyText(alt bool) (string, string) {
+	if m.usedByViewActive {
+		items := clipboard.Selection(nil, m.usedByList.Filtered, m.usedByList.Cursor)
+		return clipboard.Describe(len(items), "service name"), clipboard.Join(items, func(i usedByItem) string { return i.ServiceName })
+	}
+	items := clipboard.Selection(m.networksList.Marked(), m.networksList.Filtered, m.networksList.Cursor)
+	if alt {
+		return clipboard.Describe(len(items), "network ID"), clipboard.Join(items, func(n networkItem) string { return n.ID })
+	}
+	return clipboard.Describe(len(items), "network name"), clipboard.Join(items, networkName)
+}
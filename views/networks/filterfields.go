@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package networksview
+
+import filterlist "swarmcli/ui/components/filterable/list"
+
+// networkFilterFields are the attributes addressable from the filter query,
+// e.g. "driver:overlay scope:swarm used:no".
+var networkFilterFields = []filterlist.Field[networkItem]{
+	{Name: "name", Values: func(n networkItem) []string { return []string{n.Name} }},
+	{Name: "id", Values: func(n networkItem) []string { return []string{n.ID} }},
+	{Name: "driver", Values: func(n networkItem) []string { return []string{n.Driver} }},
+	{Name: "scope", Values: func(n networkItem) []string { return []string{n.Scope} }},
+	{Name: "used", Values: func(n networkItem) []string { return []string{usedValue(n.Used, n.UsedKnown)} }, Exact: true},
+}
+
+func usedValue(used, known bool) string {
+	switch {
+	case !known:
+		return "unknown"
+	case used:
+		return "yes"
+	default:
+		return "no"
+	}
+}
+
+func networkName(n networkItem) string { return n.Name }
@@ -13,7 +13,7 @@ func GetNetworksHelpContent() []helpview.HelpCategory {
 			Items: []helpview.HelpItem{
 				{Keys: "<i>", Description: "Inspect selected network (JSON)"},
 				{Keys: "<u>", Description: "Show services using the network"},
-				{Keys: "</>", Description: "Filter networks"},
+				{Keys: "</>", Description: "Filter networks (e.g. driver:overlay used:no)"},
 				{Keys: "<?>", Description: "Open this help"},
 			},
 		},
@@ -27,6 +27,7 @@ func GetNetworksHelpContent() []helpview.HelpCategory {
 				{Keys: "<shift+i>", Description: "Order by ID"},
 				{Keys: "<shift+c>", Description: "Order by Created"},
 				{Keys: "(repeat key)", Description: "Toggle ascending/descending"},
+				{Keys: "<o>", Description: "Columns: show/hide, reorder, pin"},
 			},
 		},
 		{
@@ -35,6 +36,8 @@ func GetNetworksHelpContent() []helpview.HelpCategory {
 				{Keys: "<↑/↓>", Description: "Move cursor"},
 				{Keys: "<pgup>", Description: "Page up"},
 				{Keys: "<pgdown>", Description: "Page down"},
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
 				{Keys: "<esc/q>", Description: "Back"},
 			},
 		},
@@ -42,6 +45,8 @@ func GetNetworksHelpContent() []helpview.HelpCategory {
 			Title: "Danger Zone",
 			Items: []helpview.HelpItem{
 				{Keys: "<ctrl+d>", Description: "Delete selected network"},
+				{Keys: "<space>", Description: "Mark for bulk delete"},
+				{Keys: "<ctrl+a>", Description: "Mark all filtered"},
 				{Keys: "<ctrl+u>", Description: "Prune unused networks"},
 			},
 		},
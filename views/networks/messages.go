@@ -5,12 +5,11 @@ package networksview
 
 import (
 	"context"
+	"swarmcli/views/bulkaction"
 	"time"
 
 	"swarmcli/docker"
 
-	"github.com/docker/docker/api/types/swarm"
-
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -59,58 +58,71 @@ type ViewStackMsg struct {
 }
 
 func loadNetworksCmd() tea.Cmd {
-	return func() tea.Msg {
-		networks, err := fetchNetworks()
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
+		networks, err := fetchNetworks(ctx)
 		return NetworksLoadedMsg{
 			Networks: networks,
 			Err:      err,
 		}
-	}
+	})
 }
 
 func inspectNetworkCmd(networkID string) tea.Cmd {
-	return func() tea.Msg {
-		nw, err := fetchNetworkWithUsage(networkID)
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
+		nw, err := fetchNetworkWithUsage(ctx, networkID)
 		return NetworkInspectMsg{
 			NetworkWithUsage: nw,
 			Err:              err,
 		}
-	}
+	})
+}
+
+// deleteNetworksCmd deletes every given network and reports per-item results.
+func deleteNetworksCmd(items []networkItem) tea.Cmd {
+	return bulkaction.Run("Deleted", "networks", items, networkName, func(ctx context.Context, n networkItem) error {
+		return deleteNetwork(ctx, n.ID)
+	})
 }
 
 func deleteNetworkCmd(networkID string) tea.Cmd {
-	return func() tea.Msg {
-		err := deleteNetwork(networkID)
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
+		err := deleteNetwork(ctx, networkID)
 		return NetworkDeletedMsg{Err: err}
-	}
+	})
 }
 
 func createNetworkCmd(name, driver string, attachable, internal bool, ipv4Subnet, ipv4Gateway string, enableIPv6 bool, ipv6Subnet, ipv6Gateway string) tea.Cmd {
-	return func() tea.Msg {
-		id, warnings, err := createNetwork(name, driver, attachable, internal, ipv4Subnet, ipv4Gateway, enableIPv6, ipv6Subnet, ipv6Gateway)
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
+		id, warnings, err := createNetwork(ctx, name, driver, attachable, internal, ipv4Subnet, ipv4Gateway, enableIPv6, ipv6Subnet, ipv6Gateway)
 		return NetworkCreatedMsg{Name: name, ID: id, Warnings: warnings, Err: err}
-	}
+	})
 }
 
 func pruneNetworksCmd() tea.Cmd {
-	return func() tea.Msg {
-		deleted, err := pruneNetworks()
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
+		deleted, err := pruneNetworks(ctx)
 		return NetworksPrunedMsg{Deleted: deleted, Err: err}
-	}
+	})
 }
 
 func loadUsedByCmd(networkID, networkName string) tea.Cmd {
-	return func() tea.Msg {
-		services, err := fetchUsedBy(networkID, networkName)
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
+		services, err := fetchUsedBy(ctx, networkID, networkName)
 		return UsedByLoadedMsg{
 			Services: services,
 			Err:      err,
 		}
-	}
+	})
 }
 
 func computeNetworkUsedCmd(networks []networkItem) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		used := make(map[string]bool, len(networks))
 		keyToID := make(map[string]string, len(networks)*2)
 		ingressIDs := make([]string, 0, 1)
@@ -127,15 +139,10 @@ func computeNetworkUsedCmd(networks []networkItem) tea.Cmd {
 			}
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+		ctx, cancel := context.WithTimeout(docker.WithContextName(docker.WithContextName(context.Background(), ctxName), ctxName), 15*time.Second)
 		defer cancel()
 
-		client, err := docker.GetClient()
-		if err != nil {
-			return usedStatusUpdatedMsg(used)
-		}
-
-		services, err := client.ServiceList(ctx, swarm.ServiceListOptions{})
+		services, err := docker.ListServices(ctx)
 		if err != nil {
 			return usedStatusUpdatedMsg(used)
 		}
@@ -167,5 +174,5 @@ func computeNetworkUsedCmd(networks []networkItem) tea.Cmd {
 		}
 
 		return usedStatusUpdatedMsg(used)
-	}
+	})
 }
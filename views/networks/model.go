@@ -6,7 +6,10 @@ package networksview
 import (
 	"fmt"
 	"strings"
+	"swarmcli/core/primitives/fuzzy"
+	"swarmcli/ui/components/columns"
 	filterlist "swarmcli/ui/components/filterable/list"
+	"swarmcli/views/columnpicker"
 	"swarmcli/views/confirmdialog"
 	"swarmcli/views/helpbar"
 	loading "swarmcli/views/loading"
@@ -36,6 +39,7 @@ type Model struct {
 	lastSnapshot          uint64 // hash of last snapshot for change detection
 	visible               bool   // tracks if view is currently active
 	resetCursorOnNextLoad bool   // one-shot: force cursor to top on next NetworksLoadedMsg
+	entered               bool   // OnEnter ran before; later entries keep the cursor
 	sortField             SortField
 	sortAscending         bool // true for ascending, false for descending
 
@@ -61,10 +65,9 @@ type Model struct {
 	usedByList        filterlist.FilterableList[usedByItem]
 	usedByNetworkName string
 
-	// Cached column widths for header alignment
-	colNameWidth   int
-	colDriverWidth int
-	colScopeWidth  int
+	// Column layout of the networks table
+	columns      *columns.Layout[networkItem]
+	columnPicker *columnpicker.Model
 
 	// Spinner for loading indicator
 	spinner int
@@ -103,12 +106,10 @@ func New(width, height int) *Model {
 
 	list := filterlist.FilterableList[networkItem]{
 		Viewport: vp,
-		Match: func(n networkItem, query string) bool {
-			q := strings.ToLower(query)
-			return strings.Contains(strings.ToLower(n.Name), q) ||
-				strings.Contains(strings.ToLower(n.ID), q) ||
-				strings.Contains(strings.ToLower(n.Driver), q) ||
-				strings.Contains(strings.ToLower(n.Scope), q)
+		Fields:   networkFilterFields,
+		Key:      func(n networkItem) string { return n.ID },
+		Score: func(n networkItem, query string) (int, bool) {
+			return fuzzy.Score(query, n.Name, n.ID, n.Driver, n.Scope)
 		},
 	}
 	// Important: make Items a non-nil slice so the FilterableList renderer pads
@@ -149,7 +150,7 @@ func New(width, height int) *Model {
 	ipv6Gateway.CharLimit = 64
 	ipv6Gateway.Width = 50
 
-	return &Model{
+	m := &Model{
 		networksList:      list,
 		width:             width,
 		height:            height,
@@ -167,7 +168,10 @@ func New(width, height int) *Model {
 		createEnableIPv6:  false,
 		createIPv6Subnet:  ipv6Subnet,
 		createIPv6Gateway: ipv6Gateway,
+		columnPicker:      columnpicker.New(width, height),
 	}
+	m.columns = m.newNetworkLayout()
+	return m
 }
 
 func (m *Model) Name() string { return ViewName }
@@ -190,9 +194,17 @@ func (m *Model) HasActiveDialog() bool {
 	if m.errorDialogActive {
 		return true
 	}
+	if m.columnPicker.Visible {
+		return true
+	}
 	return false
 }
 
+// HasMarkedItems reports whether networks are marked for a bulk delete.
+func (m *Model) HasMarkedItems() bool {
+	return m.networksList.MarkedCount() > 0
+}
+
 // IsSearching reports whether the networks or UsedBy list is in search mode.
 func (m *Model) IsSearching() bool {
 	// Important: app-level key handling uses IsSearching() to decide whether ESC/Q
@@ -262,7 +274,9 @@ func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
 		{Key: "i", Desc: "Inspect"},
 		{Key: "u", Desc: "Used By"},
 		{Key: "ctrl+d", Desc: "Delete"},
+		{Key: "space", Desc: "Mark"},
 		{Key: "ctrl+u", Desc: "Prune Unused"},
+		{Key: "o", Desc: "Columns"},
 		{Key: "/", Desc: "Filter"},
 		{Key: "?", Desc: "Help"},
 		{Key: "esc/q", Desc: "Back"},
@@ -321,12 +335,15 @@ func (m *Model) SetSize(width, height int) {
 func (m *Model) OnEnter() tea.Cmd {
 	m.visible = true
 	l().Info("NetworksView: OnEnter() - view is now visible")
-	// When entering the view, prefer a predictable UX: start at the top.
-	// We keep cursor-restore behavior for background refreshes, but suppress it
-	// for the first load after entering.
-	m.resetCursorOnNextLoad = true
-	m.networksList.Cursor = 0
-	m.networksList.Viewport.YOffset = 0
+	// When opening the view, prefer a predictable UX: start at the top.
+	// We keep cursor-restore behavior for background refreshes and for
+	// returning to the view through the navigation history.
+	if !m.entered {
+		m.entered = true
+		m.resetCursorOnNextLoad = true
+		m.networksList.Cursor = 0
+		m.networksList.Viewport.YOffset = 0
+	}
 	return LoadNetworks()
 }
 
@@ -4,13 +4,18 @@
 package networksview
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/netip"
 	"sort"
 	"strings"
+	"swarmcli/core/primitives/fuzzy"
 	"swarmcli/core/primitives/hash"
-	"swarmcli/ui"
+	"swarmcli/docker"
 	filterlist "swarmcli/ui/components/filterable/list"
+	"swarmcli/views/bulkaction"
+	"swarmcli/views/columnpicker"
 	helpview "swarmcli/views/help"
 	servicesview "swarmcli/views/services"
 	view "swarmcli/views/view"
@@ -119,77 +124,6 @@ func (m *Model) updateInspectViewport() {
 	m.inspectViewport.SetContent(strings.Join(lines, "\n"))
 }
 
-func truncateWithEllipsis(s string, maxWidth int) string {
-	if maxWidth <= 0 {
-		return ""
-	}
-	if maxWidth <= 1 {
-		return "…"
-	}
-	if lipgloss.Width(s) <= maxWidth {
-		return s
-	}
-	// best-effort: assumes single-width runes for typical ASCII docker output
-	if maxWidth == 2 {
-		return s[:1] + "…"
-	}
-	return s[:maxWidth-1] + "…"
-}
-
-func (m *Model) networkColWidths(totalWidth int) (nameW, driverW, scopeW, usedW, idW int) {
-	if totalWidth <= 0 {
-		totalWidth = 80
-	}
-
-	// Match other views: compute an effective content width (excluding separators)
-	// and allocate columns as percentages of that width.
-	sepLen := 2
-	sepTotal := 4 * sepLen // 5 cols => 4 separators
-	effWidth := totalWidth - sepTotal
-	if effWidth < 20 {
-		effWidth = totalWidth
-	}
-
-	// Percent weights (sum=100)
-	weights := []int{30, 18, 12, 6, 34} // NAME, DRIVER, SCOPE, USED, ID
-	colWidths := make([]int, 5)
-	sum := 0
-	for i := 0; i < 4; i++ {
-		w := (effWidth * weights[i]) / 100
-		if w < 1 {
-			w = 1
-		}
-		colWidths[i] = w
-		sum += w
-	}
-	colWidths[4] = effWidth - sum
-	if colWidths[4] < 1 {
-		colWidths[4] = 1
-	}
-
-	// Enforce minimums similar to other views.
-	mins := []int{10, 6, 5, 1, 8}
-	for i := range colWidths {
-		if colWidths[i] < mins[i] {
-			colWidths[i] = mins[i]
-		}
-	}
-
-	// Adjust last column to ensure total equals effWidth.
-	sum = 0
-	for _, v := range colWidths {
-		sum += v
-	}
-	if sum != effWidth {
-		colWidths[4] += effWidth - sum
-		if colWidths[4] < 1 {
-			colWidths[4] = 1
-		}
-	}
-
-	return colWidths[0], colWidths[1], colWidths[2], colWidths[3], colWidths[4]
-}
-
 func (m *Model) Update(msg tea.Msg) tea.Cmd {
 	switch msg := msg.(type) {
 	case SpinnerTickMsg:
@@ -233,6 +167,13 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		}
 		return nil
 
+	case columnpicker.OpenMsg:
+		m.openColumnPicker()
+		return nil
+
+	case columnpicker.ResultMsg:
+		return m.applyColumnLayout(msg)
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -408,6 +349,16 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		}
 		return loadNetworksCmd()
 
+	case bulkaction.DoneMsg:
+		m.networksList.ClearMarks()
+		if msg.Failed() > 0 {
+			m.errorDialogActive = true
+			m.err = errors.New(msg.Summary())
+		} else {
+			m.showToast(msg.Summary())
+		}
+		return loadNetworksCmd()
+
 	case NetworksPrunedMsg:
 		if msg.Err != nil {
 			m.errorDialogActive = true
@@ -497,10 +448,8 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 
 		m.usedByList = filterlist.FilterableList[usedByItem]{
 			Viewport: usedByVp,
-			Match: func(item usedByItem, query string) bool {
-				q := strings.ToLower(query)
-				return strings.Contains(strings.ToLower(item.StackName), q) ||
-					strings.Contains(strings.ToLower(item.ServiceName), q)
+			Score: func(item usedByItem, query string) (int, bool) {
+				return fuzzy.Score(query, item.StackName, item.ServiceName)
 			},
 		}
 
@@ -534,6 +483,10 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			return nil
 		}
 
+		if m.columnPicker.Visible {
+			return m.columnPicker.Update(msg)
+		}
+
 		// Handle confirm dialog
 		if m.confirmDialog.Visible {
 			switch msg.String() {
@@ -884,8 +837,20 @@ func (m *Model) handleCreateDialogKeys(msg tea.KeyMsg) tea.Cmd {
 func (m *Model) handleNormalKeys(msg tea.KeyMsg) tea.Cmd {
 	switch msg.String() {
 	case "esc", "q":
+		if msg.String() == "esc" && m.networksList.MarkedCount() > 0 {
+			m.networksList.ClearMarks()
+			m.networksList.Viewport.SetContent(m.networksList.View())
+		}
 		// Networks is a root view, no back navigation
 		return nil
+	case " ":
+		m.networksList.ToggleMark()
+		m.networksList.Viewport.SetContent(m.networksList.View())
+		return nil
+	case "ctrl+a":
+		m.networksList.ToggleMarkAll()
+		m.networksList.Viewport.SetContent(m.networksList.View())
+		return nil
 	case "?":
 		return func() tea.Msg {
 			return view.NavigateToMsg{
@@ -981,6 +946,9 @@ func (m *Model) handleNormalKeys(msg tea.KeyMsg) tea.Cmd {
 		m.networksList.Mode = filterlist.ModeSearching
 		m.networksList.Query = ""
 		m.setRenderItem()
+	case "o":
+		m.openColumnPicker()
+		return nil
 	case "c":
 		m.createDialogActive = true
 		m.createDialogStep = "basic"
@@ -1013,6 +981,13 @@ func (m *Model) handleNormalKeys(msg tea.KeyMsg) tea.Cmd {
 		m.usedByNetworkName = selected.Name
 		return loadUsedByCmd(selected.ID, selected.Name)
 	case "ctrl+d":
+		// Delete marked networks
+		if marked := m.networksList.Marked(); len(marked) > 0 {
+			m.pendingAction = "bulk-delete"
+			m.confirmDialog.Message = bulkaction.ConfirmMessage("Delete", "networks", bulkaction.Names(marked, networkName), "")
+			m.confirmDialog.Visible = true
+			return nil
+		}
 		// Delete network
 		if len(m.networksList.Filtered) == 0 {
 			return nil
@@ -1035,6 +1010,8 @@ func (m *Model) handleNormalKeys(msg tea.KeyMsg) tea.Cmd {
 
 func (m *Model) executeConfirmedAction() tea.Cmd {
 	switch m.pendingAction {
+	case "bulk-delete":
+		return deleteNetworksCmd(m.networksList.Marked())
 	case "delete":
 		if m.networkToDelete != nil {
 			return deleteNetworkCmd(m.networkToDelete.ID)
@@ -1047,6 +1024,7 @@ func (m *Model) executeConfirmedAction() tea.Cmd {
 }
 
 func (m *Model) applySorting() {
+	defer m.networksList.PrepareSort()()
 	if len(m.networksList.Filtered) == 0 {
 		return
 	}
@@ -1122,61 +1100,9 @@ func (m *Model) applySorting() {
 }
 
 func (m *Model) setRenderItem() {
-	itemStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("15"))
-
-	m.networksList.RenderItem = func(item networkItem, selected bool, colWidth int) string {
-		style := itemStyle
-		if selected {
-			style = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("0")).
-				Background(lipgloss.Color("63"))
-		}
-
-		width := colWidth
-		if width <= 0 {
-			width = m.networksList.Viewport.Width
-		}
-		if width <= 0 {
-			width = m.width
-		}
-		if width <= 0 {
-			width = 80
-		}
-
-		nameWidth, driverWidth, scopeWidth, usedWidth, idWidth := m.networkColWidths(width)
-		// Match header style: keep a small left padding for the first column so
-		// it doesn't touch the frame border.
-		innerNameWidth := nameWidth
-		if innerNameWidth > 1 {
-			innerNameWidth--
-		}
-		name := " " + truncateWithEllipsis(item.Name, innerNameWidth)
-		driver := truncateWithEllipsis(item.Driver, driverWidth)
-		scope := truncateWithEllipsis(item.Scope, scopeWidth)
-
-		usedText := " "
-		if !item.UsedKnown {
-			usedText = ui.SpinnerCharAt(m.spinner)
-		} else if item.Used {
-			usedText = "●"
-		}
-
-		id := truncateWithEllipsis(item.ID, idWidth)
-
-		sep := strings.Repeat(" ", 2)
-		line := fmt.Sprintf("%-*s%s%-*s%s%-*s%s%-*s%s%-*s",
-			nameWidth, name,
-			sep,
-			driverWidth, driver,
-			sep,
-			scopeWidth, scope,
-			sep,
-			usedWidth, usedText,
-			sep,
-			idWidth, id,
-		)
-
-		return style.Render(line)
+	// Columns come from the view's layout (see columns.go)
+	m.networksList.RenderItem = func(item networkItem, selected bool, _ int) string {
+		return m.columns.Row(item, selected)
 	}
 	m.networksList.Viewport.SetContent(m.networksList.View())
 }
@@ -1213,8 +1139,9 @@ func (m *Model) setUsedByRenderItem() {
 
 // CheckNetworksCmd checks if networks have changed by comparing hashes
 func CheckNetworksCmd(lastHash uint64) tea.Cmd {
-	return func() tea.Msg {
-		networks, err := fetchNetworks()
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
+		networks, err := fetchNetworks(ctx)
 		if err != nil {
 			return NetworksLoadedMsg{Err: err}
 		}
@@ -1247,5 +1174,5 @@ func CheckNetworksCmd(lastHash uint64) tea.Cmd {
 			return NetworksLoadedMsg{Networks: networks}
 		}
 		return nil
-	}
+	})
 }
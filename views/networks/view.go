@@ -9,7 +9,6 @@ import (
 	"swarmcli/ui"
 	"swarmcli/ui/components/errordialog"
 	filterlist "swarmcli/ui/components/filterable/list"
-	"swarmcli/ui/components/sorting"
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
@@ -70,6 +69,8 @@ func (m *Model) View() string {
 	} else if m.errorDialogActive {
 		errorDialog := errordialog.Render(fmt.Sprintf("%v", m.err))
 		content = ui.OverlayCentered(content, errorDialog, width, 0)
+	} else if m.columnPicker.Visible {
+		content = ui.OverlayCentered(content, m.columnPicker.View(), width, 0)
 	}
 
 	title := fmt.Sprintf("Docker Networks (%d)", len(m.networksList.Filtered))
@@ -207,57 +208,9 @@ func (m *Model) renderCreateNetworkDialog(width int) string {
 }
 
 func (m *Model) renderNetworksHeader(items []networkItem, width int) string {
-	if width <= 0 {
-		width = 80
-	}
-
-	nameWidth, driverWidth, scopeWidth, usedWidth, idWidth := m.networkColWidths(width)
-
-	// Store for rendering items (header alignment / other rendering)
-	m.colNameWidth = nameWidth
-	m.colDriverWidth = driverWidth
-	m.colScopeWidth = scopeWidth
-
-	labels := []string{" NAME", "DRIVER", "SCOPE", "USED", "ID"}
-
-	// Add sort indicators
-	arrow := func() string {
-		if m.sortAscending {
-			return sorting.SortArrow(sorting.Ascending)
-		}
-		return sorting.SortArrow(sorting.Descending)
-	}
-
-	if m.sortField == SortByName {
-		labels[0] = fmt.Sprintf(" NAME %s", arrow())
-	}
-	if m.sortField == SortByDriver {
-		labels[1] = fmt.Sprintf("DRIVER %s", arrow())
-	}
-	if m.sortField == SortByScope {
-		labels[2] = fmt.Sprintf("SCOPE %s", arrow())
-	}
-	if m.sortField == SortByUsed {
-		labels[3] = fmt.Sprintf("USED %s", arrow())
-	}
-	if m.sortField == SortByID {
-		labels[4] = fmt.Sprintf("ID %s", arrow())
-	}
-
-	sep := strings.Repeat(" ", 2)
-	line := fmt.Sprintf("%-*s%s%-*s%s%-*s%s%-*s%s%-*s",
-		nameWidth, labels[0],
-		sep,
-		driverWidth, labels[1],
-		sep,
-		scopeWidth, labels[2],
-		sep,
-		usedWidth, labels[3],
-		sep,
-		idWidth, labels[4],
-	)
-
-	return ui.FrameHeaderStyle.Render(line)
+	// Size the columns for this frame; the rows rendered below reuse them.
+	m.columns.Compute(width, items)
+	return ui.FrameHeaderStyle.Render(m.columns.Header(m.sortArrow))
 }
 
 func (m *Model) renderNetworksFooter() string {
@@ -0,0 +1,96 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package nodesview
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"swarmcli/docker"
+	"swarmcli/views/bulkaction"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/swarm"
+)
+
+func nodeName(n docker.NodeEntry) string { return n.Hostname }
+
+// confirmBulk asks for confirmation before running cmd over the marked
+// nodes. The command is kept in pendingBulk until the dialog resolves.
+func (m *Model) confirmBulk(message string, cmd tea.Cmd) {
+	m.pendingBulk = cmd
+	m.confirmDialog.Visible = true
+	m.confirmDialog.ErrorMode = false
+	m.confirmDialog.Message = message
+}
+
+// bulkSetAvailability prepares setting availability on every marked node.
+func (m *Model) bulkSetAvailability(avail swarm.NodeAvailability) {
+	marked := m.List.Marked()
+	m.confirmBulk(
+		bulkaction.ConfirmMessage(fmt.Sprintf("Set availability %q on", avail), "nodes", bulkaction.Names(marked, nodeName), ""),
+		bulkaction.Run(fmt.Sprintf("Set %s on", avail), "nodes", marked, nodeName, func(ctx context.Context, n docker.NodeEntry) error {
+			return docker.SetNodeAvailability(ctx, n.ID, avail)
+		}),
+	)
+}
+
+// bulkAddLabel prepares adding key=value to every marked node.
+func (m *Model) bulkAddLabel(key, value string) {
+	marked := m.List.Marked()
+	m.confirmBulk(
+		bulkaction.ConfirmMessage(fmt.Sprintf("Add label %s=%s to", key, value), "nodes", bulkaction.Names(marked, nodeName), ""),
+		bulkaction.Run(fmt.Sprintf("Labelled %s=%s", key, value), "nodes", marked, nodeName, func(ctx context.Context, n docker.NodeEntry) error {
+			return docker.AddNodeLabel(ctx, n.ID, key, value)
+		}),
+	)
+}
+
+// bulkRemoveLabel prepares removing key from the marked nodes that have it.
+func (m *Model) bulkRemoveLabel(key string) {
+	var targets []docker.NodeEntry
+	for _, n := range m.List.Marked() {
+		if _, ok := n.Labels[key]; ok {
+			targets = append(targets, n)
+		}
+	}
+	m.confirmBulk(
+		bulkaction.ConfirmMessage(fmt.Sprintf("Remove label %q from", key), "nodes", bulkaction.Names(targets, nodeName), ""),
+		bulkaction.Run(fmt.Sprintf("Removed label %s from", key), "nodes", targets, nodeName, func(ctx context.Context, n docker.NodeEntry) error {
+			return docker.RemoveNodeLabel(ctx, n.ID, key)
+		}),
+	)
+}
+
+// markedLabels returns the union of "key=value" labels on the marked nodes.
+func (m *Model) markedLabels() []string {
+	seen := make(map[string]bool)
+	var labels []string
+	for _, n := range m.List.Marked() {
+		for k, v := range n.Labels {
+			kv := k + "=" + v
+			if !seen[kv] {
+				seen[kv] = true
+				labels = append(labels, kv)
+			}
+		}
+	}
+	sort.Strings(labels)
+	return labels
+}
+
+// showBulkResult reports a finished batch and reloads the nodes.
+func (m *Model) showBulkResult(msg bulkaction.DoneMsg) tea.Cmd {
+	m.List.ClearMarks()
+	m.confirmDialog.Visible = true
+	m.confirmDialog.ErrorMode = true
+	m.confirmDialog.Title = "Bulk Result"
+	m.confirmDialog.Message = msg.Summary()
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		if _, err := docker.RefreshSnapshotFor(ctxName); err != nil {
+			l().Warnf("Failed to refresh snapshot: %v", err)
+		}
+		return Msg{Entries: LoadNodes(ctxName)}
+	})
+}
@@ -0,0 +1,213 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package nodesview
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"swarmcli/docker"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/docker/go-units"
+)
+
+// capacityPanelTasks caps the task list of the capacity panel.
+const capacityPanelTasks = 10
+
+var binaryAbbrs = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB"}
+
+func formatCores(nano int64) string {
+	return fmt.Sprintf("%.2f", float64(nano)/1e9)
+}
+
+func formatBytes(n int64) string {
+	return units.CustomSize("%.1f%s", float64(n), 1024.0, binaryAbbrs)
+}
+
+// formatAllocation renders "reserved/capacity pct%".
+func formatAllocation(a docker.Allocation, format func(int64) string) string {
+	if a.Capacity <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%s/%s %.0f%%", format(a.Reserved), format(a.Capacity), a.ReservedPercent())
+}
+
+// formatLimits renders "limits/capacity pct%".
+func formatLimits(a docker.Allocation, format func(int64) string) string {
+	if a.Capacity <= 0 {
+		return "-"
+	}
+	return fmt.Sprintf("%s/%s %.0f%%", format(a.Limits), format(a.Capacity), a.LimitsPercent())
+}
+
+// allocationColor warns as a node fills up: past 90% reserved it will
+// turn away most new tasks with "insufficient resources".
+func allocationColor(pct float64) lipgloss.Color {
+	switch {
+	case pct >= 90:
+		return lipgloss.Color("9")
+	case pct >= 75:
+		return lipgloss.Color("11")
+	}
+	return ""
+}
+
+// schedulable reports whether the scheduler places new tasks on the node.
+func schedulable(n docker.NodeEntry) bool {
+	return n.State == "ready" && n.Availability == "active"
+}
+
+// clusterResources sums the resources of the schedulable nodes.
+func clusterResources(nodes []docker.NodeEntry) (docker.NodeResources, int) {
+	var total docker.NodeResources
+	count := 0
+	for _, n := range nodes {
+		if schedulable(n) {
+			total.Add(n.Resources)
+			count++
+		}
+	}
+	return total, count
+}
+
+// renderClusterTotal is the total line under the nodes table.
+func (m *Model) renderClusterTotal() string {
+	total, count := clusterResources(m.List.Items)
+	line := fmt.Sprintf("Total of %d schedulable node%s: CPU %s, MEM %s reserved",
+		count, plural(count),
+		formatAllocation(total.CPU, formatCores),
+		formatAllocation(total.Memory, formatBytes))
+	if g := total.GenericSummary(); g != "-" {
+		line += ", " + g
+	}
+	return line
+}
+
+// handleCapacityPanelKey closes the capacity panel; moving the cursor
+// keeps it open on the next node.
+func (m *Model) handleCapacityPanelKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "q", "c":
+		m.capacityPanel = false
+	case "up", "down", "k", "j", "pgup", "pgdown", "home", "end":
+		m.List.HandleKey(msg)
+		m.List.Viewport.SetContent(m.List.View())
+	}
+	return nil
+}
+
+// renderCapacityPanel details the selected node's capacity against the
+// reservations and limits of its tasks.
+func (m *Model) renderCapacityPanel() string {
+	node, ok := m.SelectedNode()
+	if !ok {
+		return ""
+	}
+	r := node.Resources
+	contentWidth := 64
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("15")).
+		Background(lipgloss.Color("63")).
+		Padding(0, 1).
+		Width(contentWidth)
+
+	headStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8")).Bold(true)
+	rowStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("15"))
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Italic(true)
+
+	borderStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("63")).
+		Padding(0, 1).
+		Width(contentWidth + 2)
+
+	row := func(name, capacity, reserved, limits string, pct float64) string {
+		text := fmt.Sprintf(" %-10s %-12s %-18s %s", name, capacity, reserved, limits)
+		style := rowStyle
+		if fg := allocationColor(pct); fg != "" {
+			style = style.Foreground(fg)
+		}
+		return style.Render(text)
+	}
+	pctOf := func(a docker.Allocation, v int64, format func(int64) string) string {
+		if a.Capacity <= 0 {
+			return format(v)
+		}
+		return fmt.Sprintf("%s (%.0f%%)", format(v), float64(v)/float64(a.Capacity)*100)
+	}
+
+	var lines []string
+	lines = append(lines, titleStyle.Render(" Capacity: "+node.Hostname))
+	lines = append(lines, "")
+	lines = append(lines, headStyle.Render(fmt.Sprintf(" %-10s %-12s %-18s %s", "RESOURCE", "CAPACITY", "RESERVED", "LIMITS")))
+	lines = append(lines, row("CPU", formatCores(r.CPU.Capacity),
+		pctOf(r.CPU, r.CPU.Reserved, formatCores), pctOf(r.CPU, r.CPU.Limits, formatCores), r.CPU.ReservedPercent()))
+	lines = append(lines, row("Memory", formatBytes(r.Memory.Capacity),
+		pctOf(r.Memory, r.Memory.Reserved, formatBytes), pctOf(r.Memory, r.Memory.Limits, formatBytes), r.Memory.ReservedPercent()))
+
+	kinds := make([]string, 0, len(r.Generic))
+	for k := range r.Generic {
+		kinds = append(kinds, k)
+	}
+	sort.Strings(kinds)
+	count := func(n int64) string { return fmt.Sprint(n) }
+	for _, k := range kinds {
+		a := r.Generic[k]
+		lines = append(lines, row(k, count(a.Capacity), pctOf(a, a.Reserved, count), "-", a.ReservedPercent()))
+	}
+
+	if !schedulable(node) {
+		lines = append(lines, "")
+		lines = append(lines, helpStyle.Render(fmt.Sprintf(" Not schedulable (%s, %s): new tasks go elsewhere", node.State, node.Availability)))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, headStyle.Render(fmt.Sprintf(" %-28s %-16s %s", fmt.Sprintf("TASKS (%d)", len(r.Tasks)), "RESERVED", "LIMITS")))
+	if len(r.Tasks) == 0 {
+		lines = append(lines, helpStyle.Render(" (no tasks)"))
+	}
+	for i, t := range r.Tasks {
+		if i == capacityPanelTasks {
+			lines = append(lines, helpStyle.Render(fmt.Sprintf(" … %d more", len(r.Tasks)-i)))
+			break
+		}
+		lines = append(lines, rowStyle.Render(fmt.Sprintf(" %-28s %-16s %s",
+			truncate(t.Name, 28),
+			taskAmounts(t.CPUReserved, t.MemReserved),
+			taskAmounts(t.CPULimit, t.MemLimit))))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, helpStyle.Render(" ↑/↓ Other node • Esc Close"))
+
+	return borderStyle.Render(strings.Join(lines, "\n"))
+}
+
+// taskAmounts renders a task's CPU and memory, e.g. "0.50 / 256.0MiB".
+func taskAmounts(cpu, mem int64) string {
+	if cpu == 0 && mem == 0 {
+		return "-"
+	}
+	c, mm := "-", "-"
+	if cpu > 0 {
+		c = formatCores(cpu)
+	}
+	if mem > 0 {
+		mm = formatBytes(mem)
+	}
+	return c + " / " + mm
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n-1] + "…"
+}
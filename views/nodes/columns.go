@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package nodesview
+
+import (
+	"fmt"
+	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
+	"swarmcli/ui/components/sorting"
+	"swarmcli/views/columnpicker"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// nodeColumns returns the built-in columns of the nodes table. The labels
+// column follows the view's horizontal label scroll offset.
+func (m *Model) nodeColumns() []columns.Column[docker.NodeEntry] {
+	return []columns.Column[docker.NodeEntry]{
+		{Name: "id", Title: "ID", Min: 12, Value: func(n docker.NodeEntry) string { return n.ID }},
+		{Name: "hostname", Title: "HOSTNAME", Min: 10, Fit: true, Search: true, Value: func(n docker.NodeEntry) string { return n.Hostname }},
+		{Name: "role", Title: "ROLE", Min: 8, Value: func(n docker.NodeEntry) string { return n.Role }},
+		{Name: "state", Title: "STATE", Min: 8, Value: func(n docker.NodeEntry) string { return n.State }},
+		{Name: "availability", Title: "Availability", Min: 8, Value: func(n docker.NodeEntry) string { return n.Availability }},
+		{Name: "manager", Title: "MANAGER", Min: 7, Value: func(n docker.NodeEntry) string {
+			if n.Manager {
+				return "yes"
+			}
+			return "no"
+		}},
+		{Name: "version", Title: "VERSION", Min: 8, Value: func(n docker.NodeEntry) string { return n.Version }},
+		{Name: "address", Title: "ADDRESS", Min: 8, Value: func(n docker.NodeEntry) string { return n.Addr }},
+		{Name: "cpu", Title: "CPU RESERVED", Min: 12,
+			Value: func(n docker.NodeEntry) string { return formatAllocation(n.Resources.CPU, formatCores) },
+			Color: func(n docker.NodeEntry) lipgloss.Color { return allocationColor(n.Resources.CPU.ReservedPercent()) }},
+		{Name: "memory", Title: "MEM RESERVED", Min: 12,
+			Value: func(n docker.NodeEntry) string { return formatAllocation(n.Resources.Memory, formatBytes) },
+			Color: func(n docker.NodeEntry) lipgloss.Color { return allocationColor(n.Resources.Memory.ReservedPercent()) }},
+		{Name: "cpu-limits", Title: "CPU LIMITS", Min: 10, Hidden: true,
+			Value: func(n docker.NodeEntry) string { return formatLimits(n.Resources.CPU, formatCores) }},
+		{Name: "memory-limits", Title: "MEM LIMITS", Min: 10, Hidden: true,
+			Value: func(n docker.NodeEntry) string { return formatLimits(n.Resources.Memory, formatBytes) }},
+		{Name: "generic", Title: "GENERIC", Min: 8, Hidden: true,
+			Value: func(n docker.NodeEntry) string { return n.Resources.GenericSummary() }},
+		{Name: "labels", Title: "LABELS", Min: 8,
+			Value: func(n docker.NodeEntry) string { return formatLabels(n.Labels) },
+			Format: func(n docker.NodeEntry, width int) string {
+				return formatLabelsWithScroll(n.Labels, m.labelsScrollOffset, width)
+			}},
+	}
+}
+
+func (m *Model) newNodeLayout() *columns.Layout[docker.NodeEntry] {
+	layout, err := columns.NewLayout(ViewName, m.nodeColumns(), func(n docker.NodeEntry) map[string]string { return n.Labels })
+	if err != nil {
+		l().Warnf("Failed to load column layout: %v", err)
+	}
+	layout.SelectedBg = lipgloss.Color("63")
+	layout.Terms = m.List.FreeTerms
+	return layout
+}
+
+// sortArrow returns the sort indicator for the given column, if it is the
+// active sort column.
+func (m *Model) sortArrow(name string) string {
+	fields := map[string]SortField{
+		"hostname":     SortByHostname,
+		"role":         SortByRole,
+		"state":        SortByState,
+		"availability": SortByAvailability,
+		"version":      SortByVersion,
+		"address":      SortByAddress,
+		"labels":       SortByLabels,
+		"cpu":          SortByCPU,
+		"memory":       SortByMemory,
+	}
+	if f, ok := fields[name]; !ok || f != m.sortField {
+		return ""
+	}
+	if m.sortAscending {
+		return sorting.SortArrow(sorting.Ascending)
+	}
+	return sorting.SortArrow(sorting.Descending)
+}
+
+func (m *Model) openColumnPicker() {
+	m.columnPicker.Show(ViewName, m.columns.Specs())
+}
+
+// applyColumnLayout applies and persists the picker's result.
+func (m *Model) applyColumnLayout(msg columnpicker.ResultMsg) tea.Cmd {
+	if !msg.Confirmed {
+		return nil
+	}
+	err := m.columns.Apply(msg.Reset, msg.Columns)
+	m.setRenderItem()
+	m.List.Viewport.SetContent(m.List.View())
+
+	if err != nil {
+		l().Errorf("Failed to save column layout: %v", err)
+		m.confirmDialog.Visible = true
+		m.confirmDialog.ErrorMode = true
+		m.confirmDialog.Message = fmt.Sprintf("Failed to save column layout:\n%v", err)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package nodesview
+
+import (
+	"swarmcli/core/clipboard"
+	"swarmcli/docker"
+)
+
+// CopyText returns the hostnames (or IDs with alt) of the marked nodes, or of
+// the node under the cursor.
+func (m *Model) CopyText(alt bool) (string, string) {
+	items := clipboard.Selection(m.List.Marked(), m.List.Filtered, m.List.Cursor)
+	if alt {
+		return clipboard.Describe(len(items), "node ID"), clipboard.Join(items, func(n docker.NodeEntry) string { return n.ID })
+	}
+	return clipboard.Describe(len(items), "hostname"), clipboard.Join(items, func(n docker.NodeEntry) string { return n.Hostname })
+}
@@ -0,0 +1,22 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package nodesview
+
+import (
+	"swarmcli/docker"
+	filterlist "swarmcli/ui/components/filterable/list"
+)
+
+// nodeFilterFields are the attributes addressable from the filter query,
+// e.g. "role:worker state:down label:zone=eu-1".
+var nodeFilterFields = []filterlist.Field[docker.NodeEntry]{
+	{Name: "name", Values: func(n docker.NodeEntry) []string { return []string{n.Hostname} }},
+	{Name: "id", Values: func(n docker.NodeEntry) []string { return []string{n.ID} }},
+	{Name: "role", Values: func(n docker.NodeEntry) []string { return []string{n.Role} }},
+	{Name: "state", Values: func(n docker.NodeEntry) []string { return []string{n.State} }},
+	{Name: "availability", Values: func(n docker.NodeEntry) []string { return []string{n.Availability} }},
+	{Name: "version", Values: func(n docker.NodeEntry) []string { return []string{n.Version} }},
+	{Name: "addr", Values: func(n docker.NodeEntry) []string { return []string{n.Addr} }},
+	{Name: "label", Values: func(n docker.NodeEntry) []string { return filterlist.LabelValues(n.Labels) }, Exact: true},
+}
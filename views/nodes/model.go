@@ -4,10 +4,12 @@
 package nodesview
 
 import (
-	"strings"
+	"swarmcli/core/primitives/fuzzy"
 	"swarmcli/core/primitives/hash"
 	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
 	filterlist "swarmcli/ui/components/filterable/list"
+	"swarmcli/views/columnpicker"
 	"swarmcli/views/confirmdialog"
 	"swarmcli/views/helpbar"
 	"time"
@@ -26,6 +28,8 @@ const (
 	SortByVersion
 	SortByAddress
 	SortByLabels
+	SortByCPU
+	SortByMemory
 )
 
 type Model struct {
@@ -52,6 +56,10 @@ type Model struct {
 	labelRemoveNodeID     string   // Node ID for label remove
 	labelRemoveSelection  int      // Currently selected label to remove
 	labelRemoveLabels     []string // List of "key=value" strings
+	pendingBulk           tea.Cmd  // Bulk action awaiting confirmation
+	columns               *columns.Layout[docker.NodeEntry]
+	columnPicker          *columnpicker.Model
+	capacityPanel         bool // Whether the capacity panel of the selected node is visible
 }
 
 func New(width, height int) *Model {
@@ -60,21 +68,26 @@ func New(width, height int) *Model {
 
 	list := filterlist.FilterableList[docker.NodeEntry]{
 		Viewport: vp,
-		Match: func(n docker.NodeEntry, query string) bool {
-			return strings.Contains(strings.ToLower(n.Hostname), strings.ToLower(query))
+		Fields:   nodeFilterFields,
+		Key:      func(n docker.NodeEntry) string { return n.ID },
+		Score: func(n docker.NodeEntry, query string) (int, bool) {
+			return fuzzy.Score(query, n.Hostname)
 		},
 	}
 
-	return &Model{
+	m := &Model{
 		List:          list,
 		Visible:       false,
 		firstResize:   true,
 		width:         width,
 		height:        height,
 		confirmDialog: confirmdialog.New(width, height),
+		columnPicker:  columnpicker.New(width, height),
 		sortField:     SortByHostname,
 		sortAscending: true,
 	}
+	m.columns = m.newNodeLayout()
+	return m
 }
 
 func (m *Model) Init() tea.Cmd {
@@ -95,12 +108,16 @@ func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
 	return []helpbar.HelpEntry{
 		{Key: "i", Desc: "Inspect"},
 		{Key: "p", Desc: "ps"},
+		{Key: "t", Desc: "Xray"},
 		{Key: "a", Desc: "Availability"},
 		{Key: "Ctrl+L", Desc: "Add label"},
 		{Key: "Ctrl+R", Desc: "Remove label"},
 		{Key: "Ctrl+T", Desc: "Demote node"},
 		{Key: "Ctrl+O", Desc: "Promote node"},
 		{Key: "Ctrl+D", Desc: "Remove node"},
+		{Key: "space", Desc: "Mark"},
+		{Key: "c", Desc: "Capacity"},
+		{Key: "o", Desc: "Columns"},
 		{Key: "↑/↓", Desc: "Navigate"},
 		{Key: "?", Desc: "Help"},
 		{Key: "q", Desc: "Close"},
@@ -109,17 +126,17 @@ func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
 
 // HasActiveDialog reports whether a dialog is currently visible.
 func (m *Model) HasActiveDialog() bool {
-	return m.confirmDialog.Visible || m.errorDialogActive || m.availabilityDialog || m.labelInputDialog || m.labelRemoveDialog
+	return m.confirmDialog.Visible || m.errorDialogActive || m.availabilityDialog || m.labelInputDialog || m.labelRemoveDialog || m.columnPicker.Visible || m.capacityPanel
 }
 
-func LoadNodes() []docker.NodeEntry {
+func LoadNodes(ctxName string) []docker.NodeEntry {
 	// Prefer cached snapshot to avoid blocking the UI. Trigger an async refresh if needed.
-	docker.TriggerRefreshIfNeeded()
+	docker.TriggerRefreshIfNeededFor(ctxName)
 
-	snap := docker.GetSnapshot()
+	snap := docker.SnapshotFor(ctxName)
 	if snap == nil {
 		// Try synchronous refresh as a last resort
-		s, err := docker.RefreshSnapshot()
+		s, err := docker.RefreshSnapshotFor(ctxName)
 		if err != nil {
 			l().Errorf("LoadNodes: RefreshSnapshot failed: %v", err)
 			return []docker.NodeEntry{}
@@ -129,19 +146,21 @@ func LoadNodes() []docker.NodeEntry {
 	return snap.ToNodeEntries()
 }
 
+// LoadNodesCmd loads the nodes of the Docker context of the tab that issued
+// it.
 func LoadNodesCmd() tea.Cmd {
-	return func() tea.Msg {
-		entries := LoadNodes()
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		entries := LoadNodes(ctxName)
 		return Msg{Entries: entries}
-	}
+	})
 }
 
 // CheckNodesCmd checks if nodes have changed and returns update message if so
 func CheckNodesCmd(lastHash uint64) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		l().Info("CheckNodesCmd: Polling for node changes")
 
-		entries := LoadNodes()
+		entries := LoadNodes(ctxName)
 		newHash, err := hash.Compute(entries)
 		if err != nil {
 			l().Errorf("CheckNodesCmd: Compute hash failed: %v", err)
@@ -161,7 +180,7 @@ func CheckNodesCmd(lastHash uint64) tea.Cmd {
 
 		l().Info("CheckNodesCmd: No changes detected, scheduling next poll")
 		return tickCmd()
-	}
+	})
 }
 
 func (m *Model) OnEnter() tea.Cmd {
@@ -177,7 +196,20 @@ func (m *Model) HasActiveFilter() bool {
 	return m.List.Query != ""
 }
 
+// HasMarkedItems reports whether nodes are marked for a bulk action.
+func (m *Model) HasMarkedItems() bool {
+	return m.List.MarkedCount() > 0
+}
+
 // IsSearching reports whether the list is currently in search mode.
 func (m *Model) IsSearching() bool {
 	return m.List.Mode == filterlist.ModeSearching
 }
+
+// SelectedNode returns the node under the cursor.
+func (m *Model) SelectedNode() (docker.NodeEntry, bool) {
+	if m.List.Cursor < 0 || m.List.Cursor >= len(m.List.Filtered) {
+		return docker.NodeEntry{}, false
+	}
+	return m.List.Filtered[m.List.Cursor], true
+}
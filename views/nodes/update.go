@@ -11,6 +11,8 @@ import (
 	"swarmcli/core/primitives/hash"
 	"swarmcli/docker"
 	filterlist "swarmcli/ui/components/filterable/list"
+	"swarmcli/views/bulkaction"
+	"swarmcli/views/columnpicker"
 	"swarmcli/views/confirmdialog"
 	helpview "swarmcli/views/help"
 	inspectview "swarmcli/views/inspect"
@@ -18,7 +20,6 @@ import (
 	"swarmcli/views/view"
 
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
 	"github.com/docker/docker/api/types/swarm"
 )
 
@@ -45,59 +46,68 @@ func getFreshNodeState(nodeID string) *docker.NodeEntry {
 func (m *Model) Update(msg tea.Msg) tea.Cmd {
 	switch msg := msg.(type) {
 	case confirmdialog.ResultMsg:
+		pendingBulk := m.pendingBulk
+		m.pendingBulk = nil
 		if !msg.Confirmed {
 			// User cancelled, just close the dialog
 			m.confirmDialog.Visible = false
 			return nil
 		}
+		if pendingBulk != nil {
+			m.confirmDialog.Visible = false
+			return pendingBulk
+		}
 
 		if m.List.Cursor < len(m.List.Filtered) {
 			node := m.List.Filtered[m.List.Cursor]
 			// Check which action to perform based on message content
 			if strings.Contains(m.confirmDialog.Message, "Demote") {
 				// Run demote, keeping dialog visible during operation
-				return func() tea.Msg {
-					if err := docker.DemoteNode(context.Background(), node.ID); err != nil {
+				return docker.ContextCmd(func(ctxName string) tea.Msg {
+					if err := docker.DemoteNode(docker.WithContextName(context.Background(), ctxName), node.ID); err != nil {
 						return DemoteErrorMsg{NodeID: node.ID, Error: err}
 					}
 					// Force refresh
-					if _, err := docker.RefreshSnapshot(); err != nil {
+					if _, err := docker.RefreshSnapshotFor(ctxName); err != nil {
 						l().Warnf("Failed to refresh snapshot: %v", err)
 					}
 					// Return a message that will close dialog and refresh list
 					return DemoteSuccessMsg{}
-				}
+				})
 			} else if strings.Contains(m.confirmDialog.Message, "Promote") {
 				// Run promote, keeping dialog visible during operation
-				return func() tea.Msg {
-					if err := docker.PromoteNode(context.Background(), node.ID); err != nil {
+				return docker.ContextCmd(func(ctxName string) tea.Msg {
+					if err := docker.PromoteNode(docker.WithContextName(context.Background(), ctxName), node.ID); err != nil {
 						return PromoteErrorMsg{NodeID: node.ID, Error: err}
 					}
 					// Force refresh
-					if _, err := docker.RefreshSnapshot(); err != nil {
+					if _, err := docker.RefreshSnapshotFor(ctxName); err != nil {
 						l().Warnf("Failed to refresh snapshot: %v", err)
 					}
 					// Return a message that will close dialog and refresh list
 					return PromoteSuccessMsg{}
-				}
+				})
 			} else if strings.Contains(m.confirmDialog.Message, "Remove") {
 				// Run remove with force=true, keeping dialog visible during operation
-				return func() tea.Msg {
-					if err := docker.RemoveNode(context.Background(), node.ID, true); err != nil {
+				return docker.ContextCmd(func(ctxName string) tea.Msg {
+					if err := docker.RemoveNode(docker.WithContextName(context.Background(), ctxName), node.ID, true); err != nil {
 						return RemoveErrorMsg{NodeID: node.ID, Error: err}
 					}
 					// Force refresh
-					if _, err := docker.RefreshSnapshot(); err != nil {
+					if _, err := docker.RefreshSnapshotFor(ctxName); err != nil {
 						l().Warnf("Failed to refresh snapshot: %v", err)
 					}
 					// Return a message that will close dialog and refresh list
 					return RemoveSuccessMsg{}
-				}
+				})
 			}
 		}
 		m.confirmDialog.Visible = false
 		return nil
 
+	case bulkaction.DoneMsg:
+		return m.showBulkResult(msg)
+
 	case DemoteErrorMsg:
 		// Reuse confirm dialog to display error
 		m.confirmDialog.Visible = true
@@ -211,6 +221,13 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		// Continue polling even if not visible
 		return tickCmd()
 
+	case columnpicker.OpenMsg:
+		m.openColumnPicker()
+		return nil
+
+	case columnpicker.ResultMsg:
+		return m.applyColumnLayout(msg)
+
 	case tea.WindowSizeMsg:
 		m.List.Viewport.Width = msg.Width
 		m.List.Viewport.Height = msg.Height
@@ -241,6 +258,14 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			return m.confirmDialog.Update(msg)
 		}
 
+		if m.columnPicker.Visible {
+			return m.columnPicker.Update(msg)
+		}
+
+		if m.capacityPanel {
+			return m.handleCapacityPanelKey(msg)
+		}
+
 		// --- if in search mode, handle all keys via FilterableList ---
 		if m.List.Mode == filterlist.ModeSearching {
 			m.List.HandleKey(msg)
@@ -256,6 +281,10 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			m.List.Viewport.GotoTop()
 			return nil
 		}
+		if msg.Type == tea.KeyEsc && m.List.MarkedCount() > 0 {
+			m.List.ClearMarks()
+			return nil
+		}
 
 		// Handle left/right for labels scrolling
 		switch msg.String() {
@@ -295,11 +324,19 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 
 		// Enter triggers inspect / ps
 		switch msg.String() {
+		case "o":
+			m.openColumnPicker()
+			return nil
+		case "c":
+			if m.List.Cursor < len(m.List.Filtered) {
+				m.capacityPanel = true
+			}
+			return nil
 		case "i":
 			if m.List.Cursor < len(m.List.Filtered) {
 				node := m.List.Filtered[m.List.Cursor]
-				return func() tea.Msg {
-					inspectContent, err := docker.Inspect(context.Background(), docker.InspectNode, node.ID)
+				return docker.ContextCmd(func(ctxName string) tea.Msg {
+					inspectContent, err := docker.Inspect(docker.WithContextName(context.Background(), ctxName), docker.InspectNode, node.ID)
 					if err != nil {
 						inspectContent = "Error inspecting node: " + err.Error()
 					}
@@ -310,7 +347,7 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 							"json":  inspectContent,
 						},
 					}
-				}
+				})
 			}
 		case "p":
 			if m.List.Cursor < len(m.List.Filtered) {
@@ -325,6 +362,16 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 					}
 				}
 			}
+		case "t":
+			if m.List.Cursor < len(m.List.Filtered) {
+				node := m.List.Filtered[m.List.Cursor]
+				return func() tea.Msg {
+					return view.NavigateToMsg{
+						ViewName: view.NameXray,
+						Payload:  docker.XrayScope{Node: node.ID},
+					}
+				}
+			}
 		case "?":
 			return func() tea.Msg {
 				return view.NavigateToMsg{
@@ -447,22 +494,64 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			}
 			m.applySorting()
 			return nil
+
+		// Sort by CPU reserved (Shift+C), fullest first
+		case "C":
+			if m.sortField == SortByCPU {
+				m.sortAscending = !m.sortAscending
+			} else {
+				m.sortField = SortByCPU
+				m.sortAscending = false
+			}
+			m.applySorting()
+			return nil
+
+		// Sort by Memory reserved (Shift+M), fullest first
+		case "M":
+			if m.sortField == SortByMemory {
+				m.sortAscending = !m.sortAscending
+			} else {
+				m.sortField = SortByMemory
+				m.sortAscending = false
+			}
+			m.applySorting()
+			return nil
 		case "a":
-			if m.List.Cursor < len(m.List.Filtered) {
+			if m.List.MarkedCount() > 0 {
+				m.availabilityDialog = true
+				m.availabilityNodeID = ""
+				m.availabilitySelection = 0
+			} else if m.List.Cursor < len(m.List.Filtered) {
 				node := m.List.Filtered[m.List.Cursor]
 				m.availabilityDialog = true
 				m.availabilityNodeID = node.ID
 				m.availabilitySelection = 0
 			}
 		case "ctrl+l":
-			if m.List.Cursor < len(m.List.Filtered) {
+			if m.List.MarkedCount() > 0 {
+				m.labelInputDialog = true
+				m.labelInputNodeID = ""
+				m.labelInputValue = ""
+			} else if m.List.Cursor < len(m.List.Filtered) {
 				node := m.List.Filtered[m.List.Cursor]
 				m.labelInputDialog = true
 				m.labelInputNodeID = node.ID
 				m.labelInputValue = ""
 			}
 		case "ctrl+r":
-			if m.List.Cursor < len(m.List.Filtered) {
+			if m.List.MarkedCount() > 0 {
+				labels := m.markedLabels()
+				if len(labels) == 0 {
+					m.confirmDialog.Visible = true
+					m.confirmDialog.ErrorMode = true
+					m.confirmDialog.Message = "Marked nodes have no labels to remove"
+				} else {
+					m.labelRemoveDialog = true
+					m.labelRemoveNodeID = ""
+					m.labelRemoveSelection = 0
+					m.labelRemoveLabels = labels
+				}
+			} else if m.List.Cursor < len(m.List.Filtered) {
 				node := m.List.Filtered[m.List.Cursor]
 				if len(node.Labels) == 0 {
 					m.confirmDialog.Visible = true
@@ -526,102 +615,9 @@ func (m *Model) setRenderItem() {
 		return n.ID
 	}, 15)
 
-	// Use bright white for content and reserve leading space in first column
-	itemStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("15"))
-
-	m.List.RenderItem = func(n docker.NodeEntry, selected bool, colWidth int) string {
-		// Compute proportional column widths for the current viewport width
-		width := m.List.Viewport.Width
-		if width <= 0 {
-			width = 80
-		}
-		cols := 9
-		starts := make([]int, cols)
-		for i := 0; i < cols; i++ {
-			starts[i] = (i * width) / cols
-		}
-		colWidths := make([]int, cols)
-		for i := 0; i < cols; i++ {
-			if i == cols-1 {
-				colWidths[i] = width - starts[i]
-			} else {
-				colWidths[i] = starts[i+1] - starts[i]
-			}
-			if colWidths[i] < 1 {
-				colWidths[i] = 1
-			}
-		}
-		manager := "no"
-		if n.Manager {
-			manager = "yes"
-		}
-		labelsStr := formatLabelsWithScroll(n.Labels, m.labelsScrollOffset, colWidths[7])
-		// Truncate ID so it fits the formatted field width (we render with
-		// a leading space and field width `colWidths[0]-1`). Ensure the
-		// final string length is <= colWidths[0]-1. If we need an ellipsis,
-		// reserve 3 chars for it and trim the core accordingly.
-		idStr := n.ID
-		// safeWidth is the maximum length we can print for the ID (excluding the leading space)
-		safeWidth := 0
-		if colWidths[0] > 0 {
-			safeWidth = colWidths[0] - 1
-		}
-		if safeWidth < 0 {
-			safeWidth = 0
-		}
-		if len(idStr) > safeWidth {
-			// If we can show at least 5 chars, show core + "..." and leave 1
-			// extra char to avoid colliding with the next column: core + "..." so total == safeWidth
-			if safeWidth > 4 {
-				core := safeWidth - 4
-				if core < 0 {
-					core = 0
-				}
-				if core > len(idStr) {
-					core = len(idStr)
-				}
-				idStr = idStr[:core] + "..."
-			} else if safeWidth > 0 {
-				// No room for ellipsis, just trim to fit
-				if safeWidth > len(idStr) {
-					// already fits, no-op
-				} else {
-					idStr = idStr[:safeWidth]
-				}
-			} else {
-				idStr = ""
-			}
-		}
-		// Use the pre-calculated column widths instead of the single colWidth
-		if selected {
-			selBg := lipgloss.Color("63")
-			selStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("230")).Background(selBg).Bold(true)
-			// Preserve leading space for hostname when selected
-			return selStyle.Render(fmt.Sprintf(" %-*s%-*s%-*s%-*s%-*s%-*s%-*s%-*s%-*s",
-				colWidths[0]-1, idStr,
-				colWidths[1], n.Hostname,
-				colWidths[2], n.Role,
-				colWidths[3], n.State,
-				colWidths[4], n.Availability,
-				colWidths[5], manager,
-				colWidths[6], n.Version,
-				colWidths[7], n.Addr,
-				colWidths[8], labelsStr,
-			))
-		}
-
-		// Ensure the first column has a leading space to align with header
-		return itemStyle.Render(fmt.Sprintf(" %-*s%-*s%-*s%-*s%-*s%-*s%-*s%-*s%-*s",
-			colWidths[0]-1, idStr,
-			colWidths[1], n.Hostname,
-			colWidths[2], n.Role,
-			colWidths[3], n.State,
-			colWidths[4], n.Availability,
-			colWidths[5], manager,
-			colWidths[6], n.Version,
-			colWidths[7], n.Addr,
-			colWidths[8], labelsStr,
-		))
+	// Columns come from the view's layout (see columns.go)
+	m.List.RenderItem = func(n docker.NodeEntry, selected bool, _ int) string {
+		return m.columns.Row(n, selected)
 	}
 }
 
@@ -647,7 +643,11 @@ func (m *Model) handleAvailabilityDialogKey(msg tea.KeyMsg) tea.Cmd {
 		availability := []string{"active", "pause", "drain"}[m.availabilitySelection]
 		nodeID := m.availabilityNodeID
 		m.availabilityDialog = false
-		return func() tea.Msg {
+		if nodeID == "" {
+			m.bulkSetAvailability(swarm.NodeAvailability(availability))
+			return nil
+		}
+		return docker.ContextCmd(func(ctxName string) tea.Msg {
 			var avail swarm.NodeAvailability
 			switch availability {
 			case "active":
@@ -657,15 +657,15 @@ func (m *Model) handleAvailabilityDialogKey(msg tea.KeyMsg) tea.Cmd {
 			case "drain":
 				avail = swarm.NodeAvailabilityDrain
 			}
-			if err := docker.SetNodeAvailability(context.Background(), nodeID, avail); err != nil {
+			if err := docker.SetNodeAvailability(docker.WithContextName(context.Background(), ctxName), nodeID, avail); err != nil {
 				return SetAvailabilityErrorMsg{NodeID: nodeID, Error: err}
 			}
 			// Force refresh
-			if _, err := docker.RefreshSnapshot(); err != nil {
+			if _, err := docker.RefreshSnapshotFor(ctxName); err != nil {
 				l().Warnf("Failed to refresh snapshot: %v", err)
 			}
 			return SetAvailabilitySuccessMsg{}
-		}
+		})
 	case "esc", "q":
 		m.availabilityDialog = false
 	}
@@ -711,17 +711,21 @@ func (m *Model) handleLabelInputDialogKey(msg tea.KeyMsg) tea.Cmd {
 		nodeID := m.labelInputNodeID
 		m.labelInputDialog = false
 		m.labelInputValue = ""
+		if nodeID == "" {
+			m.bulkAddLabel(key, value)
+			return nil
+		}
 
-		return func() tea.Msg {
-			if err := docker.AddNodeLabel(context.Background(), nodeID, key, value); err != nil {
+		return docker.ContextCmd(func(ctxName string) tea.Msg {
+			if err := docker.AddNodeLabel(docker.WithContextName(context.Background(), ctxName), nodeID, key, value); err != nil {
 				return AddLabelErrorMsg{NodeID: nodeID, Error: err}
 			}
 			// Force refresh
-			if _, err := docker.RefreshSnapshot(); err != nil {
+			if _, err := docker.RefreshSnapshotFor(ctxName); err != nil {
 				l().Warnf("Failed to refresh snapshot: %v", err)
 			}
 			return AddLabelSuccessMsg{}
-		}
+		})
 	case tea.KeyBackspace:
 		if len(m.labelInputValue) > 0 {
 			m.labelInputValue = m.labelInputValue[:len(m.labelInputValue)-1]
@@ -756,17 +760,21 @@ func (m *Model) handleLabelRemoveDialogKey(msg tea.KeyMsg) tea.Cmd {
 			key := parts[0]
 			nodeID := m.labelRemoveNodeID
 			m.labelRemoveDialog = false
+			if nodeID == "" {
+				m.bulkRemoveLabel(key)
+				return nil
+			}
 
-			return func() tea.Msg {
-				if err := docker.RemoveNodeLabel(context.Background(), nodeID, key); err != nil {
+			return docker.ContextCmd(func(ctxName string) tea.Msg {
+				if err := docker.RemoveNodeLabel(docker.WithContextName(context.Background(), ctxName), nodeID, key); err != nil {
 					return RemoveLabelErrorMsg{NodeID: nodeID, Error: err}
 				}
 				// Force refresh
-				if _, err := docker.RefreshSnapshot(); err != nil {
+				if _, err := docker.RefreshSnapshotFor(ctxName); err != nil {
 					l().Warnf("Failed to refresh snapshot: %v", err)
 				}
 				return RemoveLabelSuccessMsg{}
-			}
+			})
 		}
 	case "esc", "q":
 		m.labelRemoveDialog = false
@@ -782,13 +790,17 @@ func GetNodesHelpContent() []helpview.HelpCategory {
 			Items: []helpview.HelpItem{
 				{Keys: "<i>", Description: "Inspect node"},
 				{Keys: "<p>", Description: "Show services on node"},
+				{Keys: "<t>", Description: "Tree of the tasks and containers on node"},
+				{Keys: "<c>", Description: "Capacity vs reservations of node"},
 				{Keys: "<a>", Description: "Change availability"},
 				{Keys: "<ctrl+l>", Description: "Add label to node"},
 				{Keys: "<ctrl+r>", Description: "Remove label from node"},
 				{Keys: "<ctrl+o>", Description: "Promote to manager"},
 				{Keys: "<ctrl+t>", Description: "Demote to worker"},
 				{Keys: "<ctrl+d>", Description: "Remove node"},
-				{Keys: "</>", Description: "Filter"},
+				{Keys: "</>", Description: "Filter (e.g. role:worker label:zone=eu-1)"},
+				{Keys: "<space>", Description: "Mark for bulk availability/label changes"},
+				{Keys: "<ctrl+a>", Description: "Mark all filtered"},
 			},
 		},
 		{
@@ -801,6 +813,9 @@ func GetNodesHelpContent() []helpview.HelpCategory {
 				{Keys: "<shift+v>", Description: "Order by Version"},
 				{Keys: "<shift+d>", Description: "Order by Address"},
 				{Keys: "<shift+l>", Description: "Order by Labels"},
+				{Keys: "<shift+c>", Description: "Order by CPU reserved"},
+				{Keys: "<shift+m>", Description: "Order by Memory reserved"},
+				{Keys: "<o>", Description: "Columns: show/hide, reorder, pin, add label columns"},
 			},
 		},
 		{
@@ -809,6 +824,10 @@ func GetNodesHelpContent() []helpview.HelpCategory {
 				{Keys: "<↑/↓>", Description: "Navigate"},
 				{Keys: "<pgup>", Description: "Page up"},
 				{Keys: "<pgdown>", Description: "Page down"},
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
+				{Keys: "<|>", Description: "Split with a linked pane"},
+				{Keys: "<tab>", Description: "Switch pane focus"},
 				{Keys: "<q>", Description: "Back to stacks"},
 			},
 		},
@@ -817,6 +836,7 @@ func GetNodesHelpContent() []helpview.HelpCategory {
 
 // applySorting applies the current sort configuration to the filtered list
 func (m *Model) applySorting() {
+	defer m.List.PrepareSort()()
 	if len(m.List.Filtered) == 0 {
 		return
 	}
@@ -888,6 +908,24 @@ func (m *Model) applySorting() {
 			}
 			return labelsI > labelsJ
 		})
+	case SortByCPU:
+		sort.SliceStable(m.List.Filtered, func(i, j int) bool {
+			pctI := m.List.Filtered[i].Resources.CPU.ReservedPercent()
+			pctJ := m.List.Filtered[j].Resources.CPU.ReservedPercent()
+			if m.sortAscending {
+				return pctI < pctJ
+			}
+			return pctI > pctJ
+		})
+	case SortByMemory:
+		sort.SliceStable(m.List.Filtered, func(i, j int) bool {
+			pctI := m.List.Filtered[i].Resources.Memory.ReservedPercent()
+			pctJ := m.List.Filtered[j].Resources.Memory.ReservedPercent()
+			if m.sortAscending {
+				return pctI < pctJ
+			}
+			return pctI > pctJ
+		})
 	}
 
 	// Restore cursor position
@@ -10,7 +10,6 @@ import (
 	"swarmcli/docker"
 	"swarmcli/ui"
 	filterlist "swarmcli/ui/components/filterable/list"
-	"swarmcli/ui/components/sorting"
 
 	"github.com/charmbracelet/lipgloss"
 )
@@ -29,59 +28,6 @@ func (m *Model) View() string {
 	}
 
 	title := fmt.Sprintf("Nodes (%d total, %d manager%s)", total, managers, plural(managers))
-	// Compute proportional column widths (9 equal partitions) so header aligns with items
-	labels := []string{"ID", "HOSTNAME", "ROLE", "STATE", "Availability", "MANAGER", "VERSION", "ADDRESS", "LABELS"}
-
-	// Add sort indicators to labels
-	if m.sortField == SortByHostname {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		labels[1] = fmt.Sprintf("HOSTNAME %s", arrow)
-	}
-	if m.sortField == SortByRole {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		labels[2] = fmt.Sprintf("ROLE %s", arrow)
-	}
-	if m.sortField == SortByState {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		labels[3] = fmt.Sprintf("STATE %s", arrow)
-	}
-	if m.sortField == SortByAvailability {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		labels[4] = fmt.Sprintf("Availability %s", arrow)
-	}
-	if m.sortField == SortByVersion {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		labels[6] = fmt.Sprintf("VERSION %s", arrow)
-	}
-	if m.sortField == SortByAddress {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		labels[7] = fmt.Sprintf("ADDRESS %s", arrow)
-	}
-	if m.sortField == SortByLabels {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		labels[8] = fmt.Sprintf("LABELS %s", arrow)
-	}
 	width := m.List.Viewport.Width
 	if width <= 0 {
 		if m.width > 0 {
@@ -90,29 +36,13 @@ func (m *Model) View() string {
 			width = 80
 		}
 	}
-	cols := 9
-	starts := make([]int, cols)
-	for i := 0; i < cols; i++ {
-		starts[i] = (i * width) / cols
-	}
-	colWidths := make([]int, cols)
-	for i := 0; i < cols; i++ {
-		if i == cols-1 {
-			colWidths[i] = width - starts[i]
-		} else {
-			colWidths[i] = starts[i+1] - starts[i]
-		}
-		if colWidths[i] < 1 {
-			colWidths[i] = 1
-		}
-	}
-	// Prefix first label with a leading space to match item alignment
-	labels[0] = " " + labels[0]
-	header := ui.RenderColumnHeader(labels, colWidths)
+	// Size the columns for this frame; the rows rendered below reuse them.
+	m.columns.Compute(width, m.List.Items)
+	header := ui.FrameHeaderStyle.Render(m.columns.Header(m.sortArrow))
 
 	// Footer: cursor + optional search query
 	status := fmt.Sprintf("Node %d of %d", m.List.Cursor+1, len(m.List.Filtered))
-	statusBar := ui.StatusBarStyle.Render(status)
+	statusBar := ui.StatusBarStyle.Render(m.renderClusterTotal()) + "\n" + ui.StatusBarStyle.Render(status)
 
 	var footer string
 	if m.List.Mode == filterlist.ModeSearching {
@@ -151,6 +81,10 @@ func (m *Model) View() string {
 		framed = ui.OverlayCentered(framed, m.renderAvailabilityDialog(), frame.FrameWidth, frame.FrameHeight)
 	} else if m.confirmDialog.Visible {
 		framed = ui.OverlayCentered(framed, m.confirmDialog.View(), frame.FrameWidth, frame.FrameHeight)
+	} else if m.columnPicker.Visible {
+		framed = ui.OverlayCentered(framed, m.columnPicker.View(), frame.FrameWidth, frame.FrameHeight)
+	} else if m.capacityPanel {
+		framed = ui.OverlayCentered(framed, m.renderCapacityPanel(), frame.FrameWidth, frame.FrameHeight)
 	}
 
 	return framed
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package pulseview
+
+import (
+	"swarmcli/core/pulse"
+	"swarmcli/docker"
+	helpview "swarmcli/views/help"
+	"swarmcli/views/helpbar"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Model struct {
+	context string
+	rec     *pulse.Recorder
+	width   int
+	height  int
+}
+
+// New returns a dashboard of the active context.
+func New(width, height int) *Model {
+	context, _ := docker.ActiveContext()
+	return &Model{
+		context: context,
+		rec:     pulse.For(context),
+		width:   width,
+		height:  height,
+	}
+}
+
+func (m *Model) Init() tea.Cmd { return nil }
+
+func (m *Model) Name() string { return ViewName }
+
+func (m *Model) OnEnter() tea.Cmd { return nil }
+func (m *Model) OnExit() tea.Cmd  { return nil }
+
+func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
+	return []helpbar.HelpEntry{
+		{Key: "?", Desc: "Help"},
+		{Key: "q", Desc: "Close"},
+	}
+}
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+	case tea.KeyMsg:
+		if msg.String() == "?" {
+			return func() tea.Msg {
+				return view.NavigateToMsg{
+					ViewName: view.NameHelp,
+					Payload:  GetPulseHelpContent(),
+				}
+			}
+		}
+	}
+	// Samples arrive as pulse.SampledMsg; View reads the recorder directly
+	return nil
+}
+
+// GetPulseHelpContent returns categorized help for the pulse view
+func GetPulseHelpContent() []helpview.HelpCategory {
+	return []helpview.HelpCategory{
+		{
+			Title: "Pulse",
+			Items: []helpview.HelpItem{
+				{Keys: "Tasks", Description: "Running vs desired tasks across all services"},
+				{Keys: "Nodes", Description: "Ready nodes vs all nodes"},
+				{Keys: "CPU/Memory", Description: "Usage sampled by the status header"},
+				{Keys: "Transitions", Description: "Task state changes per minute"},
+				{Keys: "Failures", Description: "Tasks failed or rejected per minute"},
+				{Keys: "Events", Description: "Docker events per minute"},
+			},
+		},
+		{
+			Title: "Navigation",
+			Items: []helpview.HelpItem{
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
+				{Keys: "<q>", Description: "Close"},
+			},
+		},
+	}
+}
@@ -0,0 +1,8 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package pulseview is the :pulse dashboard: sparklines and gauges of the
+// metrics recorded by core/pulse over the session.
+package pulseview
+
+const ViewName = "pulse"
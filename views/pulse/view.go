@@ -0,0 +1,193 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package pulseview
+
+import (
+	"fmt"
+	"strings"
+	"swarmcli/core/pulse"
+	"swarmcli/ui"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	labelStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("214")).Bold(true)
+	valueStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Bold(true)
+	sparkStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("81"))
+	emptyStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("238"))
+	okColor    = lipgloss.Color("42")
+	warnColor  = lipgloss.Color("214")
+	errColor   = lipgloss.Color("196")
+)
+
+var sparkRunes = []rune("▁▂▃▄▅▆▇█")
+
+// panel is one tile of the dashboard.
+type panel struct {
+	title string
+	value string
+	// gauge is the fill ratio; negative hides the gauge
+	gauge float64
+	color lipgloss.Color
+	spark []float64
+	// sparkMax scales the sparkline; zero scales to the largest sample
+	sparkMax float64
+}
+
+func (m *Model) panels(n int) []panel {
+	r := m.rec
+	last := func(metric pulse.Metric) (float64, bool) { return r.Last(metric) }
+
+	tasks := panel{title: "Tasks running / desired", value: "–", gauge: -1, spark: r.Values(pulse.TasksRunning, n)}
+	if running, ok := last(pulse.TasksRunning); ok {
+		desired, _ := last(pulse.TasksDesired)
+		tasks.value = fmt.Sprintf("%.0f / %.0f", running, desired)
+		tasks.gauge, tasks.color = ratio(running, desired)
+		tasks.sparkMax = maxOf(r.Values(pulse.TasksDesired, n))
+	}
+
+	nodes := panel{title: "Nodes ready", value: "–", gauge: -1, spark: r.Values(pulse.NodesReady, n)}
+	if ready, ok := last(pulse.NodesReady); ok {
+		total, _ := last(pulse.NodesTotal)
+		nodes.value = fmt.Sprintf("%.0f / %.0f", ready, total)
+		nodes.gauge, nodes.color = ratio(ready, total)
+		nodes.sparkMax = maxOf(r.Values(pulse.NodesTotal, n))
+	}
+
+	usage := func(title string, metric pulse.Metric) panel {
+		p := panel{title: title, value: "–", gauge: -1, spark: r.Values(metric, n), sparkMax: 100}
+		if v, ok := last(metric); ok {
+			p.value = fmt.Sprintf("%.1f%%", v)
+			p.gauge = v / 100
+			p.color = okColor
+			switch {
+			case v >= 90:
+				p.color = errColor
+			case v >= 70:
+				p.color = warnColor
+			}
+		}
+		return p
+	}
+
+	rate := func(title string, metric pulse.Metric, alert bool) panel {
+		p := panel{title: title, value: "–", gauge: -1, spark: r.Values(metric, n)}
+		if v, ok := last(metric); ok {
+			p.value = fmt.Sprintf("%.1f/min", v)
+			if alert && v > 0 {
+				p.color = errColor
+			}
+		}
+		return p
+	}
+
+	return []panel{
+		tasks,
+		nodes,
+		usage("CPU", pulse.CPU),
+		usage("Memory", pulse.Memory),
+		rate("Task transitions", pulse.Transitions, false),
+		rate("Failed tasks", pulse.Failures, true),
+		rate("Events", pulse.Events, false),
+	}
+}
+
+// ratio returns have/want as a gauge fill with its colour.
+func ratio(have, want float64) (float64, lipgloss.Color) {
+	if want <= 0 {
+		return 1, okColor
+	}
+	f := have / want
+	switch {
+	case f >= 1:
+		return f, okColor
+	case f >= 0.9:
+		return f, warnColor
+	}
+	return f, errColor
+}
+
+func maxOf(values []float64) float64 {
+	m := 0.0
+	for _, v := range values {
+		m = max(m, v)
+	}
+	return m
+}
+
+// sparkline draws values right-aligned in width cells.
+func sparkline(values []float64, width int, top float64) string {
+	if top <= 0 {
+		top = maxOf(values)
+	}
+	values = values[max(len(values)-width, 0):]
+	var b strings.Builder
+	for _, v := range values {
+		i := 0
+		if top > 0 {
+			i = int(v / top * float64(len(sparkRunes)-1))
+		}
+		b.WriteRune(sparkRunes[max(0, min(i, len(sparkRunes)-1))])
+	}
+	return emptyStyle.Render(strings.Repeat("·", width-len(values))) + sparkStyle.Render(b.String())
+}
+
+// gauge draws a horizontal bar filled to f.
+func gauge(f float64, width int, color lipgloss.Color) string {
+	filled := int(max(0, min(f, 1)) * float64(width))
+	return lipgloss.NewStyle().Foreground(color).Render(strings.Repeat("█", filled)) +
+		emptyStyle.Render(strings.Repeat("░", width-filled))
+}
+
+func (p panel) render(width int) string {
+	value := valueStyle.Render(p.value)
+	if p.color != "" {
+		value = valueStyle.Foreground(p.color).Render(p.value)
+	}
+	pad := max(width-lipgloss.Width(p.title)-lipgloss.Width(p.value), 1)
+	lines := []string{labelStyle.Render(p.title) + strings.Repeat(" ", pad) + value}
+	if p.gauge >= 0 {
+		lines = append(lines, gauge(p.gauge, width, p.color))
+	} else {
+		lines = append(lines, "")
+	}
+	lines = append(lines, sparkline(p.spark, width, p.sparkMax))
+	return strings.Join(lines, "\n")
+}
+
+func (m *Model) View() string {
+	width := max(m.width, 40)
+
+	cols := 1
+	if width >= 100 {
+		cols = 2
+	}
+	// Columns are separated by a four-cell gap
+	panelWidth := (width - 4*(cols-1)) / cols
+
+	panels := m.panels(panelWidth)
+	var rows []string
+	for i := 0; i < len(panels); i += cols {
+		var cells []string
+		for j := i; j < min(i+cols, len(panels)); j++ {
+			cell := lipgloss.NewStyle().Width(panelWidth).Render(panels[j].render(panelWidth))
+			if j > i {
+				cell = lipgloss.NewStyle().PaddingLeft(4).Render(cell)
+			}
+			cells = append(cells, cell)
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, cells...), "")
+	}
+
+	started := m.rec.Started()
+	title := fmt.Sprintf("Pulse — %s", m.context)
+	footer := ui.StatusBarStyle.Render(fmt.Sprintf("Recording since %s (%s), one sample per tick",
+		started.Format("15:04"), time.Since(started).Truncate(time.Second)))
+
+	frame := ui.ComputeFrameDimensions(width, m.height, width, m.height, "", footer)
+	content := ui.TrimOrPadContentToLines(strings.Join(rows, "\n"), frame.DesiredContentLines)
+	return ui.RenderFramedBox(title, "", content, footer, frame.FrameWidth)
+}
@@ -77,8 +77,8 @@ func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
 }
 
 func LoadSecret(name string) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
 
 		// Look up secret to get its ID
 		secret, err := docker.InspectSecret(ctx, name)
@@ -100,7 +100,7 @@ func LoadSecret(name string) tea.Cmd {
 		}
 		// Always clean up the temporary service.
 		defer func() {
-			_ = docker.RemoveService(serviceName)
+			_ = docker.RemoveService(ctx, serviceName)
 		}()
 
 		// Wait for logs to appear. A fixed sleep is racy (image pull / scheduling).
@@ -157,7 +157,7 @@ func LoadSecret(name string) tea.Cmd {
 		}
 
 		return revealedMsg{Content: content, Decoded: decoded}
-	}
+	})
 }
 
 func isPrintable(s string) bool {
@@ -14,6 +14,7 @@ import (
 	"strings"
 	"swarmcli/core/primitives/hash"
 	"swarmcli/docker"
+	"swarmcli/views/bulkaction"
 	inspectview "swarmcli/views/inspect"
 	"swarmcli/views/view"
 
@@ -24,8 +25,8 @@ import (
 // --- Async commands ---
 
 func loadSecretsCmd() tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
 		secs, err := docker.ListSecrets(ctx)
 		if err != nil {
 			return errorMsg(fmt.Errorf("failed to list secrets: %w", err))
@@ -36,15 +37,15 @@ func loadSecretsCmd() tea.Cmd {
 			wrapped[i] = docker.SecretWithDecodedData{Secret: s, Data: nil}
 		}
 		return secretsLoadedMsg(wrapped)
-	}
+	})
 }
 
 // computeSecretUsedCmd checks which secrets are used by services in background
 // and returns a usedStatusUpdatedMsg containing a map[id]bool.
 func computeSecretUsedCmd(secs []docker.SecretWithDecodedData) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		usedMap := make(map[string]bool, len(secs))
-		ctx := context.Background()
+		ctx := docker.WithContextName(context.Background(), ctxName)
 		for _, s := range secs {
 			usedMap[s.Secret.ID] = false
 			svcs, err := docker.ListServicesUsingSecretID(ctx, s.Secret.ID)
@@ -53,15 +54,15 @@ func computeSecretUsedCmd(secs []docker.SecretWithDecodedData) tea.Cmd {
 			}
 		}
 		return usedStatusUpdatedMsg(usedMap)
-	}
+	})
 }
 
 // CheckSecretsCmd checks if secrets have changed and returns update message if so
 func CheckSecretsCmd(lastHash uint64) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		l().Info("CheckSecretsCmd: Polling for secret changes")
 
-		ctx := context.Background()
+		ctx := docker.WithContextName(context.Background(), ctxName)
 		secs, err := docker.ListSecrets(ctx)
 		if err != nil {
 			l().Errorf("CheckSecretsCmd: ListSecrets failed: %v", err)
@@ -107,12 +108,12 @@ func CheckSecretsCmd(lastHash uint64) tea.Cmd {
 		l().Info("CheckSecretsCmd: No changes detected, scheduling next poll")
 		// Schedule next poll in 5 seconds
 		return tickCmd()
-	}
+	})
 }
 
 func inspectSecretCmd(name string) tea.Cmd {
-	return func() tea.Msg {
-		sec, err := docker.InspectSecret(context.Background(), name)
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		sec, err := docker.InspectSecret(docker.WithContextName(context.Background(), ctxName), name)
 		jsonStr := ""
 		if err != nil {
 			jsonStr = fmt.Sprintf("Error inspecting secret %q: %v", name, err)
@@ -134,7 +135,7 @@ func inspectSecretCmd(name string) tea.Cmd {
 				},
 			},
 		}
-	}
+	})
 }
 
 func pushRevealViewCmd(name string) tea.Cmd {
@@ -149,15 +150,22 @@ func pushRevealViewCmd(name string) tea.Cmd {
 	}
 }
 
+// deleteSecretsCmd deletes every given secret and reports per-item results.
+func deleteSecretsCmd(items []secretItem) tea.Cmd {
+	return bulkaction.Run("Deleted", "secrets", items, secretName, func(ctx context.Context, sec secretItem) error {
+		return docker.DeleteSecret(ctx, sec.ID)
+	})
+}
+
 func deleteSecretCmd(name string) tea.Cmd {
-	return func() tea.Msg {
-		ctx := context.Background()
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
 		err := docker.DeleteSecret(ctx, name)
 		if err != nil {
 			return errorMsg(fmt.Errorf("failed to delete secret %q: %w", name, err))
 		}
 		return secretDeletedMsg{Name: name}
-	}
+	})
 }
 
 func loadFilesCmd(dirPath string) tea.Cmd {
@@ -210,7 +218,7 @@ func loadFilesCmd(dirPath string) tea.Cmd {
 }
 
 func createSecretFromFileCmd(name, filePath string, labels map[string]string, encode bool) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		l().Infof("Creating secret %s from file %s (encode=%v, labels=%v)", name, filePath, encode, labels)
 
 		// Read file content
@@ -234,7 +242,7 @@ func createSecretFromFileCmd(name, filePath string, labels map[string]string, en
 		}
 
 		// Create the secret
-		ctx := context.Background()
+		ctx := docker.WithContextName(context.Background(), ctxName)
 		newSec, err := docker.CreateSecret(ctx, name, data, labels)
 		if err != nil {
 			l().Errorf("Failed to create secret %s: %v", name, err)
@@ -246,11 +254,11 @@ func createSecretFromFileCmd(name, filePath string, labels map[string]string, en
 			Name:   name,
 			Secret: docker.SecretWithDecodedData{Secret: newSec, Data: nil},
 		}
-	}
+	})
 }
 
 func createSecretFromContentCmd(name string, content []byte, labels map[string]string, encode bool) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		l().Infof("Creating secret %s from inline content (encode=%v, labels=%v)", name, encode, labels)
 
 		// Base64 encode if requested
@@ -267,7 +275,7 @@ func createSecretFromContentCmd(name string, content []byte, labels map[string]s
 		}
 
 		// Create the secret
-		ctx := context.Background()
+		ctx := docker.WithContextName(context.Background(), ctxName)
 		newSec, err := docker.CreateSecret(ctx, name, content, labels)
 		if err != nil {
 			l().Errorf("Failed to create secret %s: %v", name, err)
@@ -279,14 +287,14 @@ func createSecretFromContentCmd(name string, content []byte, labels map[string]s
 			Name:   name,
 			Secret: docker.SecretWithDecodedData{Secret: newSec, Data: nil},
 		}
-	}
+	})
 }
 
 func getUsedByStacksCmd(secretName string) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		l().Infof("Getting stacks/services that use secret: %s", secretName)
 
-		ctx := context.Background()
+		ctx := docker.WithContextName(context.Background(), ctxName)
 		// Get secret ID for robust matching
 		sec, err := docker.InspectSecret(ctx, secretName)
 		if err != nil {
@@ -339,7 +347,7 @@ func getUsedByStacksCmd(secretName string) tea.Cmd {
 		l().Infof("Secret %s is used by %d service(s)", secretName, len(usedBy))
 
 		return usedByMsg{SecretName: secretName, UsedBy: usedBy}
-	}
+	})
 }
 
 // openEditorForContentCmd opens the user's editor to edit secret content
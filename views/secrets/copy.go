@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package secretsview
+
+import "swarmcli/core/clipboard"
+
+// CopyText returns the names (or IDs with alt) of the marked secrets, or of the
+// secret under the cursor. In the "used by" list it copies service names.
+func (m *Model) CopyText(alt bool) (string, string) {
+	if m.usedByViewActive {
+		items := clipboard.Selection(nil, m.usedByList.Filtered, m.usedByList.Cursor)
+		return clipboard.Describe(len(items), "service name"), clipboard.Join(items, func(i usedByItem) string { return i.ServiceName })
+	}
+	items := clipboard.Selection(m.secretsList.Marked(), m.secretsList.Filtered, m.secretsList.Cursor)
+	if alt {
+		return clipboard.Describe(len(items), "secret ID"), clipboard.Join(items, func(i secretItem) string { return i.ID })
+	}
+	return clipboard.Describe(len(items), "secret name"), clipboard.Join(items, secretName)
+}
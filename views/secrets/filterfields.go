@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package secretsview
+
+import filterlist "swarmcli/ui/components/filterable/list"
+
+// secretFilterFields are the attributes addressable from the filter query,
+// e.g. "label:env=prod used:no".
+var secretFilterFields = []filterlist.Field[secretItem]{
+	{Name: "name", Values: func(s secretItem) []string { return []string{s.Name} }},
+	{Name: "id", Values: func(s secretItem) []string { return []string{s.ID} }},
+	{Name: "used", Values: func(s secretItem) []string { return []string{usedValue(s.Used, s.UsedKnown)} }, Exact: true},
+	{Name: "label", Values: func(s secretItem) []string { return filterlist.LabelValues(s.Labels) }, Exact: true},
+}
+
+func usedValue(used, known bool) string {
+	switch {
+	case !known:
+		return "unknown"
+	case used:
+		return "yes"
+	default:
+		return "no"
+	}
+}
+
+func secretName(s secretItem) string { return s.Name }
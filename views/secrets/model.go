@@ -7,8 +7,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"swarmcli/core/primitives/fuzzy"
 	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
 	filterlist "swarmcli/ui/components/filterable/list"
+	"swarmcli/views/columnpicker"
 	"swarmcli/views/confirmdialog"
 	"swarmcli/views/helpbar"
 	loading "swarmcli/views/loading"
@@ -80,9 +83,9 @@ type Model struct {
 	revealViewport      viewport.Model
 	revealingInProgress bool // true while waiting for secret to be revealed
 
-	// Cached column widths for header alignment
-	colNameWidth int
-	colIdWidth   int
+	// Column layout of the secrets table
+	columns      *columns.Layout[secretItem]
+	columnPicker *columnpicker.Model
 
 	// Spinner for slow-used-status indicator
 	spinner int
@@ -107,10 +110,10 @@ func New(width, height int) *Model {
 
 	list := filterlist.FilterableList[secretItem]{
 		Viewport: vp,
-		Match: func(s secretItem, query string) bool {
-			q := strings.ToLower(query)
-			return strings.Contains(strings.ToLower(s.Name), q) ||
-				strings.Contains(strings.ToLower(s.ID), q)
+		Fields:   secretFilterFields,
+		Key:      func(s secretItem) string { return s.ID },
+		Score: func(s secretItem, query string) (int, bool) {
+			return fuzzy.Score(query, s.Name, s.ID)
 		},
 	}
 
@@ -138,7 +141,7 @@ func New(width, height int) *Model {
 	// Initialize viewport for reveal dialog - use full dimensions like inspect
 	revealVp := viewport.New(width, height)
 
-	return &Model{
+	m := &Model{
 		secretsList:        list,
 		width:              width,
 		height:             height,
@@ -154,12 +157,15 @@ func New(width, height int) *Model {
 		revealViewport:     revealVp,
 		sortField:          SortByName,
 		sortAscending:      true,
+		columnPicker:       columnpicker.New(width, height),
 	}
+	m.columns = m.newSecretLayout()
+	return m
 }
 
 // HasActiveDialog returns true if any dialog is currently active
 func (m *Model) HasActiveDialog() bool {
-	return m.revealDialogActive || m.createDialogActive || m.fileBrowserActive || m.confirmDialog.Visible || m.errorDialogActive
+	return m.revealDialogActive || m.createDialogActive || m.fileBrowserActive || m.confirmDialog.Visible || m.errorDialogActive || m.columnPicker.Visible
 }
 
 // IsInUsedByView returns true if the UsedBy view is currently active
@@ -175,6 +181,11 @@ func (m *Model) HasActiveFilter() bool {
 }
 
 // IsSearching reports whether the secrets or UsedBy list is in search mode.
+// HasMarkedItems reports whether secrets are marked for a bulk delete.
+func (m *Model) HasMarkedItems() bool {
+	return m.secretsList.MarkedCount() > 0
+}
+
 func (m *Model) IsSearching() bool {
 	if m.usedByViewActive {
 		return m.usedByList.Mode == filterlist.ModeSearching
@@ -220,6 +231,8 @@ func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
 		{Key: "x", Desc: "Reveal"},
 		{Key: "u", Desc: "Used By"},
 		{Key: "ctrl+d", Desc: "Delete"},
+		{Key: "space", Desc: "Mark"},
+		{Key: "o", Desc: "Columns"},
 		{Key: "?", Desc: "Help"},
 		{Key: "esc/q", Desc: "Back"},
 	}
@@ -10,9 +10,11 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"swarmcli/core/primitives/fuzzy"
 	"swarmcli/core/primitives/hash"
-	"swarmcli/ui"
 	filterlist "swarmcli/ui/components/filterable/list"
+	"swarmcli/views/bulkaction"
+	"swarmcli/views/columnpicker"
 	"swarmcli/views/confirmdialog"
 	helpview "swarmcli/views/help"
 	loading "swarmcli/views/loading"
@@ -88,6 +90,13 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		m.secretsList.Viewport.SetContent(m.secretsList.View())
 		return nil
 
+	case columnpicker.OpenMsg:
+		m.openColumnPicker()
+		return nil
+
+	case columnpicker.ResultMsg:
+		return m.applyColumnLayout(msg)
+
 	case tea.WindowSizeMsg:
 		m.secretsList.Viewport.Width = msg.Width
 		m.secretsList.Viewport.Height = msg.Height
@@ -166,6 +175,13 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		}
 		return tickCmd()
 
+	case bulkaction.DoneMsg:
+		m.secretsList.ClearMarks()
+		m.confirmDialog.ErrorMode = true
+		m.confirmDialog.Title = "Bulk Result"
+		m.confirmDialog.Show(msg.Summary())
+		return loadSecretsCmd()
+
 	case secretDeletedMsg:
 		l().Infof("Secret deleted successfully: %s", msg.Name)
 		return loadSecretsCmd()
@@ -220,9 +236,8 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 
 		m.usedByList = filterlist.FilterableList[usedByItem]{
 			Viewport: vp,
-			Match: func(item usedByItem, query string) bool {
-				return strings.Contains(strings.ToLower(item.StackName), strings.ToLower(query)) ||
-					strings.Contains(strings.ToLower(item.ServiceName), strings.ToLower(query))
+			Score: func(item usedByItem, query string) (int, bool) {
+				return fuzzy.Score(query, item.StackName, item.ServiceName)
 			},
 			RenderItem: func(item usedByItem, selected bool, _ int) string {
 				width := vp.Width
@@ -344,6 +359,7 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		defer func() {
 			m.pendingAction = ""
 			m.confirmDialog.Visible = false
+			m.confirmDialog.ErrorMode = false
 			m.secretToDelete = nil
 		}()
 		if !msg.Confirmed {
@@ -353,6 +369,10 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		}
 
 		switch m.pendingAction {
+		case "bulk-delete":
+			marked := m.secretsList.Marked()
+			l().Infof("Confirmed deletion of %d secrets", len(marked))
+			return deleteSecretsCmd(marked)
 		case "delete":
 			if m.secretToDelete == nil {
 				l().Warnln("Confirmed delete but secretToDelete is nil")
@@ -397,6 +417,10 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			return m.confirmDialog.Update(msg)
 		}
 
+		if m.columnPicker.Visible {
+			return m.columnPicker.Update(msg)
+		}
+
 		// --- if in search mode, handle all keys via FilterableList ---
 		if m.secretsList.Mode == filterlist.ModeSearching {
 			m.secretsList.HandleKey(msg)
@@ -412,10 +436,20 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			m.secretsList.Viewport.GotoTop()
 			return nil
 		}
+		if msg.Type == tea.KeyEsc && m.secretsList.MarkedCount() > 0 {
+			m.secretsList.ClearMarks()
+			m.secretsList.Viewport.SetContent(m.secretsList.View())
+			return nil
+		}
 
 		// Handle specific keys in switch, then navigation keys
 		switch msg.String() {
 		case "ctrl+d":
+			if marked := m.secretsList.Marked(); len(marked) > 0 {
+				m.pendingAction = "bulk-delete"
+				m.confirmDialog.Show(bulkaction.ConfirmMessage("Delete", "secrets", bulkaction.Names(marked, secretName), ""))
+				return nil
+			}
 			if len(m.secretsList.Filtered) == 0 {
 				return nil
 			}
@@ -479,6 +513,9 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			m.createDialogError = ""
 			return nil
 
+		case "o":
+			m.openColumnPicker()
+			return nil
 		case "i":
 			sec := m.selectedSecret()
 			l().Infof("Inspect key pressed for secret: %s", sec)
@@ -582,130 +619,10 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 }
 
 func (m *Model) setRenderItem() {
-	itemStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("15"))
-
-	m.secretsList.RenderItem = func(sec secretItem, selected bool, _ int) string {
-		width := m.secretsList.Viewport.Width
-		if width <= 0 {
-			width = 80
-		}
-
-		// Columns: NAME | ID | USED | LABELS | CREATED | UPDATED
-		cols := 6
-		starts := make([]int, cols)
-		for i := 0; i < cols; i++ {
-			starts[i] = (i * width) / cols
-		}
-		colWidths := make([]int, cols)
-		for i := 0; i < cols; i++ {
-			if i == cols-1 {
-				colWidths[i] = width - starts[i]
-			} else {
-				colWidths[i] = starts[i+1] - starts[i]
-			}
-			if colWidths[i] < 1 {
-				colWidths[i] = 1
-			}
-		}
-
-		// Ensure CREATED and UPDATED columns have at least 19 chars
-		minTime := 19
-		cur := colWidths[3] + colWidths[4]
-		if cur < 2*minTime {
-			deficit := 2*minTime - cur
-			for i := 2; i >= 0 && deficit > 0; i-- {
-				take := deficit
-				if colWidths[i] > take+5 {
-					colWidths[i] -= take
-					deficit = 0
-				} else {
-					take = colWidths[i] - 5
-					if take > 0 {
-						colWidths[i] -= take
-						deficit -= take
-					}
-				}
-			}
-			if colWidths[3] < minTime {
-				colWidths[3] = minTime
-			}
-			if colWidths[4] < minTime {
-				colWidths[4] = minTime
-			}
-		}
-
-		if colWidths[2] < 1 {
-			colWidths[2] = 1
-		}
-
-		// Update cached widths for header alignment
-		m.colNameWidth = colWidths[0]
-		m.colIdWidth = colWidths[1]
-
-		// Prepare cell texts
-		nameText := truncateWithEllipsis(sec.Name, colWidths[0]-1)
-		idText := truncateWithEllipsis(sec.ID, colWidths[1])
-		usedText := " "
-		if !sec.UsedKnown {
-			usedText = ui.SpinnerCharAt(m.spinner)
-		} else if sec.Used {
-			usedText = "●"
-		}
-		// Format timestamps
-		createdStr := "N/A"
-		if !sec.CreatedAt.IsZero() {
-			createdStr = sec.CreatedAt.Format("2006-01-02 15:04:05")
-		}
-		updatedStr := "N/A"
-		if !sec.UpdatedAt.IsZero() {
-			updatedStr = sec.UpdatedAt.Format("2006-01-02 15:04:05")
-		}
-		createdText := truncateWithEllipsis(createdStr, colWidths[3])
-		updatedText := truncateWithEllipsis(updatedStr, colWidths[4])
-		// Format labels with scroll (sorted and in last column)
-		// Reserve 1 char for space before frame end
-		maxLabelsWidth := colWidths[5] - 1
-		if maxLabelsWidth < 1 {
-			maxLabelsWidth = 1
-		}
-		labelsText := formatLabelsWithScroll(sec.Labels, m.labelsScrollOffset, maxLabelsWidth)
-
-		// Render all columns in one format string (no explicit separators, like nodes view)
-		if selected {
-			selBg := lipgloss.Color("63")
-			selStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("230")).Background(selBg).Bold(true)
-			return selStyle.Render(fmt.Sprintf(" %-*s%-*s%-*s%-*s%-*s%-*s",
-				colWidths[0]-1, nameText,
-				colWidths[1], idText,
-				colWidths[2], usedText,
-				colWidths[3], createdText,
-				colWidths[4], updatedText,
-				colWidths[5], labelsText,
-			))
-		}
-
-		return itemStyle.Render(fmt.Sprintf(" %-*s%-*s%-*s%-*s%-*s%-*s",
-			colWidths[0]-1, nameText,
-			colWidths[1], idText,
-			colWidths[2], usedText,
-			colWidths[3], createdText,
-			colWidths[4], updatedText,
-			colWidths[5], labelsText,
-		))
-	}
-}
-
-func truncateWithEllipsis(s string, maxWidth int) string {
-	if len(s) <= maxWidth {
-		return s
-	}
-	if maxWidth <= 1 {
-		return "…"
-	}
-	if maxWidth == 2 {
-		return s[:1] + "…"
+	// Columns come from the view's layout (see columns.go)
+	m.secretsList.RenderItem = func(item secretItem, selected bool, _ int) string {
+		return m.columns.Row(item, selected)
 	}
-	return s[:maxWidth-1] + "…"
 }
 
 func (m *Model) handleCreateDialogKey(msg tea.KeyMsg) tea.Cmd {
@@ -1144,7 +1061,9 @@ func GetSecretsHelpContent() []helpview.HelpCategory {
 				{Keys: "<x>", Description: "Reveal secret content"},
 				{Keys: "<u>", Description: "Show Used By"},
 				{Keys: "<ctrl+d>", Description: "Delete secret"},
-				{Keys: "</>", Description: "Filter"},
+				{Keys: "<space>", Description: "Mark for bulk delete"},
+				{Keys: "<ctrl+a>", Description: "Mark all filtered"},
+				{Keys: "</>", Description: "Filter (e.g. label:env=prod used:no)"},
 			},
 		},
 		{
@@ -1156,6 +1075,7 @@ func GetSecretsHelpContent() []helpview.HelpCategory {
 				{Keys: "<shift+c>", Description: "Order by Created"},
 				{Keys: "<shift+d>", Description: "Order by Updated"},
 				{Keys: "<shift+l>", Description: "Order by Labels"},
+				{Keys: "<o>", Description: "Columns: show/hide, reorder, pin, add label columns"},
 			},
 		},
 		{
@@ -1164,6 +1084,8 @@ func GetSecretsHelpContent() []helpview.HelpCategory {
 				{Keys: "<↑/↓>", Description: "Navigate"},
 				{Keys: "<pgup>", Description: "Page up"},
 				{Keys: "<pgdown>", Description: "Page down"},
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
 				{Keys: "<esc/q>", Description: "Back to stacks"},
 			},
 		},
@@ -1172,6 +1094,7 @@ func GetSecretsHelpContent() []helpview.HelpCategory {
 
 // applySorting applies the current sort configuration to the filtered list
 func (m *Model) applySorting() {
+	defer m.secretsList.PrepareSort()()
 	if len(m.secretsList.Filtered) == 0 {
 		return
 	}
@@ -12,7 +12,6 @@ import (
 	"swarmcli/ui"
 	"swarmcli/ui/components/errordialog"
 	filterlist "swarmcli/ui/components/filterable/list"
-	"swarmcli/ui/components/sorting"
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
@@ -118,24 +117,6 @@ func (m *Model) View() string {
 
 	header := m.renderSecretsHeader(m.secretsList.Items, width)
 
-	// column width calculations are handled in setRenderItem
-	nameCol := m.colNameWidth
-	idCol := m.colIdWidth
-	if nameCol <= 0 {
-		nameCol = len("NAME")
-	}
-	if idCol <= 0 {
-		idCol = len("ID")
-	}
-	for _, sec := range m.secretsList.Items {
-		if len(sec.Name) > nameCol {
-			nameCol = len(sec.Name)
-		}
-		if len(sec.ID) > idCol {
-			idCol = len(sec.ID)
-		}
-	}
-
 	var content string
 	footer := m.renderSecretsFooter()
 
@@ -163,6 +144,8 @@ func (m *Model) View() string {
 	} else if m.errorDialogActive {
 		errorDialog := errordialog.Render(fmt.Sprintf("%v", m.err))
 		content = ui.OverlayCentered(content, errorDialog, width, 0)
+	} else if m.columnPicker.Visible {
+		content = ui.OverlayCentered(content, m.columnPicker.View(), width, 0)
 	} else if m.state == stateLoading || m.loadingView.Visible() {
 		loadingView := m.loadingView.View()
 		content = ui.OverlayCentered(content, loadingView, width, 0)
@@ -175,89 +158,9 @@ func (m *Model) View() string {
 }
 
 func (m *Model) renderSecretsHeader(items []secretItem, width int) string {
-	if len(items) == 0 {
-		return "NAME         ID                 SECRET USED    CREATED AT             UPDATED AT             LABELS"
-	}
-	if width <= 0 {
-		width = 80
-	}
-	cols := 6
-	starts := make([]int, cols)
-	for i := 0; i < cols; i++ {
-		starts[i] = (i * width) / cols
-	}
-	colWidths := make([]int, cols)
-	for i := 0; i < cols; i++ {
-		if i == cols-1 {
-			colWidths[i] = width - starts[i]
-		} else {
-			colWidths[i] = starts[i+1] - starts[i]
-		}
-		if colWidths[i] < 1 {
-			colWidths[i] = 1
-		}
-	}
-
-	// Ensure CREATED and UPDATED columns have at least 19 chars
-	minTime := 19
-	cur := colWidths[3] + colWidths[4]
-	if cur < 2*minTime {
-		deficit := 2*minTime - cur
-		for i := 2; i >= 0 && deficit > 0; i-- {
-			take := deficit
-			if colWidths[i] > take+5 {
-				colWidths[i] -= take
-				deficit = 0
-			} else {
-				take = colWidths[i] - 5
-				if take > 0 {
-					colWidths[i] -= take
-					deficit -= take
-				}
-			}
-		}
-		if colWidths[3] < minTime {
-			colWidths[3] = minTime
-		}
-		if colWidths[4] < minTime {
-			colWidths[4] = minTime
-		}
-	}
-
-	if colWidths[2] < 1 {
-		colWidths[2] = 1
-	}
-
-	// Prefix first label with a leading space to match item alignment
-	labels := []string{" NAME", "ID", "SECRET USED", "CREATED AT", "UPDATED AT", "LABELS"}
-
-	// Add sort indicators
-	arrow := func() string {
-		if m.sortAscending {
-			return sorting.SortArrow(sorting.Ascending)
-		}
-		return sorting.SortArrow(sorting.Descending)
-	}
-	if m.sortField == SortByName {
-		labels[0] = fmt.Sprintf(" NAME %s", arrow())
-	}
-	if m.sortField == SortByID {
-		labels[1] = fmt.Sprintf("ID %s", arrow())
-	}
-	if m.sortField == SortByUsed {
-		labels[2] = fmt.Sprintf("SECRET USED %s", arrow())
-	}
-	if m.sortField == SortByCreated {
-		labels[3] = fmt.Sprintf("CREATED AT %s", arrow())
-	}
-	if m.sortField == SortByUpdated {
-		labels[4] = fmt.Sprintf("UPDATED AT %s", arrow())
-	}
-	if m.sortField == SortByLabels {
-		labels[5] = fmt.Sprintf("LABELS %s", arrow())
-	}
-
-	return ui.RenderColumnHeader(labels, colWidths)
+	// Size the columns for this frame; the rows rendered below reuse them.
+	m.columns.Compute(width, items)
+	return ui.FrameHeaderStyle.Render(m.columns.Header(m.sortArrow))
 }
 
 func (m *Model) renderSecretsFooter() string {
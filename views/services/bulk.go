@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package servicesview
+
+import (
+	"context"
+	"fmt"
+	"swarmcli/docker"
+	"swarmcli/views/bulkaction"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func serviceName(e docker.ServiceEntry) string { return e.ServiceName }
+
+// confirmBulk opens the confirm dialog for action over the marked services.
+func (m *Model) confirmBulk(action string) {
+	names := bulkaction.Names(m.List.Marked(), serviceName)
+	m.pendingAction = action
+	m.confirmDialog.Visible = true
+	m.confirmDialog.ErrorMode = false
+	switch action {
+	case "remove":
+		m.confirmDialog.Message = bulkaction.ConfirmMessage("Remove", "services", names, "This action cannot be undone!")
+	case "rollback":
+		m.confirmDialog.Message = bulkaction.ConfirmMessage("Rollback", "services", names, "")
+	default:
+		m.confirmDialog.Message = bulkaction.ConfirmMessage("Restart", "services", names, "")
+	}
+}
+
+// runBulk applies action to every marked service.
+func (m *Model) runBulk(action string) tea.Cmd {
+	marked := m.List.Marked()
+	l().Infof("Starting bulk %s for %d services", action, len(marked))
+	switch action {
+	case "remove":
+		return bulkaction.Run("Removed", "services", marked, serviceName, func(ctx context.Context, e docker.ServiceEntry) error {
+			return docker.RemoveService(ctx, e.ServiceName)
+		})
+	case "rollback":
+		return bulkaction.Run("Rolled back", "services", marked, serviceName, func(ctx context.Context, e docker.ServiceEntry) error {
+			return docker.RollbackService(ctx, e.ServiceName)
+		})
+	default:
+		return bulkaction.Run("Restarted", "services", marked, serviceName, func(ctx context.Context, e docker.ServiceEntry) error {
+			return docker.RestartService(ctx, e.ServiceName)
+		})
+	}
+}
+
+// runBulkScale scales every marked service to replicas.
+func (m *Model) runBulkScale(replicas uint64) tea.Cmd {
+	marked := m.List.Marked()
+	l().Infof("Scaling %d services to %d replicas", len(marked), replicas)
+	return bulkaction.Run(fmt.Sprintf("Scaled to %d:", replicas), "services", marked, serviceName, func(ctx context.Context, e docker.ServiceEntry) error {
+		return docker.ScaleService(ctx, e.ServiceID, replicas)
+	})
+}
+
+// showBulkResult reports a finished batch and refreshes the list.
+func (m *Model) showBulkResult(msg bulkaction.DoneMsg) tea.Cmd {
+	m.List.ClearMarks()
+	m.confirmDialog.Visible = true
+	m.confirmDialog.ErrorMode = true
+	m.confirmDialog.Title = "Bulk Result"
+	m.confirmDialog.Message = msg.Summary()
+	return refreshServicesCmd(m.nodeID, m.stackName, m.filterType)
+}
@@ -0,0 +1,190 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package servicesview
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"swarmcli/core/export"
+	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
+	"swarmcli/ui/components/sorting"
+	"swarmcli/views/columnpicker"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/docker/go-units"
+)
+
+// serviceColumns are the built-in columns of the services table. Users can
+// rearrange them and add label columns with :columns (see columnpicker).
+var serviceColumns = []columns.Column[docker.ServiceEntry]{
+	{Name: "service", Title: "SERVICE", Min: 10, Fit: true, Search: true, Value: func(s docker.ServiceEntry) string { return s.ServiceName }},
+	{Name: "id", Title: "ID", Min: 12, Hidden: true, Value: func(s docker.ServiceEntry) string { return s.ServiceID }},
+	{Name: "stack", Title: "STACK", Min: 10, Value: func(s docker.ServiceEntry) string { return s.StackName }},
+	{Name: "replicas", Title: "REPLICAS", Min: 8, Value: replicasText, Color: replicasColor},
+	{Name: "status", Title: "STATUS", Min: 8, Value: func(s docker.ServiceEntry) string { return s.Status }, Color: func(s docker.ServiceEntry) lipgloss.Color { return getStatusColor(s.Status) }},
+	{Name: "cpu", Title: "CPU", Min: 6, Value: cpuText},
+	{Name: "mem", Title: "MEM", Min: 10, Value: memText, Color: memColor},
+	{Name: "restarts", Title: "RESTARTS", Min: 8, Value: func(s docker.ServiceEntry) string { return strconv.Itoa(s.Restarts) }, Color: restartsColor},
+	{Name: "mode", Title: "MODE", Min: 10, Value: func(s docker.ServiceEntry) string { return s.Mode }},
+	{Name: "image", Title: "IMAGE", Min: 15, Value: func(s docker.ServiceEntry) string { return s.Image }},
+	{Name: "ports", Title: "PORTS", Min: 8, Value: func(s docker.ServiceEntry) string { return s.Ports }},
+	{Name: "nodes", Title: "NODES", Min: 8, Hidden: true, Value: func(s docker.ServiceEntry) string { return joinOrDash(s.NodeNames) }},
+	{Name: "labels", Title: "LABELS", Min: 8, Hidden: true, Value: func(s docker.ServiceEntry) string { return formatLabels(s.Labels) }},
+	// Timestamps are shown relative but exported as RFC 3339.
+	{Name: "created", Title: "CREATED", Min: 8,
+		Value:  func(s docker.ServiceEntry) string { return export.Time(s.CreatedAt) },
+		Format: func(s docker.ServiceEntry, _ int) string { return formatRelativeTime(s.CreatedAt) }},
+	{Name: "updated", Title: "UPDATED", Min: 8,
+		Value:  func(s docker.ServiceEntry) string { return export.Time(s.UpdatedAt) },
+		Format: func(s docker.ServiceEntry, _ int) string { return formatRelativeTime(s.UpdatedAt) }},
+}
+
+func newServiceLayout() *columns.Layout[docker.ServiceEntry] {
+	layout, err := columns.NewLayout(ViewName, serviceColumns, func(s docker.ServiceEntry) map[string]string { return s.Labels })
+	if err != nil {
+		l().Warnf("Failed to load column layout: %v", err)
+	}
+	return layout
+}
+
+func replicasText(s docker.ServiceEntry) string {
+	if s.ReplicasTotal == 0 {
+		return "—"
+	}
+	return fmt.Sprintf("%d/%d", s.ReplicasOnNode, s.ReplicasTotal)
+}
+
+func replicasColor(s docker.ServiceEntry) lipgloss.Color {
+	switch {
+	case s.ReplicasTotal == 0:
+		return lipgloss.Color("8")
+	case s.ReplicasOnNode == 0:
+		return lipgloss.Color("9")
+	case s.ReplicasOnNode < s.ReplicasTotal:
+		return lipgloss.Color("11")
+	default:
+		return lipgloss.Color("10")
+	}
+}
+
+func cpuText(s docker.ServiceEntry) string {
+	if s.Measured == 0 {
+		return "-"
+	}
+	return formatCPU(s.CPU)
+}
+
+func memText(s docker.ServiceEntry) string {
+	if s.Measured == 0 {
+		return "-"
+	}
+	return formatMem(s.MemUsed, s.MemLimit)
+}
+
+func memColor(s docker.ServiceEntry) lipgloss.Color {
+	return memUsageColor(s.MemUsed, s.MemLimit)
+}
+
+func restartsColor(s docker.ServiceEntry) lipgloss.Color {
+	if s.Restarts > 0 {
+		return lipgloss.Color("11")
+	}
+	return ""
+}
+
+func formatCPU(cpu float64) string {
+	return fmt.Sprintf("%.1f%%", cpu)
+}
+
+// formatMem renders memory as "used / limit", or just "used" without a
+// limit.
+func formatMem(used, limit int64) string {
+	if limit <= 0 {
+		return units.BytesSize(float64(used))
+	}
+	return units.BytesSize(float64(used)) + " / " + units.BytesSize(float64(limit))
+}
+
+// memUsageColor warns when memory gets close to the limit, where the
+// kernel would kill the container.
+func memUsageColor(used, limit int64) lipgloss.Color {
+	if limit <= 0 {
+		return ""
+	}
+	switch ratio := float64(used) / float64(limit); {
+	case ratio >= 0.9:
+		return lipgloss.Color("9")
+	case ratio >= 0.75:
+		return lipgloss.Color("11")
+	}
+	return ""
+}
+
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return "-"
+	}
+	parts := make([]string, 0, len(labels))
+	for k, v := range labels {
+		parts = append(parts, k+"="+v)
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
+
+func joinOrDash(values []string) string {
+	if len(values) == 0 {
+		return "-"
+	}
+	return strings.Join(values, ",")
+}
+
+// sortArrow returns the sort indicator for the given column, if it is the
+// active sort column.
+func (m *Model) sortArrow(name string) string {
+	fields := map[string]SortField{
+		"service":  SortByName,
+		"status":   SortByStatus,
+		"image":    SortByImage,
+		"ports":    SortByPorts,
+		"created":  SortByCreated,
+		"updated":  SortByUpdated,
+		"cpu":      SortByCPU,
+		"mem":      SortByMemory,
+		"restarts": SortByRestarts,
+	}
+	if f, ok := fields[name]; !ok || f != m.sortField {
+		return ""
+	}
+	if m.sortAscending {
+		return sorting.SortArrow(sorting.Ascending)
+	}
+	return sorting.SortArrow(sorting.Descending)
+}
+
+func (m *Model) openColumnPicker() {
+	m.columnPicker.Show(ViewName, m.columns.Specs())
+}
+
+// applyColumnLayout applies and persists the picker's result.
+func (m *Model) applyColumnLayout(msg columnpicker.ResultMsg) tea.Cmd {
+	if !msg.Confirmed {
+		return nil
+	}
+	err := m.columns.Apply(msg.Reset, msg.Columns)
+	m.setRenderItem()
+	m.List.Viewport.SetContent(m.List.View())
+
+	if err != nil {
+		l().Errorf("Failed to save column layout: %v", err)
+		m.confirmDialog.Visible = true
+		m.confirmDialog.ErrorMode = true
+		m.confirmDialog.Message = fmt.Sprintf("Failed to save column layout:\n%v", err)
+	}
+	return nil
+}
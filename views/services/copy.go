@@ -0,0 +1,30 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package servicesview
+
+import (
+	"swarmcli/core/clipboard"
+	"swarmcli/docker"
+)
+
+// CopyText returns the names (or IDs with alt) of the marked services, or of
+// the service or task under the cursor.
+func (m *Model) CopyText(alt bool) (string, string) {
+	if m.selectedTaskIndex >= 0 && m.List.Cursor < len(m.List.Filtered) {
+		tasks := m.serviceTasks[m.List.Filtered[m.List.Cursor].ServiceID]
+		if m.selectedTaskIndex < len(tasks) {
+			t := tasks[m.selectedTaskIndex]
+			if alt {
+				return "task ID", t.ID
+			}
+			return "task name", t.Name
+		}
+	}
+
+	items := clipboard.Selection(m.List.Marked(), m.List.Filtered, m.List.Cursor)
+	if alt {
+		return clipboard.Describe(len(items), "service ID"), clipboard.Join(items, func(e docker.ServiceEntry) string { return e.ServiceID })
+	}
+	return clipboard.Describe(len(items), "service name"), clipboard.Join(items, serviceName)
+}
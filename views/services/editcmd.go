@@ -0,0 +1,224 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package servicesview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"swarmcli/core/diff"
+	"swarmcli/core/editor"
+	"swarmcli/docker"
+	"swarmcli/views/updatedialog"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// maxNoteDiffLines caps the concurrent changes listed in the editor header.
+const maxNoteDiffLines = 30
+
+// specLoadedMsg carries the spec document of a service loaded for editing.
+type specLoadedMsg struct {
+	Service swarm.Service
+	Doc     []byte
+}
+
+// specEditedMsg carries a spec document back from $EDITOR, with the service
+// it was loaded from.
+type specEditedMsg struct {
+	Service swarm.Service
+	Data    []byte
+	Err     error
+}
+
+// editSpecCmd opens a spec document of svc in $EDITOR.
+func editSpecCmd(svc swarm.Service, doc []byte) tea.Cmd {
+	l().Infoln("Opening spec of", svc.Spec.Name, "in $EDITOR")
+	return editor.EditTempFileCmd(svc.Spec.Name+"-*.yaml", doc,
+		func(data []byte) tea.Msg {
+			return specEditedMsg{Service: svc, Data: data}
+		},
+		func(err error) tea.Msg {
+			return specEditedMsg{Service: svc, Err: err}
+		})
+}
+
+// editServiceSpecCmd loads a service and renders its spec document; the
+// resulting specLoadedMsg opens it in $EDITOR.
+func editServiceSpecCmd(serviceID string) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
+		svc, err := docker.LoadService(ctx, serviceID)
+		if err != nil {
+			return specEditedMsg{Err: err}
+		}
+		doc, err := docker.ServiceSpecDocument(svc.Spec.Name, svc.Spec)
+		if err != nil {
+			return specEditedMsg{Service: svc, Err: err}
+		}
+		return specLoadedMsg{Service: svc, Doc: doc}
+	})
+}
+
+// handleSpecEdited validates an edited spec and opens its review.
+func (m *Model) handleSpecEdited(msg specEditedMsg) tea.Cmd {
+	if msg.Err != nil {
+		l().Errorf("Editing service spec failed: %v", msg.Err)
+		m.showError(fmt.Sprintf("Failed to edit %s:\n%v", msg.Service.Spec.Name, msg.Err))
+		return nil
+	}
+
+	spec, err := docker.ParseServiceSpec(msg.Data)
+	if errors.Is(err, docker.ErrEditCancelled) {
+		l().Infof("Edit of service %s cancelled", msg.Service.Spec.Name)
+		return nil
+	}
+	if err == nil {
+		err = docker.ValidateServiceSpec(msg.Service.Spec, spec)
+	}
+	if err != nil {
+		// Offer to fix the document rather than losing the edits
+		m.specEdit = &msg
+		m.specEditErr = err
+		m.pendingAction = "reedit-spec"
+		m.confirmDialog.Visible = true
+		m.confirmDialog.ErrorMode = false
+		m.confirmDialog.Message = fmt.Sprintf("Invalid spec for %s:\n%v\n\nEdit it again?", msg.Service.Spec.Name, err)
+		return nil
+	}
+
+	update, err := docker.NewServiceUpdate(msg.Service, spec)
+	if err != nil {
+		m.showError(fmt.Sprintf("Failed to diff %s:\n%v", msg.Service.Spec.Name, err))
+		return nil
+	}
+	m.updateDialog.ShowReview(update, "")
+	return nil
+}
+
+// reeditSpecCmd reopens a rejected document with the error on top.
+func (m *Model) reeditSpecCmd() tea.Cmd {
+	edit, editErr := m.specEdit, m.specEditErr
+	m.specEdit, m.specEditErr = nil, nil
+	if edit == nil {
+		return nil
+	}
+	doc := docker.WrapSpecDocument(edit.Service.Spec.Name, docker.StripSpecComments(edit.Data),
+		fmt.Sprintf("Error: %v", editErr))
+	return editSpecCmd(edit.Service, doc)
+}
+
+// editUpdateCmd reopens a reviewed spec in $EDITOR.
+func editUpdateCmd(msg updatedialog.EditMsg) tea.Cmd {
+	u := msg.Update
+	doc, err := docker.ServiceSpecDocument(u.Spec.Name, u.Spec)
+	if err != nil {
+		return func() tea.Msg { return specEditedMsg{Service: u.Service, Err: err} }
+	}
+	return editSpecCmd(u.Service, doc)
+}
+
+// handleVersionConflict asks what to do with an update swarm refused
+// because the service changed since it was loaded.
+func (m *Model) handleVersionConflict(msg updatedialog.ResultMsg) {
+	m.updateDialog.Hide()
+	m.conflictUpdate = msg.Update
+	m.pendingAction = "spec-conflict"
+	m.confirmDialog.Visible = true
+	m.confirmDialog.ErrorMode = false
+	m.confirmDialog.Message = fmt.Sprintf("%s was updated by someone else since you loaded it.\n\n"+
+		"Merge your changes into the current spec? (No discards them)", msg.ServiceName)
+}
+
+// concurrentChanges lists what changed in a service between two specs, for
+// the editor header.
+func concurrentChanges(base, current swarm.ServiceSpec) string {
+	a, errA := diff.YAML(base)
+	b, errB := diff.YAML(current)
+	if errA != nil || errB != nil {
+		return ""
+	}
+	var lines []string
+	for _, line := range diff.Lines(a, b) {
+		if line.Op == diff.Equal {
+			continue
+		}
+		if len(lines) == maxNoteDiffLines {
+			lines = append(lines, "  …")
+			break
+		}
+		lines = append(lines, "  "+string(line.Op)+" "+line.Text)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// conflictReloadedMsg carries the current version of a service whose
+// update swarm refused, for merging the update into it.
+type conflictReloadedMsg struct {
+	Update  *docker.ServiceUpdate
+	Current swarm.Service
+	Err     error
+}
+
+// mergeConflictCmd reloads the service of the refused update; the resulting
+// conflictReloadedMsg merges the update into it.
+func (m *Model) mergeConflictCmd() tea.Cmd {
+	u := m.conflictUpdate
+	m.conflictUpdate = nil
+	if u == nil {
+		return nil
+	}
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
+		current, err := docker.LoadService(ctx, u.Service.ID)
+		return conflictReloadedMsg{Update: u, Current: current, Err: err}
+	})
+}
+
+// handleConflictReloaded merges the refused update into the current spec: a
+// clean merge goes to review, conflicts go back to $EDITOR with your side
+// kept and the conflicting fields listed.
+func (m *Model) handleConflictReloaded(msg conflictReloadedMsg) tea.Cmd {
+	u, current := msg.Update, msg.Current
+	if msg.Err != nil {
+		m.showError(fmt.Sprintf("Failed to reload %s:\n%v", u.Spec.Name, msg.Err))
+		return nil
+	}
+	merged, conflicts, err := docker.MergeServiceSpecs(u.Service.Spec, u.Spec, current.Spec)
+	if err != nil {
+		m.showError(fmt.Sprintf("Failed to merge %s:\n%v", u.Spec.Name, err))
+		return nil
+	}
+
+	if len(conflicts) == 0 {
+		update, err := docker.NewServiceUpdate(current, merged)
+		if err != nil {
+			m.showError(fmt.Sprintf("Failed to diff %s:\n%v", u.Spec.Name, err))
+			return nil
+		}
+		m.updateDialog.ShowReview(update, "Merged with the changes made since you loaded the service.")
+		return nil
+	}
+
+	note := "Conflicts with changes made since you loaded the service (your values are kept):\n  " +
+		strings.Join(conflicts, "\n  ")
+	if changes := concurrentChanges(u.Service.Spec, current.Spec); changes != "" {
+		note += "\n\nChanges made meanwhile:\n" + changes
+	}
+	doc, err := docker.ServiceSpecDocument(u.Spec.Name, merged, note)
+	if err != nil {
+		m.showError(fmt.Sprintf("Failed to merge %s:\n%v", u.Spec.Name, err))
+		return nil
+	}
+	return editSpecCmd(current, doc)
+}
+
+// showError shows a message in the confirm dialog used as an error display.
+func (m *Model) showError(message string) {
+	m.confirmDialog.Visible = true
+	m.confirmDialog.ErrorMode = true
+	m.confirmDialog.Message = message
+}
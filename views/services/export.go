@@ -0,0 +1,12 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package servicesview
+
+import "swarmcli/core/export"
+
+// ExportTable returns the services currently listed, in display order, with
+// every column of the layout including hidden ones.
+func (m *Model) ExportTable() export.Table {
+	return m.columns.Table(m.List.Filtered)
+}
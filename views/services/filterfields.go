@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package servicesview
+
+import (
+	"swarmcli/docker"
+	filterlist "swarmcli/ui/components/filterable/list"
+)
+
+// serviceFilterFields are the attributes addressable from the filter query,
+// e.g. "stack:web state:degraded image:*:latest".
+var serviceFilterFields = []filterlist.Field[docker.ServiceEntry]{
+	{Name: "name", Values: func(s docker.ServiceEntry) []string { return []string{s.ServiceName} }},
+	{Name: "id", Values: func(s docker.ServiceEntry) []string { return []string{s.ServiceID} }},
+	{Name: "stack", Values: func(s docker.ServiceEntry) []string { return []string{s.StackName} }},
+	{Name: "state", Values: func(s docker.ServiceEntry) []string { return []string{s.Status, serviceHealth(s)} }},
+	{Name: "mode", Values: func(s docker.ServiceEntry) []string { return []string{s.Mode} }},
+	{Name: "image", Values: func(s docker.ServiceEntry) []string { return []string{s.Image} }},
+	{Name: "ports", Values: func(s docker.ServiceEntry) []string { return []string{s.Ports} }},
+	{Name: "node", Values: func(s docker.ServiceEntry) []string { return s.NodeNames }},
+	{Name: "label", Values: func(s docker.ServiceEntry) []string { return filterlist.LabelValues(s.Labels) }, Exact: true},
+}
+
+// serviceHealth classifies a service by its running replica count so that
+// "state:degraded" and "state:down" can be filtered on.
+func serviceHealth(s docker.ServiceEntry) string {
+	switch {
+	case s.ReplicasTotal > 0 && s.ReplicasOnNode == 0:
+		return "down"
+	case s.ReplicasOnNode < s.ReplicasTotal:
+		return "degraded"
+	default:
+		return "healthy"
+	}
+}
@@ -4,12 +4,16 @@
 package servicesview
 
 import (
-	"strings"
+	"swarmcli/core/primitives/fuzzy"
 	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
 	filterlist "swarmcli/ui/components/filterable/list"
+	"swarmcli/views/columnpicker"
 	"swarmcli/views/confirmdialog"
+	"swarmcli/views/createdialog"
 	"swarmcli/views/helpbar"
 	"swarmcli/views/scaledialog"
+	"swarmcli/views/updatedialog"
 	"time"
 
 	"github.com/charmbracelet/bubbles/viewport"
@@ -36,6 +40,9 @@ const (
 	SortByPorts
 	SortByCreated
 	SortByUpdated
+	SortByCPU
+	SortByMemory
+	SortByRestarts
 )
 
 type Model struct {
@@ -48,9 +55,9 @@ type Model struct {
 	height       int
 	lastSnapshot uint64 // hash of last snapshot for change detection
 
-	// Column widths cached after computation
-	colServiceWidth int
-	colStackWidth   int
+	// Column layout, configurable through the column picker
+	columns      *columns.Layout[docker.ServiceEntry]
+	columnPicker *columnpicker.Model
 
 	// Filter
 	filterType FilterType
@@ -62,9 +69,17 @@ type Model struct {
 
 	confirmDialog *confirmdialog.Model
 	scaleDialog   *scaledialog.Model
+	updateDialog  *updatedialog.Model
+	createDialog  *createdialog.Model
 
 	// Track what action is pending confirmation
-	pendingAction string // "restart", "remove", "rollback", or "empty-stack"
+	pendingAction string // "restart", "remove", "rollback", "empty-stack", "reedit-spec" or "spec-conflict"
+
+	// A spec edited in $EDITOR that failed validation, and why
+	specEdit    *specEditedMsg
+	specEditErr error
+	// An update refused because the service changed meanwhile
+	conflictUpdate *docker.ServiceUpdate
 
 	// Track which services have their tasks expanded
 	expandedServices map[string]bool               // service ID -> expanded
@@ -87,12 +102,14 @@ func New(width, height int) *Model {
 
 	list := filterlist.FilterableList[docker.ServiceEntry]{
 		Viewport: vp,
-		Match: func(s docker.ServiceEntry, query string) bool {
-			return strings.Contains(strings.ToLower(s.ServiceName), strings.ToLower(query))
+		Fields:   serviceFilterFields,
+		Key:      func(s docker.ServiceEntry) string { return s.ServiceID },
+		Score: func(s docker.ServiceEntry, query string) (int, bool) {
+			return fuzzy.Score(query, s.ServiceName)
 		},
 	}
 
-	return &Model{
+	m := &Model{
 		List:              list,
 		Visible:           false,
 		firstResize:       true,
@@ -100,12 +117,18 @@ func New(width, height int) *Model {
 		height:            height,
 		confirmDialog:     confirmdialog.New(width, height),
 		scaleDialog:       scaledialog.New(width, height),
+		updateDialog:      updatedialog.New(width, height),
+		createDialog:      createdialog.New(width, height),
+		columns:           newServiceLayout(),
+		columnPicker:      columnpicker.New(width, height),
 		expandedServices:  make(map[string]bool),
 		serviceTasks:      make(map[string][]docker.TaskEntry),
 		selectedTaskIndex: -1,
 		sortField:         SortByName,
 		sortAscending:     true,
 	}
+	m.columns.Terms = m.List.FreeTerms
+	return m
 }
 
 func (m *Model) Init() tea.Cmd {
@@ -126,10 +149,17 @@ func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
 		{Key: "↑/↓", Desc: "Navigate"},
 		{Key: "p", Desc: "Show/hide tasks"},
 		{Key: "s", Desc: "Scale service"},
+		{Key: "u", Desc: "Update service"},
+		{Key: "E", Desc: "Edit spec"},
+		{Key: "n", Desc: "New service"},
+		{Key: "c", Desc: "Clone"},
 		{Key: "r", Desc: "Restart service"},
 		{Key: "ctrl+r", Desc: "Rollback service"},
 		{Key: "ctrl+d", Desc: "Remove service"},
 		{Key: "l", Desc: "View logs"},
+		{Key: "e", Desc: "Explain placement"},
+		{Key: "space", Desc: "Mark"},
+		{Key: "o", Desc: "Columns"},
 		{Key: "?", Desc: "Help"},
 		{Key: "q", Desc: "Close"},
 	}
@@ -148,6 +178,11 @@ func (m *Model) HasActiveFilter() bool {
 	return m.List.Query != ""
 }
 
+// HasMarkedItems reports whether services are marked for a bulk action.
+func (m *Model) HasMarkedItems() bool {
+	return m.List.MarkedCount() > 0
+}
+
 // IsSearching reports whether the list is currently in search mode.
 func (m *Model) IsSearching() bool {
 	return m.List.Mode == filterlist.ModeSearching
@@ -155,5 +190,13 @@ func (m *Model) IsSearching() bool {
 
 // HasActiveDialog reports whether a dialog is currently visible.
 func (m *Model) HasActiveDialog() bool {
-	return m.confirmDialog.Visible || m.scaleDialog.Visible
+	return m.confirmDialog.Visible || m.scaleDialog.Visible || m.updateDialog.Visible || m.createDialog.Visible || m.columnPicker.Visible
+}
+
+// SelectedService returns the service under the cursor.
+func (m *Model) SelectedService() (docker.ServiceEntry, bool) {
+	if m.List.Cursor < 0 || m.List.Cursor >= len(m.List.Filtered) {
+		return docker.ServiceEntry{}, false
+	}
+	return m.List.Filtered[m.List.Cursor], true
 }
@@ -12,15 +12,15 @@ import (
 )
 
 func refreshServicesCmd(nodeID, stackName string, filterType FilterType) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		// Explicit user-initiated refresh: perform synchronous refresh but keep it defensive.
-		_, err := docker.RefreshSnapshot()
+		_, err := docker.RefreshSnapshotFor(ctxName)
 		if err != nil {
 			// If refresh fails, fall back to cached snapshot and continue
 			l().Errorf("refreshServicesCmd: RefreshSnapshot failed: %v", err)
 		}
 
-		entries, title := LoadServicesForView(filterType, nodeID, stackName)
+		entries, title := LoadServicesForView(ctxName, filterType, nodeID, stackName)
 		return Msg{
 			Title:      title,
 			Entries:    entries,
@@ -28,38 +28,62 @@ func refreshServicesCmd(nodeID, stackName string, filterType FilterType) tea.Cmd
 			NodeID:     nodeID,
 			StackName:  stackName,
 		}
-	}
+	})
 }
 
-func LoadServicesForView(filterType FilterType, nodeID, stackName string) (entries []docker.ServiceEntry, title string) {
+// LoadServicesForView loads the services a view shows from the snapshot of
+// the named Docker context.
+func LoadServicesForView(ctxName string, filterType FilterType, nodeID, stackName string) (entries []docker.ServiceEntry, title string) {
 	switch filterType {
 	case NodeFilter:
-		entries = docker.LoadNodeServices(nodeID)
+		entries = docker.LoadNodeServicesFor(ctxName, nodeID)
 		title = "Services on Node: " + nodeID
 	case StackFilter:
-		entries = docker.LoadStackServices(stackName)
+		entries = docker.LoadStackServicesFor(ctxName, stackName)
 		title = "Services in Stack: " + stackName
 	case NoStackFilter:
 		// docker marks services without a stack namespace as stack "-".
-		entries = docker.LoadStackServices("-")
+		entries = docker.LoadStackServicesFor(ctxName, "-")
 		title = "Services (no stack)"
 	default: // All services
-		entries = docker.LoadStackServices("")
+		entries = docker.LoadStackServicesFor(ctxName, "")
 		title = "All Services"
 	}
+	if err := docker.AttachServiceUsageFor(ctxName, entries); err != nil {
+		l().Warnf("LoadServicesForView: no usage stats: %v", err)
+	}
 	return
 }
 
+// loadTasksCmd loads the tasks of an expanded service with their usage.
+func loadTasksCmd(entry docker.ServiceEntry) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		tasks, err := docker.GetTasksForServiceFor(ctxName, entry.ServiceID)
+		if err != nil {
+			l().Errorf("Failed to fetch tasks for service %s: %v", entry.ServiceName, err)
+			// Still toggle to show empty state
+			tasks = []docker.TaskEntry{}
+		}
+		if err := docker.AttachTaskUsageFor(ctxName, tasks); err != nil {
+			l().Warnf("No usage stats for tasks of %s: %v", entry.ServiceName, err)
+		}
+		return TasksLoadedMsg{
+			ServiceID: entry.ServiceID,
+			Tasks:     tasks,
+		}
+	})
+}
+
 // CheckServicesCmd checks if services have changed and returns update message if so
 func CheckServicesCmd(lastHash uint64, filterType FilterType, nodeID, stackName string) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		l().Info("CheckServicesCmd: Polling for service changes")
 
 		// Do not block the UI waiting for network calls. Trigger an async refresh if needed
 		// and use the cached snapshot for quick checks.
-		docker.TriggerRefreshIfNeeded()
+		docker.TriggerRefreshIfNeededFor(ctxName)
 
-		entries, title := LoadServicesForView(filterType, nodeID, stackName)
+		entries, title := LoadServicesForView(ctxName, filterType, nodeID, stackName)
 		newHash, err := hash.Compute(entries)
 		if err != nil {
 			l().Errorf("CheckServicesCmd: Hash computation failed: %v", err)
@@ -86,5 +110,5 @@ func CheckServicesCmd(lastHash uint64, filterType FilterType, nodeID, stackName
 		return tea.Tick(PollInterval, func(t time.Time) tea.Msg {
 			return TickMsg(t)
 		})()
-	}
+	})
 }
@@ -11,11 +11,16 @@ import (
 	"swarmcli/core/primitives/hash"
 	"swarmcli/docker"
 	filterlist "swarmcli/ui/components/filterable/list"
+	"swarmcli/views/bulkaction"
+	"swarmcli/views/columnpicker"
 	"swarmcli/views/confirmdialog"
+	"swarmcli/views/createdialog"
+	explainview "swarmcli/views/explain"
 	helpview "swarmcli/views/help"
 	inspectview "swarmcli/views/inspect"
 	logsview "swarmcli/views/logs"
 	"swarmcli/views/scaledialog"
+	"swarmcli/views/updatedialog"
 	"swarmcli/views/view"
 	"time"
 
@@ -58,8 +63,14 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		}
 		m.SetContent(msg)
 		m.Visible = true
-		// Continue polling
-		return tickCmd()
+		// Continue polling, and refresh the tasks of expanded services
+		cmds := []tea.Cmd{tickCmd()}
+		for _, e := range msg.Entries {
+			if m.expandedServices[e.ServiceID] {
+				cmds = append(cmds, loadTasksCmd(e))
+			}
+		}
+		return tea.Batch(cmds...)
 
 	case TickMsg:
 		l().Infof("ServicesView: Received TickMsg, visible=%v", m.Visible)
@@ -73,6 +84,11 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 	case TasksLoadedMsg:
 		// Store loaded tasks - view will automatically re-render
 		m.serviceTasks[msg.ServiceID] = msg.Tasks
+		// A refresh can drop tasks from under the cursor
+		if m.selectedTaskIndex >= len(msg.Tasks) && m.List.Cursor < len(m.List.Filtered) &&
+			m.List.Filtered[m.List.Cursor].ServiceID == msg.ServiceID {
+			m.selectedTaskIndex = len(msg.Tasks) - 1
+		}
 		m.setRenderItem()
 		return nil
 
@@ -80,6 +96,8 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		m.List.Viewport.Width = msg.Width
 		m.List.Viewport.Height = msg.Height
 		m.ready = true
+		m.updateDialog.SetSize(msg.Width, msg.Height)
+		m.createDialog.SetSize(msg.Width, msg.Height)
 		// On first resize, reset YOffset to 0; on subsequent resizes, only reset if cursor is at top
 		if m.firstResize {
 			m.List.Viewport.YOffset = 0
@@ -90,11 +108,15 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		return nil
 	case scaledialog.ResultMsg:
 		m.scaleDialog.Visible = false
+		if msg.Confirmed && m.List.MarkedCount() > 0 {
+			return m.runBulkScale(msg.Replicas)
+		}
 		if msg.Confirmed && m.List.Cursor < len(m.List.Filtered) {
 			entry := m.List.Filtered[m.List.Cursor]
 			l().Infof("Scaling service %s to %d replicas", entry.ServiceName, msg.Replicas)
-			return func() tea.Msg {
-				if err := docker.ScaleService(entry.ServiceID, msg.Replicas); err != nil {
+			return docker.ContextCmd(func(ctxName string) tea.Msg {
+				ctx := docker.WithContextName(context.Background(), ctxName)
+				if err := docker.ScaleService(ctx, entry.ServiceID, msg.Replicas); err != nil {
 					l().Errorf("Failed to scale service %s: %v", entry.ServiceName, err)
 					return ScaleErrorMsg{
 						ServiceName: entry.ServiceName,
@@ -103,26 +125,136 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 				}
 				l().Infof("Successfully scaled service %s to %d replicas", entry.ServiceName, msg.Replicas)
 				// Force immediate snapshot refresh
-				if _, err := docker.RefreshSnapshot(); err != nil {
+				if _, err := docker.RefreshSnapshotFor(ctxName); err != nil {
 					l().Warnf("Failed to refresh snapshot: %v", err)
 				}
 				return refreshServicesCmd(m.nodeID, m.stackName, m.filterType)()
+			})
+		}
+		return nil
+
+	case updatedialog.LoadedMsg:
+		if msg.Err != nil {
+			m.confirmDialog.Visible = true
+			m.confirmDialog.ErrorMode = true
+			m.confirmDialog.Message = fmt.Sprintf("Failed to load service:\n%v", msg.Err)
+			return nil
+		}
+		m.updateDialog.Show(msg.Service)
+		return nil
+
+	case updatedialog.PreparedMsg:
+		return m.updateDialog.Update(msg)
+
+	case updatedialog.ResultMsg:
+		m.updateDialog.Update(msg)
+		if docker.IsVersionConflict(msg.Err) {
+			m.handleVersionConflict(msg)
+			return nil
+		}
+		if msg.Err != nil {
+			l().Errorf("Failed to update service %s: %v", msg.ServiceName, msg.Err)
+			return nil
+		}
+		if len(msg.Warnings) > 0 {
+			m.confirmDialog.Visible = true
+			m.confirmDialog.ErrorMode = true
+			m.confirmDialog.Title = "Service Updated"
+			m.confirmDialog.Message = fmt.Sprintf("Updated %s with warnings:\n%s", msg.ServiceName, strings.Join(msg.Warnings, "\n"))
+		}
+		return docker.ContextCmd(func(ctxName string) tea.Msg {
+			if _, err := docker.RefreshSnapshotFor(ctxName); err != nil {
+				l().Warnf("Failed to refresh snapshot: %v", err)
 			}
+			return refreshServicesCmd(m.nodeID, m.stackName, m.filterType)()
+		})
+
+	case createdialog.LoadedMsg:
+		if msg.Err != nil {
+			m.showError(fmt.Sprintf("Failed to load networks, configs and secrets:\n%v", msg.Err))
+			return nil
+		}
+		m.createDialog.Show(msg)
+		return nil
+
+	case createdialog.CloneLoadedMsg:
+		if msg.Err != nil {
+			m.showError(fmt.Sprintf("Failed to load service:\n%v", msg.Err))
+			return nil
 		}
+		m.createDialog.ShowClone(msg.Clone)
 		return nil
 
+	case createdialog.PreparedMsg:
+		return m.createDialog.Update(msg)
+
+	case createdialog.ResultMsg:
+		m.createDialog.Update(msg)
+		if msg.Err != nil {
+			l().Errorf("Failed to create service %s: %v", msg.ServiceName, msg.Err)
+			return nil
+		}
+		if len(msg.Warnings) > 0 {
+			m.confirmDialog.Visible = true
+			m.confirmDialog.ErrorMode = true
+			m.confirmDialog.Title = "Service Created"
+			m.confirmDialog.Message = fmt.Sprintf("Created %s with warnings:\n%s", msg.ServiceName, strings.Join(msg.Warnings, "\n"))
+		}
+		return docker.ContextCmd(func(ctxName string) tea.Msg {
+			if _, err := docker.RefreshSnapshotFor(ctxName); err != nil {
+				l().Warnf("Failed to refresh snapshot: %v", err)
+			}
+			return refreshServicesCmd(m.nodeID, m.stackName, m.filterType)()
+		})
+
+	case updatedialog.EditMsg:
+		return editUpdateCmd(msg)
+
+	case specLoadedMsg:
+		return editSpecCmd(msg.Service, msg.Doc)
+
+	case specEditedMsg:
+		return m.handleSpecEdited(msg)
+
+	case conflictReloadedMsg:
+		return m.handleConflictReloaded(msg)
+
 	case confirmdialog.ResultMsg:
 		m.confirmDialog.Visible = false
 
+		switch m.pendingAction {
+		case "reedit-spec":
+			m.pendingAction = ""
+			if msg.Confirmed {
+				return m.reeditSpecCmd()
+			}
+			m.specEdit, m.specEditErr = nil, nil
+			return nil
+		case "spec-conflict":
+			m.pendingAction = ""
+			if msg.Confirmed {
+				return m.mergeConflictCmd()
+			}
+			m.conflictUpdate = nil
+			return nil
+		}
+
+		if msg.Confirmed && m.pendingAction != "empty-stack" && m.List.MarkedCount() > 0 {
+			action := m.pendingAction
+			m.pendingAction = ""
+			return m.runBulk(action)
+		}
+
 		if msg.Confirmed && m.List.Cursor < len(m.List.Filtered) {
 			entry := m.List.Filtered[m.List.Cursor]
 
 			switch m.pendingAction {
 			case "remove":
 				l().Debugln("Starting remove for", entry.ServiceName)
-				return func() tea.Msg {
+				return docker.ContextCmd(func(ctxName string) tea.Msg {
+					ctx := docker.WithContextName(context.Background(), ctxName)
 					l().Infof("Executing remove for service: %s", entry.ServiceName)
-					if err := docker.RemoveService(entry.ServiceName); err != nil {
+					if err := docker.RemoveService(ctx, entry.ServiceName); err != nil {
 						l().Errorf("Failed to remove service %s: %v", entry.ServiceName, err)
 						return RemoveErrorMsg{
 							ServiceName: entry.ServiceName,
@@ -131,16 +263,17 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 					}
 					l().Infof("Successfully removed service: %s", entry.ServiceName)
 					// Force immediate snapshot refresh
-					if _, err := docker.RefreshSnapshot(); err != nil {
+					if _, err := docker.RefreshSnapshotFor(ctxName); err != nil {
 						l().Warnf("Failed to refresh snapshot: %v", err)
 					}
 					return refreshServicesCmd(m.nodeID, m.stackName, m.filterType)()
-				}
+				})
 			case "rollback":
 				l().Debugln("Starting rollback for", entry.ServiceName)
-				return func() tea.Msg {
+				return docker.ContextCmd(func(ctxName string) tea.Msg {
+					ctx := docker.WithContextName(context.Background(), ctxName)
 					l().Infof("Executing rollback for service: %s", entry.ServiceName)
-					if err := docker.RollbackService(entry.ServiceName); err != nil {
+					if err := docker.RollbackService(ctx, entry.ServiceName); err != nil {
 						l().Errorf("Failed to rollback service %s: %v", entry.ServiceName, err)
 						return RollbackErrorMsg{
 							ServiceName: entry.ServiceName,
@@ -149,17 +282,18 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 					}
 					l().Infof("Successfully rolled back service: %s", entry.ServiceName)
 					// Force immediate snapshot refresh
-					if _, err := docker.RefreshSnapshot(); err != nil {
+					if _, err := docker.RefreshSnapshotFor(ctxName); err != nil {
 						l().Warnf("Failed to refresh snapshot: %v", err)
 					}
 					return refreshServicesCmd(m.nodeID, m.stackName, m.filterType)()
-				}
+				})
 			default:
 				// Default to restart
 				l().Debugln("Starting restart for", entry.ServiceName)
-				return func() tea.Msg {
+				return docker.ContextCmd(func(ctxName string) tea.Msg {
+					ctx := docker.WithContextName(context.Background(), ctxName)
 					l().Infof("Executing restart for service: %s", entry.ServiceName)
-					if err := docker.RestartService(entry.ServiceName); err != nil {
+					if err := docker.RestartService(ctx, entry.ServiceName); err != nil {
 						l().Errorf("Failed to restart service %s: %v", entry.ServiceName, err)
 						return RestartErrorMsg{
 							ServiceName: entry.ServiceName,
@@ -168,11 +302,11 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 					}
 					l().Infof("Successfully restarted service: %s", entry.ServiceName)
 					// Force immediate snapshot refresh
-					if _, err := docker.RefreshSnapshot(); err != nil {
+					if _, err := docker.RefreshSnapshotFor(ctxName); err != nil {
 						l().Warnf("Failed to refresh snapshot: %v", err)
 					}
 					return refreshServicesCmd(m.nodeID, m.stackName, m.filterType)()
-				}
+				})
 			}
 		}
 
@@ -188,6 +322,16 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		m.pendingAction = ""
 		return nil
 
+	case bulkaction.DoneMsg:
+		return m.showBulkResult(msg)
+
+	case columnpicker.OpenMsg:
+		m.openColumnPicker()
+		return nil
+
+	case columnpicker.ResultMsg:
+		return m.applyColumnLayout(msg)
+
 	case RestartErrorMsg:
 		// Show error in a confirm dialog (reusing it as an error display)
 		m.confirmDialog.Visible = true
@@ -225,6 +369,18 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			return m.scaleDialog.Update(msg)
 		}
 
+		if m.updateDialog.Visible {
+			return m.updateDialog.Update(msg)
+		}
+
+		if m.createDialog.Visible {
+			return m.createDialog.Update(msg)
+		}
+
+		if m.columnPicker.Visible {
+			return m.columnPicker.Update(msg)
+		}
+
 		// --- if in search mode, handle all keys via FilterableList ---
 		if m.List.Mode == filterlist.ModeSearching {
 			m.List.HandleKey(msg)
@@ -241,6 +397,10 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			m.selectedTaskIndex = -1
 			return nil
 		}
+		if msg.Type == tea.KeyEsc && m.List.MarkedCount() > 0 {
+			m.List.ClearMarks()
+			return nil
+		}
 
 		// Handle task navigation for expanded services
 		if m.List.Cursor < len(m.List.Filtered) {
@@ -302,7 +462,9 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 
 		switch msg.String() {
 		case "s":
-			if m.List.Cursor < len(m.List.Filtered) {
+			if n := m.List.MarkedCount(); n > 0 {
+				m.scaleDialog.Show(fmt.Sprintf("%d marked services", n), 1)
+			} else if m.List.Cursor < len(m.List.Filtered) {
 				entry := m.List.Filtered[m.List.Cursor]
 				m.scaleDialog.Show(entry.ServiceName, uint64(entry.ReplicasTotal))
 			}
@@ -315,18 +477,7 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 
 				// If expanding, fetch tasks
 				if m.expandedServices[entry.ServiceID] {
-					return func() tea.Msg {
-						tasks, err := docker.GetTasksForService(entry.ServiceID)
-						if err != nil {
-							l().Errorf("Failed to fetch tasks for service %s: %v", entry.ServiceName, err)
-							// Still toggle to show empty state
-							tasks = []docker.TaskEntry{}
-						}
-						return TasksLoadedMsg{
-							ServiceID: entry.ServiceID,
-							Tasks:     tasks,
-						}
-					}
+					return loadTasksCmd(entry)
 				} else {
 					// Collapsing - remove cached tasks and let view re-render
 					delete(m.serviceTasks, entry.ServiceID)
@@ -334,11 +485,31 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 					m.setRenderItem()
 				}
 			}
+		case "u":
+			if m.List.Cursor < len(m.List.Filtered) {
+				entry := m.List.Filtered[m.List.Cursor]
+				return updatedialog.LoadCmd(entry.ServiceID)
+			}
+		case "E":
+			if m.List.Cursor < len(m.List.Filtered) {
+				entry := m.List.Filtered[m.List.Cursor]
+				return editServiceSpecCmd(entry.ServiceID)
+			}
+		case "n":
+			return createdialog.LoadCmd()
+		case "c":
+			if m.List.Cursor < len(m.List.Filtered) {
+				entry := m.List.Filtered[m.List.Cursor]
+				return createdialog.LoadCloneCmd(entry.ServiceID)
+			}
+		case "o":
+			m.openColumnPicker()
+			return nil
 		case "i":
 			if m.List.Cursor < len(m.List.Filtered) {
 				entry := m.List.Filtered[m.List.Cursor]
-				return func() tea.Msg {
-					content, err := docker.Inspect(context.Background(), docker.InspectService, entry.ServiceID)
+				return docker.ContextCmd(func(ctxName string) tea.Msg {
+					content, err := docker.Inspect(docker.WithContextName(context.Background(), ctxName), docker.InspectService, entry.ServiceID)
 					if err != nil {
 						content = fmt.Sprintf("Error inspecting service %q: %v", entry.ServiceName, err)
 					}
@@ -349,10 +520,12 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 							"json":  content,
 						},
 					}
-				}
+				})
 			}
 		case "r":
-			if m.List.Cursor < len(m.List.Filtered) {
+			if m.List.MarkedCount() > 0 {
+				m.confirmBulk("restart")
+			} else if m.List.Cursor < len(m.List.Filtered) {
 				entry := m.List.Filtered[m.List.Cursor]
 				m.pendingAction = "restart"
 				m.confirmDialog.Visible = true
@@ -367,7 +540,9 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 				}
 			}
 		case "ctrl+d":
-			if m.List.Cursor < len(m.List.Filtered) {
+			if m.List.MarkedCount() > 0 {
+				m.confirmBulk("remove")
+			} else if m.List.Cursor < len(m.List.Filtered) {
 				entry := m.List.Filtered[m.List.Cursor]
 				m.pendingAction = "remove"
 				m.confirmDialog.Visible = true
@@ -375,13 +550,29 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 				m.confirmDialog.Message = fmt.Sprintf("Remove service %q?\n\nThis action cannot be undone!", entry.ServiceName)
 			}
 		case "ctrl+r":
-			if m.List.Cursor < len(m.List.Filtered) {
+			if m.List.MarkedCount() > 0 {
+				m.confirmBulk("rollback")
+			} else if m.List.Cursor < len(m.List.Filtered) {
 				entry := m.List.Filtered[m.List.Cursor]
 				m.pendingAction = "rollback"
 				m.confirmDialog.Visible = true
 				m.confirmDialog.ErrorMode = false
 				m.confirmDialog.Message = fmt.Sprintf("Rollback service %q to previous configuration?", entry.ServiceName)
 			}
+		case "e":
+			if m.List.Cursor < len(m.List.Filtered) {
+				entry := m.List.Filtered[m.List.Cursor]
+				target := explainview.Target{Service: entry.ServiceID}
+				if tasks := m.serviceTasks[entry.ServiceID]; m.selectedTaskIndex >= 0 && m.selectedTaskIndex < len(tasks) {
+					target.TaskID = tasks[m.selectedTaskIndex].ID
+				}
+				return func() tea.Msg {
+					return view.NavigateToMsg{
+						ViewName: explainview.ViewName,
+						Payload:  target,
+					}
+				}
+			}
 		case "l":
 			if m.List.Cursor < len(m.List.Filtered) {
 				entry := m.List.Filtered[m.List.Cursor]
@@ -467,6 +658,41 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			}
 			m.applySorting()
 			return nil
+
+		// Usage sorts start with the heaviest services on top
+
+		// Sort by CPU (%)
+		case "%":
+			if m.sortField == SortByCPU {
+				m.sortAscending = !m.sortAscending
+			} else {
+				m.sortField = SortByCPU
+				m.sortAscending = false
+			}
+			m.applySorting()
+			return nil
+
+		// Sort by Memory (Shift+M)
+		case "M":
+			if m.sortField == SortByMemory {
+				m.sortAscending = !m.sortAscending
+			} else {
+				m.sortField = SortByMemory
+				m.sortAscending = false
+			}
+			m.applySorting()
+			return nil
+
+		// Sort by Restarts (Shift+R)
+		case "R":
+			if m.sortField == SortByRestarts {
+				m.sortAscending = !m.sortAscending
+			} else {
+				m.sortField = SortByRestarts
+				m.sortAscending = false
+			}
+			m.applySorting()
+			return nil
 		}
 
 		m.List.Viewport.SetContent(m.List.View())
@@ -514,207 +740,11 @@ func (m *Model) SetContent(msg Msg) {
 }
 
 func (m *Model) setRenderItem() {
-	// We'll compute column widths based on the longest service name then
-	// allocate the remaining space to the other columns.
+	// Columns come from the view's layout (see columns.go); task rows of
+	// expanded services are appended below the service row.
 	m.List.RenderItem = func(e docker.ServiceEntry, selected bool, _ int) string {
-		width := m.List.Viewport.Width
-		if width <= 0 {
-			width = 80
-		}
-
-		cols := 9
-		sepLen := 2
-		sepTotal := sepLen * (cols - 1)
-		// Effective width available for columns (excluding separators)
-		effWidth := width - sepTotal
-		if effWidth < cols { // ensure sensible minimum
-			effWidth = width
-		}
-		colWidths := make([]int, cols)
-
-		// Headers and sensible minimums
-		headers := []string{" SERVICE", "STACK", "REPLICAS", "STATUS", "MODE", "IMAGE", "PORTS", "CREATED", "UPDATED"}
-		minCols := make([]int, cols)
-		for i := 0; i < cols; i++ {
-			hw := lipgloss.Width(headers[i])
-			floor := 6
-			switch i {
-			case 0: // SERVICE
-				floor = 10
-			case 1: // STACK
-				floor = 10
-			case 2: // REPLICAS
-				floor = 8
-			case 3: // STATUS
-				floor = 8
-			case 4: // MODE
-				floor = 10
-			case 5: // IMAGE
-				floor = 15
-			case 6: // PORTS
-				floor = 8
-			case 7, 8: // CREATED, UPDATED - need extra space for sort arrows
-				floor = 8
-				// If this column has a sort arrow, ensure we have room for it
-				if (i == 7 && m.sortField == SortByCreated) || (i == 8 && m.sortField == SortByUpdated) {
-					floor = 10
-				}
-			}
-			if hw > floor {
-				minCols[i] = hw
-			} else {
-				minCols[i] = floor
-			}
-		}
-
-		// Find longest service name visually among items (include header)
-		maxSvc := lipgloss.Width(headers[0])
-		for _, it := range m.List.Items {
-			if s, ok := any(it).(docker.ServiceEntry); ok {
-				if w := lipgloss.Width(s.ServiceName); w > maxSvc {
-					maxSvc = w
-				}
-			}
-		}
-		desiredSvc := maxSvc + 1 // reserve 1 for leading space
-
-		// Assign desired service width if possible, otherwise use proportional
-		// partitioning but ensure minimums.
-		nonServiceMinSum := 0
-		for i := 1; i < cols; i++ {
-			nonServiceMinSum += minCols[i]
-		}
-
-		if desiredSvc+nonServiceMinSum <= effWidth {
-			colWidths[0] = desiredSvc
-			// Start with minimums for remaining columns
-			for i := 1; i < cols; i++ {
-				colWidths[i] = minCols[i]
-			}
-			// Distribute leftover space equally among columns 1..5 (within effWidth)
-			sum := 0
-			for _, v := range colWidths {
-				sum += v
-			}
-			leftover := effWidth - sum
-			if leftover > 0 {
-				per := leftover / (cols - 1)
-				rem := leftover % (cols - 1)
-				for i := 1; i < cols; i++ {
-					add := per
-					if rem > 0 {
-						add++
-						rem--
-					}
-					colWidths[i] += add
-				}
-			}
-		} else {
-			// Proportional partition but respect minimums
-			// Partition across the effective width
-			base := effWidth / cols
-			for i := 0; i < cols; i++ {
-				colWidths[i] = base
-			}
-			for i := 0; i < cols; i++ {
-				if colWidths[i] < minCols[i] {
-					colWidths[i] = minCols[i]
-				}
-			}
-			// Adjust last column to ensure total equals effWidth
-			sum := 0
-			for _, v := range colWidths {
-				sum += v
-			}
-			if sum != effWidth {
-				colWidths[cols-1] += effWidth - sum
-				if colWidths[cols-1] < 1 {
-					colWidths[cols-1] = 1
-				}
-			}
-		}
-
-		// Cache for header alignment
-		m.colServiceWidth = colWidths[0]
-		m.colStackWidth = colWidths[1]
-
-		// Prepare texts
-		replicasText := fmt.Sprintf("%d/%d", e.ReplicasOnNode, e.ReplicasTotal)
-		if e.ReplicasTotal == 0 {
-			replicasText = "—"
-		}
-
-		// Truncate columns except the first (we try not to shorten first column
-		// but if it still doesn't fit due to small viewport, fall back to
-		// truncation there too).
-		lastIdx := len(colWidths) - 1
-		svcTruncWidth := colWidths[0]
-		// If we reserved a leading space in formatting, reduce by 1
-		if svcTruncWidth > 0 {
-			svcTruncWidth = svcTruncWidth - 1
-		}
-		serviceName := truncateWithEllipsis(e.ServiceName, svcTruncWidth)
-		stackName := truncateWithEllipsis(e.StackName, colWidths[1]-1)
-		statusText := truncateWithEllipsis(e.Status, colWidths[3]-1)
-		modeText := truncateWithEllipsis(e.Mode, colWidths[4]-1)
-		imageText := truncateWithEllipsis(e.Image, colWidths[5]-1)
-		portsText := truncateWithEllipsis(e.Ports, colWidths[6]-1)
-		created := truncateWithEllipsis(formatRelativeTime(e.CreatedAt), colWidths[7]-1)
-		updated := truncateWithEllipsis(formatRelativeTime(e.UpdatedAt), colWidths[lastIdx])
-
-		itemStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("15"))
-		col0 := itemStyle.Render(fmt.Sprintf(" %-*s", colWidths[0]-1, serviceName))
-		col1 := itemStyle.Render(fmt.Sprintf("%-*s", colWidths[1]-1, stackName))
-
-		var replicasColor lipgloss.Color
-		switch {
-		case e.ReplicasTotal == 0:
-			replicasColor = lipgloss.Color("8")
-		case e.ReplicasOnNode == 0:
-			replicasColor = lipgloss.Color("9")
-		case e.ReplicasOnNode < e.ReplicasTotal:
-			replicasColor = lipgloss.Color("11")
-		default:
-			replicasColor = lipgloss.Color("10")
-		}
-		replicasStyle := lipgloss.NewStyle().Foreground(replicasColor)
-		col2 := replicasStyle.Render(fmt.Sprintf("%-*s", colWidths[2]-1, replicasText))
-
-		statusColor := getStatusColor(e.Status)
-		statusStyle := lipgloss.NewStyle().Foreground(statusColor)
-		col3 := statusStyle.Render(fmt.Sprintf("%-*s", colWidths[3]-1, statusText))
-
-		col4 := itemStyle.Render(fmt.Sprintf("%-*s", colWidths[4]-1, modeText))
-		col5 := itemStyle.Render(fmt.Sprintf("%-*s", colWidths[5]-1, imageText))
-		col6 := itemStyle.Render(fmt.Sprintf("%-*s", colWidths[6]-1, portsText))
-		col7 := itemStyle.Render(fmt.Sprintf("%-*s", colWidths[7]-1, created))
-		col8 := itemStyle.Render(fmt.Sprintf("%-*s", colWidths[8], updated))
-
-		// Join with two-space separators for readability
-		sep := strings.Repeat(" ", sepLen)
-		line := col0 + sep + col1 + sep + col2 + sep + col3 + sep + col4 + sep + col5 + sep + col6 + sep + col7 + sep + col8
-
-		if selected && m.selectedTaskIndex == -1 {
-			// Only highlight service row if no task is selected
-			selBg := lipgloss.Color("25") // Lighter blue
-			selBase := lipgloss.NewStyle().Foreground(lipgloss.Color("230")).Background(selBg).Bold(true)
-			selRep := lipgloss.NewStyle().Foreground(replicasColor).Background(selBg).Bold(true)
-			selStatus := lipgloss.NewStyle().Foreground(statusColor).Background(selBg).Bold(true)
-
-			// Render each styled column including the separator so the
-			// highlight background is continuous across the whole line.
-			sepStr := strings.Repeat(" ", sepLen)
-			col0 = selBase.Render(fmt.Sprintf(" %-*s", colWidths[0]-1, serviceName) + sepStr)
-			col1 = selBase.Render(fmt.Sprintf("%-*s", colWidths[1]-1, stackName) + sepStr)
-			col2 = selRep.Render(fmt.Sprintf("%-*s", colWidths[2]-1, replicasText) + sepStr)
-			col3 = selStatus.Render(fmt.Sprintf("%-*s", colWidths[3]-1, statusText) + sepStr)
-			col4 = selBase.Render(fmt.Sprintf("%-*s", colWidths[4]-1, modeText) + sepStr)
-			col5 = selBase.Render(fmt.Sprintf("%-*s", colWidths[5]-1, imageText) + sepStr)
-			col6 = selBase.Render(fmt.Sprintf("%-*s", colWidths[6]-1, portsText) + sepStr)
-			col7 = selBase.Render(fmt.Sprintf("%-*s", colWidths[7]-1, created) + sepStr)
-			col8 = selBase.Render(fmt.Sprintf("%-*s", colWidths[8], updated))
-			line = col0 + col1 + col2 + col3 + col4 + col5 + col6 + col7 + col8
-		}
+		// Only highlight the service row if no task is selected
+		line := m.columns.Row(e, selected && m.selectedTaskIndex == -1)
 
 		// Check if service is expanded and add task rows
 		if m.expandedServices[e.ServiceID] {
@@ -722,7 +752,7 @@ func (m *Model) setRenderItem() {
 			if len(tasks) > 0 {
 				// Add task header
 				taskHeaderStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("8")).Italic(true)
-				taskHeader := taskHeaderStyle.Render("   NAME                    NODE          DESIRED STATE  CURRENT STATE")
+				taskHeader := taskHeaderStyle.Render("   NAME                    NODE          CPU     MEM                  RESTARTS  DESIRED STATE  CURRENT STATE")
 				line += "\n" + taskHeader
 
 				// Add each task as a row
@@ -731,6 +761,11 @@ func (m *Model) setRenderItem() {
 					taskNode := truncateWithEllipsis(task.NodeName, 12)
 					taskDesired := truncateWithEllipsis(task.DesiredState, 13)
 					taskCurrent := truncateWithEllipsis(task.CurrentState, 50)
+					taskCPU, taskMem := "-", "-"
+					if task.Measured {
+						taskCPU = formatCPU(task.CPU)
+						taskMem = truncateWithEllipsis(formatMem(task.MemUsed, task.MemLimit), 19)
+					}
 
 					// Check if this task is selected
 					taskSelected := selected && m.selectedTaskIndex == taskIdx
@@ -738,12 +773,15 @@ func (m *Model) setRenderItem() {
 					if taskSelected {
 						// Lighter highlight for task rows
 						taskSelStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("230")).Background(lipgloss.Color("24")).Bold(true)
-						taskLine = taskSelStyle.Render(fmt.Sprintf("   %-22s  %-12s  %-13s  %s",
-							taskName, taskNode, taskDesired, taskCurrent))
+						taskLine = taskSelStyle.Render(fmt.Sprintf("   %-22s  %-12s  %-6s  %-19s  %-8d  %-13s  %s",
+							taskName, taskNode, taskCPU, taskMem, task.Restarts, taskDesired, taskCurrent))
 					} else {
 						taskStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("7"))
-						taskLine = taskStyle.Render(fmt.Sprintf("   %-22s  %-12s  %-13s  %s",
-							taskName, taskNode, taskDesired, taskCurrent))
+						if fg := memUsageColor(task.MemUsed, task.MemLimit); task.Measured && fg != "" {
+							taskStyle = taskStyle.Foreground(fg)
+						}
+						taskLine = taskStyle.Render(fmt.Sprintf("   %-22s  %-12s  %-6s  %-19s  %-8d  %-13s  %s",
+							taskName, taskNode, taskCPU, taskMem, task.Restarts, taskDesired, taskCurrent))
 					}
 					line += "\n" + taskLine
 				}
@@ -847,11 +885,18 @@ func GetServicesHelpContent() []helpview.HelpCategory {
 				{Keys: "<i>", Description: "Inspect service"},
 				{Keys: "<p>", Description: "Show/hide tasks"},
 				{Keys: "<l>", Description: "View logs"},
+				{Keys: "<e>", Description: "Explain placement of the service or task"},
 				{Keys: "<s>", Description: "Scale service"},
+				{Keys: "<u>", Description: "Update service (image, env, resources, placement, …) with a diff preview"},
+				{Keys: "<shift+e>", Description: "Edit the full service spec in $EDITOR"},
+				{Keys: "<n>", Description: "Create a service, blank or from a template"},
+				{Keys: "<c>", Description: "Clone service (new name, image, replicas, ports, labels)"},
 				{Keys: "<r>", Description: "Restart service"},
 				{Keys: "<ctrl+r>", Description: "Rollback service"},
 				{Keys: "<ctrl+d>", Description: "Remove service"},
-				{Keys: "</>", Description: "Filter"},
+				{Keys: "</>", Description: "Filter (e.g. stack:web state:degraded image:*:latest)"},
+				{Keys: "<space>", Description: "Mark for bulk scale/restart/rollback/remove"},
+				{Keys: "<ctrl+a>", Description: "Mark all filtered"},
 			},
 		},
 		{
@@ -863,6 +908,10 @@ func GetServicesHelpContent() []helpview.HelpCategory {
 				{Keys: "<shift+p>", Description: "Order by Ports"},
 				{Keys: "<shift+c>", Description: "Order by Created"},
 				{Keys: "<shift+u>", Description: "Order by Updated"},
+				{Keys: "<%>", Description: "Order by CPU"},
+				{Keys: "<shift+m>", Description: "Order by Memory"},
+				{Keys: "<shift+r>", Description: "Order by Restarts"},
+				{Keys: "<o>", Description: "Columns: show/hide, reorder, pin, add label columns"},
 			},
 		},
 		{
@@ -871,6 +920,10 @@ func GetServicesHelpContent() []helpview.HelpCategory {
 				{Keys: "<↑/↓>", Description: "Navigate"},
 				{Keys: "<pgup>", Description: "Page up"},
 				{Keys: "<pgdown>", Description: "Page down"},
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
+				{Keys: "<|>", Description: "Split with a linked pane"},
+				{Keys: "<tab>", Description: "Switch pane focus"},
 				{Keys: "<q>", Description: "Back to stacks"},
 			},
 		},
@@ -879,6 +932,7 @@ func GetServicesHelpContent() []helpview.HelpCategory {
 
 // applySorting applies the current sort configuration to the filtered list
 func (m *Model) applySorting() {
+	defer m.List.PrepareSort()()
 	if len(m.List.Filtered) == 0 {
 		return
 	}
@@ -933,6 +987,27 @@ func (m *Model) applySorting() {
 			}
 			return m.List.Filtered[i].UpdatedAt.After(m.List.Filtered[j].UpdatedAt)
 		})
+	case SortByCPU:
+		sort.SliceStable(m.List.Filtered, func(i, j int) bool {
+			if m.sortAscending {
+				return m.List.Filtered[i].CPU < m.List.Filtered[j].CPU
+			}
+			return m.List.Filtered[i].CPU > m.List.Filtered[j].CPU
+		})
+	case SortByMemory:
+		sort.SliceStable(m.List.Filtered, func(i, j int) bool {
+			if m.sortAscending {
+				return m.List.Filtered[i].MemUsed < m.List.Filtered[j].MemUsed
+			}
+			return m.List.Filtered[i].MemUsed > m.List.Filtered[j].MemUsed
+		})
+	case SortByRestarts:
+		sort.SliceStable(m.List.Filtered, func(i, j int) bool {
+			if m.sortAscending {
+				return m.List.Filtered[i].Restarts < m.List.Filtered[j].Restarts
+			}
+			return m.List.Filtered[i].Restarts > m.List.Filtered[j].Restarts
+		})
 	}
 
 	// Restore cursor position to previously selected item
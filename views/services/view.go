@@ -5,12 +5,8 @@ package servicesview
 
 import (
 	"fmt"
-	"swarmcli/docker"
 	"swarmcli/ui"
 	filterlist "swarmcli/ui/components/filterable/list"
-	"swarmcli/ui/components/sorting"
-
-	"github.com/charmbracelet/lipgloss"
 )
 
 func (m *Model) View() string {
@@ -19,183 +15,9 @@ func (m *Model) View() string {
 		width = 80
 	}
 
-	// The header column widths are computed further down using the same
-	// effective-width logic as the renderer; see that computation below.
-	cols := 9
-
-	labels := []string{" SERVICE", "STACK", "REPLICAS", "STATUS", "MODE", "IMAGE", "PORTS", "CREATED", "UPDATED"}
-
-	// Add sort indicators to labels
-	if m.sortField == SortByName {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		labels[0] = fmt.Sprintf(" SERVICE %s", arrow)
-	}
-	if m.sortField == SortByStatus {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		labels[3] = fmt.Sprintf("STATUS %s", arrow)
-	}
-	if m.sortField == SortByImage {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		labels[5] = fmt.Sprintf("IMAGE %s", arrow)
-	}
-	if m.sortField == SortByPorts {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		labels[6] = fmt.Sprintf("PORTS %s", arrow)
-	}
-	if m.sortField == SortByCreated {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		labels[7] = fmt.Sprintf("CREATED %s", arrow)
-	}
-	if m.sortField == SortByUpdated {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		labels[8] = fmt.Sprintf("UPDATED %s", arrow)
-	}
-	// Compute header column widths using the same effective-width logic
-	// (columns widths exclude two-space separators) so the header aligns
-	// exactly with the data columns.
-	sepLen := 2
-	sepTotal := sepLen * (cols - 1)
-	effWidth := width - sepTotal
-	if effWidth < cols {
-		effWidth = width
-	}
-
-	// Reuse same minCols and longest service logic as RenderItem would
-	minCols := make([]int, cols)
-	for i := 0; i < cols; i++ {
-		hw := lipgloss.Width(labels[i])
-		floor := 6
-		switch i {
-		case 0: // SERVICE
-			floor = 10
-		case 1: // STACK
-			floor = 10
-		case 2: // REPLICAS
-			floor = 8
-		case 3: // STATUS
-			floor = 8
-		case 4: // MODE
-			floor = 10
-		case 5: // IMAGE
-			floor = 15
-		case 6: // PORTS
-			floor = 8
-		case 7, 8: // CREATED, UPDATED - need extra space for sort arrows
-			floor = 8
-			// If this column has a sort arrow, ensure we have room for it
-			if (i == 7 && m.sortField == SortByCreated) || (i == 8 && m.sortField == SortByUpdated) {
-				floor = 10
-			}
-		}
-		if hw > floor {
-			minCols[i] = hw
-		} else {
-			minCols[i] = floor
-		}
-	}
-
-	maxSvc := lipgloss.Width(labels[0])
-	for _, it := range m.List.Items {
-		if s, ok := any(it).(docker.ServiceEntry); ok {
-			if w := lipgloss.Width(s.ServiceName); w > maxSvc {
-				maxSvc = w
-			}
-		}
-	}
-	desiredSvc := maxSvc + 1
-
-	headerColWidths := make([]int, cols)
-	nonServiceMinSum := 0
-	for i := 1; i < cols; i++ {
-		nonServiceMinSum += minCols[i]
-	}
-	if desiredSvc+nonServiceMinSum <= effWidth {
-		headerColWidths[0] = desiredSvc
-		for i := 1; i < cols; i++ {
-			headerColWidths[i] = minCols[i]
-		}
-		// distribute leftover across cols 1..5
-		sum := 0
-		for _, v := range headerColWidths {
-			sum += v
-		}
-		leftover := effWidth - sum
-		if leftover > 0 {
-			per := leftover / (cols - 1)
-			rem := leftover % (cols - 1)
-			for i := 1; i < cols; i++ {
-				add := per
-				if rem > 0 {
-					add++
-					rem--
-				}
-				headerColWidths[i] += add
-			}
-		}
-	} else {
-		base := effWidth / cols
-		for i := 0; i < cols; i++ {
-			headerColWidths[i] = base
-			if headerColWidths[i] < minCols[i] {
-				headerColWidths[i] = minCols[i]
-			}
-		}
-		sum := 0
-		for _, v := range headerColWidths {
-			sum += v
-		}
-		if sum != effWidth {
-			headerColWidths[cols-1] += effWidth - sum
-		}
-	}
-
-	// Convert effective column widths into header render widths. The item
-	// renderer formats most columns with a `-1` width (to reserve a char),
-	// then appends separators of length `sepLen`. Recreate the exact visual
-	// width here so header labels align with data.
-	headerRenderWidths := make([]int, cols)
-	for i := 0; i < cols; i++ {
-		if i == 0 {
-			// first column uses full effective width then separator
-			headerRenderWidths[i] = headerColWidths[i] + sepLen
-		} else if i < cols-1 {
-			// non-first, non-last columns are rendered with colWidths[i]-1
-			// plus separator
-			w := headerColWidths[i]
-			if w > 0 {
-				w = w - 1
-			}
-			headerRenderWidths[i] = w + sepLen
-			if headerRenderWidths[i] < 1 {
-				headerRenderWidths[i] = 1
-			}
-		} else {
-			// last column uses its full width (no trailing separator)
-			headerRenderWidths[i] = headerColWidths[i]
-			if headerRenderWidths[i] < 1 {
-				headerRenderWidths[i] = 1
-			}
-		}
-	}
-	header := ui.RenderColumnHeader(labels, headerRenderWidths)
+	// Size the columns for this frame; the rows rendered below reuse them.
+	m.columns.Compute(width, m.List.Items)
+	header := ui.FrameHeaderStyle.Render(m.columns.Header(m.sortArrow))
 
 	// Footer: cursor + optional search query
 	status := fmt.Sprintf("Node %d of %d", m.List.Cursor+1, len(m.List.Filtered))
@@ -279,5 +101,17 @@ func (m *Model) View() string {
 		framed = ui.OverlayCentered(framed, m.scaleDialog.View(), frame.FrameWidth, frame.FrameHeight)
 	}
 
+	if m.updateDialog.Visible {
+		framed = ui.OverlayCentered(framed, m.updateDialog.View(), frame.FrameWidth, frame.FrameHeight)
+	}
+
+	if m.createDialog.Visible {
+		framed = ui.OverlayCentered(framed, m.createDialog.View(), frame.FrameWidth, frame.FrameHeight)
+	}
+
+	if m.columnPicker.Visible {
+		framed = ui.OverlayCentered(framed, m.columnPicker.View(), frame.FrameWidth, frame.FrameHeight)
+	}
+
 	return framed
 }
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package stacksview
+
+import (
+	"strconv"
+	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
+	"swarmcli/ui/components/sorting"
+	"swarmcli/views/columnpicker"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// stackColumns are the built-in columns of the stacks table.
+var stackColumns = []columns.Column[docker.StackEntry]{
+	{Name: "stack", Title: "STACK", Min: 10, Fit: true, Search: true, Value: func(s docker.StackEntry) string { return s.Name }},
+	{Name: "services", Title: "SERVICES", Min: 8, Value: func(s docker.StackEntry) string { return strconv.Itoa(s.ServiceCount) }},
+	{Name: "tasks", Title: "TASKS", Min: 8, Value: func(s docker.StackEntry) string { return strconv.Itoa(s.NodeCount) }},
+}
+
+func (m *Model) newStackLayout() *columns.Layout[docker.StackEntry] {
+	layout, err := columns.NewLayout(ViewName, stackColumns, nil)
+	if err != nil {
+		l().Warnf("Failed to load column layout: %v", err)
+	}
+	layout.SelectedBg = lipgloss.Color("63")
+	layout.Terms = m.List.FreeTerms
+	return layout
+}
+
+// sortArrow returns the sort indicator for the given column, if it is the
+// active sort column.
+func (m *Model) sortArrow(name string) string {
+	fields := map[string]SortField{
+		"stack":    SortByName,
+		"services": SortByServices,
+		"tasks":    SortByTasks,
+	}
+	if f, ok := fields[name]; !ok || f != m.sortField {
+		return ""
+	}
+	if m.sortAscending {
+		return sorting.SortArrow(sorting.Ascending)
+	}
+	return sorting.SortArrow(sorting.Descending)
+}
+
+func (m *Model) openColumnPicker() {
+	m.columnPicker.Show(ViewName, m.columns.Specs())
+}
+
+// applyColumnLayout applies and persists the picker's result.
+func (m *Model) applyColumnLayout(msg columnpicker.ResultMsg) tea.Cmd {
+	if !msg.Confirmed {
+		return nil
+	}
+	if err := m.columns.Apply(msg.Reset, msg.Columns); err != nil {
+		l().Errorf("Failed to save column layout: %v", err)
+		m.showError("Failed to save column layout:\n" + err.Error())
+	}
+	return nil
+}
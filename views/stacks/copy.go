@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package stacksview
+
+import (
+	"swarmcli/core/clipboard"
+	"swarmcli/docker"
+)
+
+// CopyText returns the name of the stack under the cursor. Stacks have no ID,
+// so alt copies the name as well.
+func (m *Model) CopyText(alt bool) (string, string) {
+	items := clipboard.Selection(m.List.Marked(), m.List.Filtered, m.List.Cursor)
+	return clipboard.Describe(len(items), "stack name"), clipboard.Join(items, func(s docker.StackEntry) string { return s.Name })
+}
@@ -0,0 +1,99 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package stacksview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"swarmcli/core/editor"
+	"swarmcli/docker"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ComposeExportedMsg carries a stack rebuilt as a compose file, to be
+// written to disk or opened in $EDITOR.
+type ComposeExportedMsg struct {
+	Stack string
+	Data  []byte
+	Edit  bool
+	Err   error
+}
+
+// ComposeWrittenMsg reports where an exported compose file was written.
+type ComposeWrittenMsg struct {
+	Stack string
+	Path  string
+	Err   error
+}
+
+// composeEditedMsg carries an exported compose file as saved in $EDITOR.
+type composeEditedMsg struct {
+	Stack string
+	Data  []byte
+	Err   error
+}
+
+// composeExportPath is where the compose file of a stack is written: the
+// working directory, like the compose files deployed from it.
+func composeExportPath(stack string) string {
+	return stack + ".compose.yml"
+}
+
+func exportComposeCmd(stack string, edit bool) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx, cancel := context.WithTimeout(docker.WithContextName(context.Background(), ctxName), 10*time.Second)
+		defer cancel()
+		data, err := docker.ExportStack(ctx, stack)
+		return ComposeExportedMsg{Stack: stack, Data: data, Edit: edit, Err: err}
+	})
+}
+
+// composeExists reports whether writing the export would overwrite a file.
+func composeExists(path string) bool {
+	_, err := os.Stat(path)
+	return !errors.Is(err, fs.ErrNotExist)
+}
+
+func writeComposeCmd(msg ComposeExportedMsg) tea.Cmd {
+	return func() tea.Msg {
+		path, err := filepath.Abs(composeExportPath(msg.Stack))
+		if err == nil {
+			err = os.WriteFile(path, msg.Data, 0o644)
+		}
+		return ComposeWrittenMsg{Stack: msg.Stack, Path: path, Err: err}
+	}
+}
+
+// saveCompose writes an exported compose file, asking first when it would
+// overwrite one.
+func (m *Model) saveCompose(msg ComposeExportedMsg) tea.Cmd {
+	path := composeExportPath(msg.Stack)
+	if !composeExists(path) {
+		return writeComposeCmd(msg)
+	}
+	m.pendingExport = &msg
+	m.confirmDialog.ErrorMode = false
+	m.confirmDialog.Title = "Export Stack"
+	m.confirmDialog.Show(fmt.Sprintf("%s already exists. Overwrite?", path))
+	return nil
+}
+
+// editComposeCmd opens an exported compose file in $EDITOR; what is saved
+// there is then written like a plain export.
+func editComposeCmd(msg ComposeExportedMsg) tea.Cmd {
+	l().Infoln("Opening compose file of stack", msg.Stack, "in $EDITOR")
+	return editor.EditTempFileCmd(msg.Stack+"-*.compose.yml", msg.Data,
+		func(data []byte) tea.Msg {
+			return composeEditedMsg{Stack: msg.Stack, Data: data}
+		},
+		func(err error) tea.Msg {
+			return composeEditedMsg{Stack: msg.Stack, Err: err}
+		})
+}
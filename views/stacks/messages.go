@@ -4,8 +4,11 @@
 package stacksview
 
 import (
+	"context"
 	"swarmcli/docker"
 	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 type Msg struct {
@@ -21,3 +24,18 @@ type RefreshErrorMsg struct {
 type TickMsg time.Time
 
 const PollInterval = 5 * time.Second
+
+// ResourcesMsg lists what removing a stack would delete.
+type ResourcesMsg struct {
+	Resources docker.StackResources
+	Err       error
+}
+
+func loadResourcesCmd(stack string) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx, cancel := context.WithTimeout(docker.WithContextName(context.Background(), ctxName), 10*time.Second)
+		defer cancel()
+		res, err := docker.ListStackResources(ctx, stack)
+		return ResourcesMsg{Resources: res, Err: err}
+	})
+}
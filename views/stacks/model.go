@@ -4,9 +4,13 @@
 package stacksview
 
 import (
-	"strings"
+	"swarmcli/core/primitives/fuzzy"
 	"swarmcli/core/primitives/hash"
 	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
+	"swarmcli/views/columnpicker"
+	"swarmcli/views/confirmdialog"
+	"swarmcli/views/deploydialog"
 	"swarmcli/views/helpbar"
 	"time"
 
@@ -36,8 +40,21 @@ type Model struct {
 	DelayInitialLoad bool   // when true, delay the first LoadStacksCmd by 3s
 	sortField        SortField
 	sortAscending    bool // true for ascending, false for descending
+	deployDialog     *deploydialog.Model
+	confirmDialog    *confirmdialog.Model
+	// removal is the stack waiting for the confirmation of its removal.
+	removal *docker.StackResources
+	// pendingExport is the compose file waiting for the confirmation to
+	// overwrite the one on disk.
+	pendingExport *ComposeExportedMsg
+	columns       *columns.Layout[docker.StackEntry]
+	columnPicker  *columnpicker.Model
 }
 
+// lastComposeFile is the file last deployed from the stacks view, offered
+// again by the deploy dialog.
+var lastComposeFile string
+
 func New(width, height int) *Model {
 	vp := viewport.New(width, height)
 	vp.SetContent("")
@@ -46,12 +63,12 @@ func New(width, height int) *Model {
 	list := filterlist.FilterableList[docker.StackEntry]{
 		Viewport: vp,
 		// Render item will be initialized later after the column with is set
-		Match: func(s docker.StackEntry, query string) bool {
-			return strings.Contains(strings.ToLower(s.Name), strings.ToLower(query))
+		Score: func(s docker.StackEntry, query string) (int, bool) {
+			return fuzzy.Score(query, s.Name)
 		},
 	}
 
-	return &Model{
+	m := &Model{
 		List:          list,
 		Visible:       false,
 		firstResize:   true,
@@ -59,7 +76,12 @@ func New(width, height int) *Model {
 		height:        height,
 		sortField:     SortByName,
 		sortAscending: true,
+		deployDialog:  deploydialog.New(width, height),
+		confirmDialog: confirmdialog.New(width, height),
+		columnPicker:  columnpicker.New(width, height),
 	}
+	m.columns = m.newStackLayout()
+	return m
 }
 
 func (m *Model) Init() tea.Cmd {
@@ -78,6 +100,13 @@ func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
 	return []helpbar.HelpEntry{
 		{Key: "i/enter", Desc: "Services"},
 		{Key: "p", Desc: "Tasks"},
+		{Key: "D", Desc: "Deploy"},
+		{Key: "C", Desc: "Compare"},
+		{Key: "ctrl+d", Desc: "Remove"},
+		{Key: "g", Desc: "Graph"},
+		{Key: "t", Desc: "Xray"},
+		{Key: "x", Desc: "Export compose"},
+		{Key: "o", Desc: "Columns"},
 		{Key: "↑/↓", Desc: "Navigate"},
 		{Key: "pgup", Desc: "Page up"},
 		{Key: "pgdown", Desc: "Page down"},
@@ -87,20 +116,21 @@ func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
 	}
 }
 
-func LoadStacks(nodeID string) []docker.StackEntry {
-	stacks, _ := LoadStacksWithErr(nodeID)
+func LoadStacks(ctxName, nodeID string) []docker.StackEntry {
+	stacks, _ := LoadStacksWithErr(ctxName, nodeID)
 	return stacks
 }
 
-// LoadStacksWithErr refreshes snapshot and returns stack entries along with any error
-func LoadStacksWithErr(nodeID string) ([]docker.StackEntry, error) {
+// LoadStacksWithErr refreshes the snapshot of the named context and returns
+// stack entries along with any error
+func LoadStacksWithErr(ctxName, nodeID string) ([]docker.StackEntry, error) {
 	// Trigger a background refresh if needed, but prefer using cached data to avoid blocking UI
-	docker.TriggerRefreshIfNeeded()
+	docker.TriggerRefreshIfNeededFor(ctxName)
 
-	snap := docker.GetSnapshot()
+	snap := docker.SnapshotFor(ctxName)
 	if snap == nil {
 		// No cached data available; attempt a synchronous refresh as a last resort
-		s, err := docker.RefreshSnapshot()
+		s, err := docker.RefreshSnapshotFor(ctxName)
 		if err != nil {
 			l().Errorf("LoadStacksWithErr: RefreshSnapshot failed: %v", err)
 			return []docker.StackEntry{}, err
@@ -110,9 +140,11 @@ func LoadStacksWithErr(nodeID string) ([]docker.StackEntry, error) {
 	return snap.ToStackEntries(), nil
 }
 
+// LoadStacksCmd loads the stacks of the Docker context of the tab that
+// issued it.
 func LoadStacksCmd(nodeID string) tea.Cmd {
-	return func() tea.Msg {
-		stacks, err := LoadStacksWithErr(nodeID)
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		stacks, err := LoadStacksWithErr(ctxName, nodeID)
 		if err != nil {
 			l().Errorf("LoadStacksCmd: Error loading stacks: %v", err)
 		}
@@ -120,15 +152,15 @@ func LoadStacksCmd(nodeID string) tea.Cmd {
 		l().Debugf("LoadStacksCmd: Loaded %v stacks", stacks)
 
 		return Msg{NodeID: nodeID, Stacks: stacks}
-	}
+	})
 }
 
 // CheckStacksCmd checks if stacks have changed and returns update message if so
 func CheckStacksCmd(lastHash uint64, nodeID string) tea.Cmd {
-	return func() tea.Msg {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
 		l().Info("CheckStacksCmd: Polling for stack changes")
 
-		stacks := LoadStacks(nodeID)
+		stacks := LoadStacks(ctxName, nodeID)
 		var err error
 		newHash, err := hash.Compute(stacks)
 		if err != nil {
@@ -141,8 +173,6 @@ func CheckStacksCmd(lastHash uint64, nodeID string) tea.Cmd {
 			hash.Fmt(lastHash), hash.Fmt(newHash), len(stacks))
 
 		l().Debugf("CheckStacksCmd: Stacks: %+v", stacks)
-
-		ctxName, _ := docker.GetCurrentContext()
 		l().Debugf("CheckStacksCmd: docker context: %s", ctxName)
 
 		// Only return update message if something changed
@@ -153,7 +183,7 @@ func CheckStacksCmd(lastHash uint64, nodeID string) tea.Cmd {
 
 		l().Info("CheckStacksCmd: No changes detected, scheduling next poll")
 		return tickCmd()
-	}
+	})
 }
 
 func (m *Model) OnEnter() tea.Cmd { return nil }
@@ -163,7 +193,26 @@ func (m *Model) HasActiveFilter() bool {
 	return m.List.Query != ""
 }
 
+// HasActiveDialog reports whether a dialog is currently visible.
+func (m *Model) HasActiveDialog() bool {
+	return m.deployDialog.Visible || m.confirmDialog.Visible || m.columnPicker.Visible
+}
+
+func (m *Model) showError(message string) {
+	m.confirmDialog.Visible = true
+	m.confirmDialog.ErrorMode = true
+	m.confirmDialog.Message = message
+}
+
 // IsSearching reports whether the list is currently in search mode.
 func (m *Model) IsSearching() bool {
 	return m.List.Mode == filterlist.ModeSearching
 }
+
+// SelectedStack returns the stack under the cursor.
+func (m *Model) SelectedStack() (docker.StackEntry, bool) {
+	if m.List.Cursor < 0 || m.List.Cursor >= len(m.List.Filtered) {
+		return docker.StackEntry{}, false
+	}
+	return m.List.Filtered[m.List.Cursor], true
+}
@@ -9,6 +9,11 @@ import (
 	"swarmcli/core/primitives/hash"
 	"swarmcli/docker"
 	filterlist "swarmcli/ui/components/filterable/list"
+	"swarmcli/views/columnpicker"
+	"swarmcli/views/confirmdialog"
+	deployview "swarmcli/views/deploy"
+	"swarmcli/views/deploydialog"
+	diffview "swarmcli/views/diff"
 	helpview "swarmcli/views/help"
 	servicesview "swarmcli/views/services"
 	"swarmcli/views/view"
@@ -49,9 +54,109 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		m.List.Viewport.SetContent(fmt.Sprintf("Error refreshing stacks: %v", msg.Err))
 		return nil
 
+	case deploydialog.ResultMsg:
+		if !msg.Confirmed {
+			return nil
+		}
+		lastComposeFile = msg.File
+		if msg.Compare {
+			req := diffview.Request{File: msg.File, Stack: msg.Stack}
+			return func() tea.Msg {
+				return view.NavigateToMsg{
+					ViewName: diffview.ViewName,
+					Payload:  req,
+				}
+			}
+		}
+		req := deployview.Request{File: msg.File, Stack: msg.Stack, Options: msg.Options}
+		return func() tea.Msg {
+			return view.NavigateToMsg{
+				ViewName: deployview.ViewName,
+				Payload:  req,
+			}
+		}
+
+	case ResourcesMsg:
+		switch {
+		case msg.Err != nil:
+			m.showError(fmt.Sprintf("Failed to list stack %s: %v", msg.Resources.Stack, msg.Err))
+		case msg.Resources.Count() == 0:
+			m.showError(fmt.Sprintf("Stack %s has nothing left to remove.", msg.Resources.Stack))
+		default:
+			res := msg.Resources
+			m.removal = &res
+			m.confirmDialog.ErrorMode = false
+			m.confirmDialog.Title = "Remove Stack"
+			m.confirmDialog.Show(fmt.Sprintf("Remove stack %s and its %d objects?\n\n%s",
+				res.Stack, res.Count(), lipgloss.NewStyle().Width(72).Render(res.Describe())))
+		}
+		return nil
+
+	case ComposeExportedMsg:
+		switch {
+		case msg.Err != nil:
+			l().Errorf("Exporting stack %s failed: %v", msg.Stack, msg.Err)
+			m.showError(fmt.Sprintf("Failed to export stack %s: %v", msg.Stack, msg.Err))
+			return nil
+		case msg.Edit:
+			return editComposeCmd(msg)
+		}
+		return m.saveCompose(msg)
+
+	case ComposeWrittenMsg:
+		if msg.Err != nil {
+			l().Errorf("Writing compose file of stack %s failed: %v", msg.Stack, msg.Err)
+			m.showError(fmt.Sprintf("Failed to write %s: %v", msg.Path, msg.Err))
+			return nil
+		}
+		l().Infof("Exported stack %s to %s", msg.Stack, msg.Path)
+		lastComposeFile = msg.Path
+		m.showError(fmt.Sprintf("Wrote stack %s to\n%s", msg.Stack, msg.Path))
+		m.confirmDialog.Title = "Stack Exported"
+		return nil
+
+	case composeEditedMsg:
+		if msg.Err != nil {
+			l().Errorf("Editing compose file of stack %s failed: %v", msg.Stack, msg.Err)
+			m.showError(fmt.Sprintf("Failed to open stack %s: %v", msg.Stack, msg.Err))
+			return nil
+		}
+		return m.saveCompose(ComposeExportedMsg{Stack: msg.Stack, Data: msg.Data})
+
+	case confirmdialog.ResultMsg:
+		m.confirmDialog.Visible = false
+		m.confirmDialog.ErrorMode = false
+		if export := m.pendingExport; export != nil {
+			m.pendingExport = nil
+			if msg.Confirmed {
+				return writeComposeCmd(*export)
+			}
+			return nil
+		}
+		res := m.removal
+		m.removal = nil
+		if !msg.Confirmed || res == nil {
+			return nil
+		}
+		req := deployview.Request{Stack: res.Stack, Remove: res}
+		return func() tea.Msg {
+			return view.NavigateToMsg{
+				ViewName: deployview.ViewName,
+				Payload:  req,
+			}
+		}
+
+	case columnpicker.OpenMsg:
+		m.openColumnPicker()
+		return nil
+
+	case columnpicker.ResultMsg:
+		return m.applyColumnLayout(msg)
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		m.deployDialog.SetSize(msg.Width, msg.Height)
 		m.List.Viewport.Width = msg.Width
 		m.List.Viewport.Height = msg.Height
 		m.ready = true
@@ -70,6 +175,16 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		return nil
 
 	case tea.KeyMsg:
+		if m.deployDialog.Visible {
+			return m.deployDialog.Update(msg)
+		}
+		if m.confirmDialog.Visible {
+			return m.confirmDialog.Update(msg)
+		}
+		if m.columnPicker.Visible {
+			return m.columnPicker.Update(msg)
+		}
+
 		// --- if in search mode, handle all keys via FilterableList ---
 		if m.List.Mode == filterlist.ModeSearching {
 			m.List.HandleKey(msg)
@@ -125,6 +240,72 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			}
 		}
 
+		// Shift+D deploys a compose file, as the selected stack by default
+		if msg.String() == "D" {
+			stack := ""
+			if selected, ok := m.SelectedStack(); ok {
+				stack = selected.Name
+			}
+			m.deployDialog.Show(lastComposeFile, stack)
+			return nil
+		}
+
+		// Shift+C compares a compose file with the selected stack
+		if msg.String() == "C" {
+			if selected, ok := m.SelectedStack(); ok {
+				m.deployDialog.ShowCompare(lastComposeFile, selected.Name)
+			}
+			return nil
+		}
+
+		// Ctrl+D removes the selected stack, after listing what it holds
+		if msg.String() == "ctrl+d" {
+			if selected, ok := m.SelectedStack(); ok {
+				return loadResourcesCmd(selected.Name)
+			}
+			return nil
+		}
+
+		// 'g' draws what the services of the selected stack use
+		if msg.String() == "g" {
+			if selected, ok := m.SelectedStack(); ok {
+				return func() tea.Msg {
+					return view.NavigateToMsg{
+						ViewName: view.NameGraph,
+						Payload:  selected.Name,
+					}
+				}
+			}
+			return nil
+		}
+
+		// 't' shows the stack as a tree down to its containers
+		if msg.String() == "t" {
+			if selected, ok := m.SelectedStack(); ok {
+				return func() tea.Msg {
+					return view.NavigateToMsg{
+						ViewName: view.NameXray,
+						Payload:  docker.XrayScope{Stack: selected.Name},
+					}
+				}
+			}
+			return nil
+		}
+
+		// 'x' writes the stack as a compose file, Shift+X opens it in $EDITOR
+		if msg.String() == "x" || msg.String() == "X" {
+			if selected, ok := m.SelectedStack(); ok {
+				return exportComposeCmd(selected.Name, msg.String() == "X")
+			}
+			return nil
+		}
+
+		// 'o' opens the column picker
+		if msg.String() == "o" {
+			m.openColumnPicker()
+			return nil
+		}
+
 		// Sort by Stack name (Shift+S)
 		if msg.String() == "S" {
 			if m.sortField == SortByName {
@@ -287,6 +468,13 @@ func GetStacksHelpContent() []helpview.HelpCategory {
 			Items: []helpview.HelpItem{
 				{Keys: "<i/enter>", Description: "Show services for Stack"},
 				{Keys: "<p>", Description: "Show tasks for Stack"},
+				{Keys: "<shift+d>", Description: "Deploy a compose file"},
+				{Keys: "<shift+c>", Description: "Compare Stack with a compose file"},
+				{Keys: "<ctrl+d>", Description: "Remove Stack"},
+				{Keys: "<g>", Description: "Graph of what Stack uses"},
+				{Keys: "<t>", Description: "Tree of Stack down to its containers"},
+				{Keys: "<x>", Description: "Export Stack as a compose file"},
+				{Keys: "<shift+x>", Description: "Open Stack as a compose file in $EDITOR"},
 				{Keys: "</>", Description: "Filter"},
 			},
 		},
@@ -296,6 +484,7 @@ func GetStacksHelpContent() []helpview.HelpCategory {
 				{Keys: "<shift+s>", Description: "Order by Stack name"},
 				{Keys: "<shift+e>", Description: "Order by Services"},
 				{Keys: "<shift+t>", Description: "Order by Tasks"},
+				{Keys: "<o>", Description: "Columns: show/hide, reorder, pin"},
 			},
 		},
 		{
@@ -304,6 +493,10 @@ func GetStacksHelpContent() []helpview.HelpCategory {
 				{Keys: "<↑/↓>", Description: "Navigate"},
 				{Keys: "<pgup>", Description: "Page up"},
 				{Keys: "<pgdown>", Description: "Page down"},
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
+				{Keys: "<|>", Description: "Split with a linked pane"},
+				{Keys: "<tab>", Description: "Switch pane focus"},
 				{Keys: "<q>", Description: "Quit"},
 			},
 		},
@@ -312,6 +505,7 @@ func GetStacksHelpContent() []helpview.HelpCategory {
 
 // applySorting applies the current sort configuration to the filtered list
 func (m *Model) applySorting() {
+	defer m.List.PrepareSort()()
 	if len(m.List.Filtered) == 0 {
 		return
 	}
@@ -8,9 +8,6 @@ import (
 	"swarmcli/docker"
 	"swarmcli/ui"
 	filterlist "swarmcli/ui/components/filterable/list"
-	"swarmcli/ui/components/sorting"
-
-	"github.com/charmbracelet/lipgloss"
 )
 
 func (m *Model) View() string {
@@ -20,8 +17,6 @@ func (m *Model) View() string {
 
 	title := fmt.Sprintf("Stacks on Node (Total: %d)", len(m.List.Items))
 
-	// Compute three percentage-based column widths so columns start at
-	// 0%, 33%, 66% of the available content width.
 	width := m.List.Viewport.Width
 	if width <= 0 {
 		width = m.width
@@ -29,49 +24,9 @@ func (m *Model) View() string {
 	if width <= 0 {
 		width = 80
 	}
-	contentWidth := width
-
-	// Calculate column widths: each column gets 33% of width
-	colWidths := make([]int, 3)
-	colWidths[0] = (contentWidth * 33) / 100
-	colWidths[1] = (contentWidth * 33) / 100
-	colWidths[2] = contentWidth - colWidths[0] - colWidths[1] // Remaining width for last column
-
-	// Build header using frame header style so it appears on the first
-	// line inside the framed box and aligns with rows below.
-	stackLabel := "  STACK"
-	if m.sortField == SortByName {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		stackLabel = fmt.Sprintf("  STACK %s", arrow)
-	}
-
-	servicesLabel := "SERVICES"
-	if m.sortField == SortByServices {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		servicesLabel = fmt.Sprintf("SERVICES %s", arrow)
-	}
-
-	tasksLabel := "TASKS"
-	if m.sortField == SortByTasks {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		tasksLabel = fmt.Sprintf("TASKS %s", arrow)
-	}
-
-	headerLine := fmt.Sprintf("%-*s%-*s%-*s",
-		colWidths[0], stackLabel,
-		colWidths[1], servicesLabel,
-		colWidths[2], tasksLabel,
-	)
-	header := ui.FrameHeaderStyle.Render(headerLine)
+	// Size the columns for this frame; the rows rendered below reuse them.
+	m.columns.Compute(width, m.List.Items)
+	header := ui.FrameHeaderStyle.Render(m.columns.Header(m.sortArrow))
 
 	// Footer: cursor + optional search query
 	status := fmt.Sprintf("Stack %d of %d", m.List.Cursor+1, len(m.List.Filtered))
@@ -90,46 +45,8 @@ func (m *Model) View() string {
 		footer = statusBar
 	}
 
-	// Set RenderItem to format rows using the same colWidths so the
-	// header and rows align exactly.
 	m.List.RenderItem = func(s docker.StackEntry, selected bool, _ int) string {
-		// First column: current marker + name (we don't have a marker here but keep spacing)
-		nameMax := colWidths[0] - 2
-		if nameMax < 0 {
-			nameMax = 0
-		}
-		name := s.Name
-		if len(name) > nameMax {
-			if nameMax > 3 {
-				name = name[:nameMax-3] + "..."
-			} else {
-				name = name[:nameMax]
-			}
-		}
-		first := fmt.Sprintf("  %s", name)
-
-		svcStr := fmt.Sprintf("%d", s.ServiceCount)
-		svcMax := colWidths[1]
-		if len(svcStr) > svcMax {
-			svcStr = svcStr[:svcMax]
-		}
-
-		nodeStr := fmt.Sprintf("%d", s.NodeCount)
-		nodeMax := colWidths[2]
-		if len(nodeStr) > nodeMax {
-			nodeStr = nodeStr[:nodeMax]
-		}
-
-		line := fmt.Sprintf("%-*s%-*s%-*s",
-			colWidths[0], first,
-			colWidths[1], svcStr,
-			colWidths[2], nodeStr,
-		)
-		if selected {
-			selStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("230")).Background(lipgloss.Color("63")).Bold(true)
-			return selStyle.Render(line)
-		}
-		return lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Render(line)
+		return m.columns.Row(s, selected)
 	}
 
 	// Compute consistent frame sizing using shared helper (stacks is template)
@@ -150,5 +67,17 @@ func (m *Model) View() string {
 
 	framed := ui.RenderFramedBox(title, header, content, footer, frame.FrameWidth)
 
+	if m.confirmDialog.Visible {
+		framed = ui.OverlayCentered(framed, m.confirmDialog.View(), frame.FrameWidth, frame.FrameHeight)
+	}
+
+	if m.columnPicker.Visible {
+		framed = ui.OverlayCentered(framed, m.columnPicker.View(), frame.FrameWidth, frame.FrameHeight)
+	}
+
+	if m.deployDialog.Visible {
+		framed = ui.OverlayCentered(framed, m.deployDialog.View(), frame.FrameWidth, frame.FrameHeight)
+	}
+
 	return framed
 }
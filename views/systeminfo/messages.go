@@ -3,7 +3,10 @@
 
 package systeminfoview
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
 
 type Msg struct {
 	context     string
@@ -20,6 +23,14 @@ type SlowStatusMsg struct {
 	mem string
 }
 
+// Usage returns the CPU and memory usage percentages; ok is false when they
+// could not be collected.
+func (m SlowStatusMsg) Usage() (cpu, mem float64, ok bool) {
+	_, errCPU := fmt.Sscanf(m.cpu, "%f%%", &cpu)
+	_, errMem := fmt.Sscanf(m.mem, "%f%%", &mem)
+	return cpu, mem, errCPU == nil && errMem == nil
+}
+
 type TickMsg time.Time
 
 type SpinnerTickMsg time.Time
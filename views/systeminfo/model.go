@@ -49,7 +49,7 @@ type Model struct {
 // Create a new instance
 func New(version string) *Model {
 	// Get initial context synchronously to display immediately
-	context, _ := docker.GetCurrentContext()
+	context, _ := docker.ActiveContext()
 	return &Model{
 		content:        content(context, version, "", "", 0, 0),
 		version:        version,
@@ -81,7 +81,7 @@ func (m *Model) spinnerTickCmd() tea.Cmd {
 func LoadStatus() tea.Cmd {
 	return func() tea.Msg {
 		// Get fast values immediately
-		context, _ := docker.GetCurrentContext()
+		context, _ := docker.ActiveContext()
 		containers, _ := docker.GetContainerCount()
 		services, _ := docker.GetServiceCount()
 
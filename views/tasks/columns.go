@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package tasksview
+
+import (
+	"swarmcli/core/export"
+	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
+	"swarmcli/ui/components/sorting"
+	"swarmcli/views/columnpicker"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// taskRow is a task as listed: sibling marks a task of the same service as
+// the task above it, whose name is then drawn as a continuation.
+type taskRow struct {
+	docker.TaskEntry
+	sibling bool
+}
+
+// taskRows pairs the tasks with their place in the list.
+func taskRows(tasks []docker.TaskEntry) []taskRow {
+	rows := make([]taskRow, len(tasks))
+	for i, t := range tasks {
+		rows[i] = taskRow{TaskEntry: t, sibling: i > 0 && t.ServiceName == tasks[i-1].ServiceName}
+	}
+	return rows
+}
+
+// taskColumns are the built-in columns of the tasks table.
+var taskColumns = []columns.Column[taskRow]{
+	{Name: "id", Title: "ID", Min: 12, Value: func(t taskRow) string { return t.ID }},
+	{Name: "name", Title: "NAME", Min: 10, Fit: true,
+		Value: func(t taskRow) string { return t.Name },
+		Format: func(t taskRow, _ int) string {
+			if t.sibling {
+				return " \\_ " + t.Name
+			}
+			return t.Name
+		}},
+	{Name: "service", Title: "SERVICE", Min: 10, Hidden: true, Value: func(t taskRow) string { return t.ServiceName }},
+	{Name: "image", Title: "IMAGE", Min: 15, Value: func(t taskRow) string { return t.Image }},
+	{Name: "node", Title: "NODE", Min: 8, Value: func(t taskRow) string { return t.NodeName }},
+	{Name: "desired_state", Title: "STATE", Min: 8, Value: func(t taskRow) string { return t.DesiredState }},
+	{Name: "current_state", Title: "STATUS", Min: 10,
+		Value: func(t taskRow) string { return t.CurrentState },
+		Format: func(t taskRow, _ int) string {
+			if t.Error != "" {
+				return "Failed: " + t.Error
+			}
+			return t.CurrentState
+		}},
+	{Name: "error", Title: "ERROR", Min: 10, Hidden: true, Value: func(t taskRow) string { return t.Error }},
+	{Name: "ports", Title: "PORTS", Min: 8, Hidden: true, Value: func(t taskRow) string { return t.Ports }},
+	{Name: "created", Title: "CREATED", Min: 20, Hidden: true, Value: func(t taskRow) string { return export.Time(t.CreatedAt) }},
+	{Name: "updated", Title: "UPDATED", Min: 20, Hidden: true, Value: func(t taskRow) string { return export.Time(t.UpdatedAt) }},
+}
+
+func newTaskLayout() *columns.Layout[taskRow] {
+	layout, err := columns.NewLayout(ViewName, taskColumns, nil)
+	if err != nil {
+		l().Warnf("Failed to load column layout: %v", err)
+	}
+	return layout
+}
+
+// sortArrow returns the sort indicator for the given column, if it is the
+// active sort column.
+func (m *Model) sortArrow(name string) string {
+	fields := map[string]SortField{
+		"name":          SortByName,
+		"service":       SortByService,
+		"node":          SortByNode,
+		"desired_state": SortByState,
+	}
+	if f, ok := fields[name]; !ok || f != m.sortField {
+		return ""
+	}
+	if m.sortAscending {
+		return sorting.SortArrow(sorting.Ascending)
+	}
+	return sorting.SortArrow(sorting.Descending)
+}
+
+func (m *Model) openColumnPicker() {
+	m.columnPicker.Show(ViewName, m.columns.Specs())
+}
+
+// applyColumnLayout applies and persists the picker's result.
+func (m *Model) applyColumnLayout(msg columnpicker.ResultMsg) tea.Cmd {
+	if !msg.Confirmed {
+		return nil
+	}
+	m.layoutErr = m.columns.Apply(msg.Reset, msg.Columns)
+	if m.layoutErr != nil {
+		l().Errorf("Failed to save column layout: %v", m.layoutErr)
+	}
+	m.refreshContent()
+	return nil
+}
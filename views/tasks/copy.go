@@ -0,0 +1,19 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package tasksview
+
+import (
+	"swarmcli/core/clipboard"
+	"swarmcli/docker"
+)
+
+// CopyText returns the names (or IDs with alt) of the tasks currently listed.
+// The tasks view has no cursor, so it copies the whole filtered list.
+func (m *Model) CopyText(alt bool) (string, string) {
+	tasks := m.filteredTasks()
+	if alt {
+		return clipboard.Describe(len(tasks), "task ID"), clipboard.Join(tasks, func(t docker.TaskEntry) string { return t.ID })
+	}
+	return clipboard.Describe(len(tasks), "task name"), clipboard.Join(tasks, func(t docker.TaskEntry) string { return t.Name })
+}
@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package tasksview
+
+import (
+	"swarmcli/core/primitives/fuzzy"
+	"swarmcli/docker"
+	filterlist "swarmcli/ui/components/filterable/list"
+)
+
+// taskFilterFields are the attributes addressable from the filter query,
+// e.g. "service:api state:failed node:worker-*".
+var taskFilterFields = []filterlist.Field[docker.TaskEntry]{
+	{Name: "name", Values: func(t docker.TaskEntry) []string { return []string{t.Name} }},
+	{Name: "id", Values: func(t docker.TaskEntry) []string { return []string{t.ID} }},
+	{Name: "service", Values: func(t docker.TaskEntry) []string { return []string{t.ServiceName} }},
+	{Name: "image", Values: func(t docker.TaskEntry) []string { return []string{t.Image} }},
+	{Name: "node", Values: func(t docker.TaskEntry) []string { return []string{t.NodeName} }},
+	{Name: "state", Values: func(t docker.TaskEntry) []string { return []string{t.CurrentState} }},
+	{Name: "desired", Values: func(t docker.TaskEntry) []string { return []string{t.DesiredState} }},
+	{Name: "error", Values: func(t docker.TaskEntry) []string { return []string{t.Error} }},
+}
+
+// filteredTasks returns the tasks matching the current filter query, best
+// free-text match first.
+func (m *Model) filteredTasks() []docker.TaskEntry {
+	if m.filterQuery == "" {
+		return m.tasks
+	}
+	list := filterlist.FilterableList[docker.TaskEntry]{
+		Items:  m.tasks,
+		Query:  m.filterQuery,
+		Fields: taskFilterFields,
+		Score: func(t docker.TaskEntry, query string) (int, bool) {
+			return fuzzy.Score(query, t.Name, t.NodeName)
+		},
+	}
+	list.ApplyFilter()
+	return list.Filtered
+}
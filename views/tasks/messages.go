@@ -14,12 +14,23 @@ type TasksLoadedMsg struct {
 	Error error
 }
 
+// LoadNodeTasksCmd loads the tasks scheduled on a node.
+func LoadNodeTasksCmd(nodeID string) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		tasks, err := docker.GetTasksForNodeFor(ctxName, nodeID)
+		return TasksLoadedMsg{
+			Tasks: tasks,
+			Error: err,
+		}
+	})
+}
+
 func LoadTasksCmd(stackName string) tea.Cmd {
-	return func() tea.Msg {
-		tasks, err := docker.GetTasksForStack(stackName)
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		tasks, err := docker.GetTasksForStackFor(ctxName, stackName)
 		return TasksLoadedMsg{
 			Tasks: tasks,
 			Error: err,
 		}
-	}
+	})
 }
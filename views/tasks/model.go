@@ -5,6 +5,8 @@ package tasksview
 
 import (
 	"swarmcli/docker"
+	"swarmcli/ui/components/columns"
+	"swarmcli/views/columnpicker"
 	"swarmcli/views/helpbar"
 
 	"github.com/charmbracelet/bubbles/viewport"
@@ -24,11 +26,18 @@ type Model struct {
 	viewport      viewport.Model
 	visible       bool
 	stackName     string
+	node          NodeScope // set when listing the tasks of a node instead of a stack
 	tasks         []docker.TaskEntry
 	width         int
 	height        int
 	sortField     SortField
 	sortAscending bool // true for ascending, false for descending
+	filterQuery   string
+	filtering     bool // true while the filter query is being typed
+	columns       *columns.Layout[taskRow]
+	columnPicker  *columnpicker.Model
+	// layoutErr is the error of the last attempt to save the column layout.
+	layoutErr error
 }
 
 func New(width, height int, stackName string) *Model {
@@ -43,9 +52,25 @@ func New(width, height int, stackName string) *Model {
 		height:        height,
 		sortField:     SortByName,
 		sortAscending: true,
+		columns:       newTaskLayout(),
+		columnPicker:  columnpicker.New(width, height),
 	}
 }
 
+// NodeScope selects the tasks of a single node. It is accepted as the view
+// payload in place of a stack name.
+type NodeScope struct {
+	ID       string
+	Hostname string
+}
+
+// NewForNode creates a tasks view listing the tasks on a node.
+func NewForNode(width, height int, node NodeScope) *Model {
+	m := New(width, height, "")
+	m.node = node
+	return m
+}
+
 func (m *Model) Init() tea.Cmd {
 	return nil
 }
@@ -56,6 +81,15 @@ func (m *Model) Name() string {
 
 func (m *Model) OnEnter() tea.Cmd {
 	m.visible = true
+	return m.Refresh()
+}
+
+// Refresh reloads the tasks. The app calls it periodically while the view is
+// shown in a split pane.
+func (m *Model) Refresh() tea.Cmd {
+	if m.node.ID != "" {
+		return LoadNodeTasksCmd(m.node.ID)
+	}
 	return LoadTasksCmd(m.stackName)
 }
 
@@ -64,13 +98,32 @@ func (m *Model) OnExit() tea.Cmd {
 	return nil
 }
 
+// IsSearching reports whether the filter query is being typed, so the app
+// routes ESC and letter keys to the view.
+func (m *Model) IsSearching() bool { return m.filtering }
+
+// HasActiveFilter reports whether a filter query is active.
+func (m *Model) HasActiveFilter() bool { return m.filterQuery != "" }
+
+// HasActiveDialog reports whether the column picker is open.
+func (m *Model) HasActiveDialog() bool { return m.columnPicker.Visible }
+
 func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
+	if m.filtering {
+		return []helpbar.HelpEntry{
+			{Key: "Type", Desc: "Filter"},
+			{Key: "Enter", Desc: "Apply"},
+			{Key: "Esc", Desc: "Clear"},
+		}
+	}
 	return []helpbar.HelpEntry{
 		{Key: "↑/↓", Desc: "Scroll"},
+		{Key: "/", Desc: "Filter"},
 		{Key: "shift+n", Desc: "Sort by Name"},
 		{Key: "shift+s", Desc: "Sort by Service"},
 		{Key: "shift+d", Desc: "Sort by Node"},
 		{Key: "shift+t", Desc: "Sort by State"},
+		{Key: "o", Desc: "Columns"},
 		{Key: "Esc", Desc: "Back"},
 	}
 }
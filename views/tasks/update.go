@@ -6,6 +6,7 @@ package tasksview
 import (
 	"sort"
 	"swarmcli/ui"
+	"swarmcli/views/columnpicker"
 	helpview "swarmcli/views/help"
 	view "swarmcli/views/view"
 
@@ -24,6 +25,13 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		m.applySorting()
 		return nil
 
+	case columnpicker.OpenMsg:
+		m.openColumnPicker()
+		return nil
+
+	case columnpicker.ResultMsg:
+		return m.applyColumnLayout(msg)
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -49,8 +57,26 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 		return nil
 
 	case tea.KeyMsg:
+		if m.columnPicker.Visible {
+			return m.columnPicker.Update(msg)
+		}
+		if m.filtering {
+			m.handleFilterKey(msg)
+			return nil
+		}
+		if msg.Type == tea.KeyEsc && m.filterQuery != "" {
+			m.filterQuery = ""
+			m.refreshContent()
+			return nil
+		}
+
 		// Handle sorting keys
 		switch msg.String() {
+		case "/":
+			m.filtering = true
+			m.filterQuery = ""
+			m.refreshContent()
+			return nil
 		case "N": // Shift+N: Sort by Name
 			if m.sortField == SortByName {
 				m.sortAscending = !m.sortAscending
@@ -87,6 +113,9 @@ func (m *Model) Update(msg tea.Msg) tea.Cmd {
 			}
 			m.applySorting()
 			return nil
+		case "o":
+			m.openColumnPicker()
+			return nil
 		case "?":
 			return func() tea.Msg {
 				return view.NavigateToMsg{
@@ -143,6 +172,34 @@ func (m *Model) applySorting() {
 	}
 
 	// Re-render the viewport with sorted tasks
+	m.refreshContent()
+}
+
+// handleFilterKey edits the filter query while in filter mode.
+func (m *Model) handleFilterKey(msg tea.KeyMsg) {
+	switch msg.Type {
+	case tea.KeyRunes, tea.KeySpace:
+		m.filterQuery += string(msg.Runes)
+	case tea.KeyBackspace:
+		if m.filterQuery == "" {
+			m.filtering = false
+			return
+		}
+		r := []rune(m.filterQuery)
+		m.filterQuery = string(r[:len(r)-1])
+	case tea.KeyEnter:
+		m.filtering = false
+		return
+	case tea.KeyEsc:
+		m.filtering = false
+		m.filterQuery = ""
+	default:
+		return
+	}
+	m.refreshContent()
+}
+
+func (m *Model) refreshContent() {
 	m.viewport.SetContent(m.renderTasks())
 	m.viewport.GotoTop()
 }
@@ -157,6 +214,8 @@ func GetTasksHelpContent() []helpview.HelpCategory {
 				{Keys: "<shift+s>", Description: "Order by Service"},
 				{Keys: "<shift+d>", Description: "Order by Node"},
 				{Keys: "<shift+t>", Description: "Order by State"},
+				{Keys: "<o>", Description: "Columns: show/hide, reorder, pin"},
+				{Keys: "</>", Description: "Filter (e.g. service:api state:failed)"},
 			},
 		},
 		{
@@ -165,6 +224,8 @@ func GetTasksHelpContent() []helpview.HelpCategory {
 				{Keys: "<↑/↓>", Description: "Scroll"},
 				{Keys: "<pgup>", Description: "Page up"},
 				{Keys: "<pgdown>", Description: "Page down"},
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
 				{Keys: "<esc/q>", Description: "Back"},
 			},
 		},
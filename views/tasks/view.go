@@ -7,7 +7,6 @@ import (
 	"fmt"
 	"strings"
 	"swarmcli/ui"
-	"swarmcli/ui/components/sorting"
 )
 
 func (m *Model) View() string {
@@ -16,117 +15,47 @@ func (m *Model) View() string {
 	}
 
 	title := fmt.Sprintf("Tasks - Stack: %s (Total: %d)", m.stackName, len(m.tasks))
+	if m.node.ID != "" {
+		title = fmt.Sprintf("Tasks - Node: %s (Total: %d)", m.node.Hostname, len(m.tasks))
+	}
 	content := m.viewport.View()
-	footer := ui.StatusBarStyle.Render(fmt.Sprintf("Viewing %d tasks", len(m.tasks)))
+	status := fmt.Sprintf("Viewing %d tasks", len(m.tasks))
+	if m.filtering || m.filterQuery != "" {
+		status = fmt.Sprintf("Filter: %s (%d of %d tasks)", m.filterQuery, len(m.filteredTasks()), len(m.tasks))
+	}
+	if m.layoutErr != nil {
+		status = "Failed to save column layout: " + m.layoutErr.Error()
+	}
+	footer := ui.StatusBarStyle.Render(status)
 
+	if m.columnPicker.Visible {
+		content = ui.OverlayCentered(content, m.columnPicker.View(), m.viewport.Width, 0)
+	}
 	return ui.RenderFramedBox(title, "", content, footer, m.width)
 }
 
 func (m *Model) renderTasks() string {
 	if len(m.tasks) == 0 {
+		if m.node.ID != "" {
+			return "No tasks found on this node."
+		}
 		return "No tasks found for this stack."
 	}
+	tasks := m.filteredTasks()
+	if len(tasks) == 0 {
+		return "No tasks match: " + m.filterQuery
+	}
 
 	width := m.viewport.Width
 	if width < 80 {
 		width = 80
 	}
 
-	// Column headers with percentage-based widths and spacing
-	// Calculate widths: ID=14%, NAME=28%, IMAGE=20%, NODE=14%, STATE=12%, STATUS=remaining
-	colWidths := []int{
-		(width * 14) / 100, // ID
-		(width * 28) / 100, // NAME
-		(width * 20) / 100, // IMAGE
-		(width * 14) / 100, // NODE
-		(width * 12) / 100, // STATE
-		0,                  // STATUS (calculated below)
-	}
-	colWidths[5] = width - colWidths[0] - colWidths[1] - colWidths[2] - colWidths[3] - colWidths[4]
-
-	headerLabels := []string{"  ID", "NAME", "IMAGE", "NODE", "STATE", "STATUS"}
-
-	// Add sort indicators to labels
-	if m.sortField == SortByName {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		headerLabels[1] = fmt.Sprintf("NAME %s", arrow)
-	}
-	if m.sortField == SortByService {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		headerLabels[0] = fmt.Sprintf("  ID %s", arrow)
-	}
-	if m.sortField == SortByNode {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		headerLabels[3] = fmt.Sprintf("NODE %s", arrow)
-	}
-	if m.sortField == SortByState {
-		arrow := sorting.SortArrow(sorting.Ascending)
-		if !m.sortAscending {
-			arrow = sorting.SortArrow(sorting.Descending)
-		}
-		headerLabels[4] = fmt.Sprintf("STATE %s", arrow)
+	rows := taskRows(tasks)
+	m.columns.Compute(width, rows)
+	lines := []string{ui.FrameHeaderStyle.Render(m.columns.Header(m.sortArrow))}
+	for _, r := range rows {
+		lines = append(lines, m.columns.Row(r, false))
 	}
-
-	headerLine := fmt.Sprintf("%-*s%-*s%-*s%-*s%-*s%-*s",
-		colWidths[0], headerLabels[0],
-		colWidths[1], headerLabels[1],
-		colWidths[2], headerLabels[2],
-		colWidths[3], headerLabels[3],
-		colWidths[4], headerLabels[4],
-		colWidths[5], headerLabels[5])
-	header := ui.FrameHeaderStyle.Render(headerLine)
-
-	var lines []string
-	lines = append(lines, header)
-
-	for i, task := range m.tasks {
-		namePrefix := ""
-		if i > 0 && task.ServiceName == m.tasks[i-1].ServiceName {
-			namePrefix = " \\_ "
-		}
-
-		// Truncate values to fit column widths (accounting for leading spaces in first column)
-		id := "  " + truncate(task.ID, colWidths[0]-2)
-		name := truncate(namePrefix+task.Name, colWidths[1])
-		image := truncate(task.Image, colWidths[2])
-		node := truncate(task.NodeName, colWidths[3])
-		desiredState := truncate(task.DesiredState, colWidths[4])
-
-		status := task.CurrentState
-		if task.Error != "" {
-			status = "Failed: " + task.Error
-		}
-		status = truncate(status, colWidths[5])
-
-		line := fmt.Sprintf("%-*s%-*s%-*s%-*s%-*s%-*s",
-			colWidths[0], id,
-			colWidths[1], name,
-			colWidths[2], image,
-			colWidths[3], node,
-			colWidths[4], desiredState,
-			colWidths[5], status)
-
-		lines = append(lines, line)
-	}
-
 	return strings.Join(lines, "\n")
 }
-
-func truncate(s string, maxLen int) string {
-	if len(s) <= maxLen {
-		return s
-	}
-	if maxLen > 3 {
-		return s[:maxLen-1] + "…"
-	}
-	return s[:maxLen]
-}
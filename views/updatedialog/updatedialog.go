@@ -0,0 +1,407 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package updatedialog implements the service update wizard: a form over
+// the common fields of a service, then a diff of the old and new spec to
+// review before the update is sent. Specs edited in $EDITOR go straight to
+// the review.
+package updatedialog
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"swarmcli/core/diff"
+	"swarmcli/docker"
+	"swarmcli/ui"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/docker/docker/api/types/swarm"
+)
+
+// LoadedMsg carries the service to edit, freshly inspected.
+type LoadedMsg struct {
+	Service swarm.Service
+	Err     error
+}
+
+// PreparedMsg carries the new spec built from the form.
+type PreparedMsg struct {
+	Update *docker.ServiceUpdate
+	Err    error
+}
+
+// ResultMsg is emitted once the update was sent.
+type ResultMsg struct {
+	ServiceName string
+	// Update is what was sent, to merge it again after a version conflict.
+	Update   *docker.ServiceUpdate
+	Warnings []string
+	Err      error
+}
+
+// EditMsg asks to edit the reviewed spec again in $EDITOR.
+type EditMsg struct {
+	Update *docker.ServiceUpdate
+}
+
+// LoadCmd inspects the service to edit.
+func LoadCmd(serviceID string) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		svc, err := docker.LoadService(docker.WithContextName(context.Background(), ctxName), serviceID)
+		return LoadedMsg{Service: svc, Err: err}
+	})
+}
+
+type step int
+
+const (
+	stepEdit step = iota
+	stepPreparing
+	stepReview
+	stepApplying
+)
+
+// field is one input of the form, bound to a ServiceForm field.
+type field struct {
+	label       string
+	placeholder string
+	value       func(*docker.ServiceForm) *string
+}
+
+var fields = []field{
+	{"Image", "nginx:1.27", func(f *docker.ServiceForm) *string { return &f.Image }},
+	{"Replicas", "3", func(f *docker.ServiceForm) *string { return &f.Replicas }},
+	{"Env", "KEY=value, OTHER=value", func(f *docker.ServiceForm) *string { return &f.Env }},
+	{"Labels", "key=value, other=value", func(f *docker.ServiceForm) *string { return &f.Labels }},
+	{"CPU limit", "0.5", func(f *docker.ServiceForm) *string { return &f.CPULimit }},
+	{"Memory limit", "512MiB", func(f *docker.ServiceForm) *string { return &f.MemoryLimit }},
+	{"CPU reservation", "0.25", func(f *docker.ServiceForm) *string { return &f.CPUReservation }},
+	{"Memory reservation", "256MiB", func(f *docker.ServiceForm) *string { return &f.MemoryReservation }},
+	{"Constraints", "node.role==worker, node.labels.zone==eu-1", func(f *docker.ServiceForm) *string { return &f.Constraints }},
+	{"Configs", "name or name:/target", func(f *docker.ServiceForm) *string { return &f.Configs }},
+	{"Secrets", "name or name:target", func(f *docker.ServiceForm) *string { return &f.Secrets }},
+	{"Update config", "parallelism=1, delay=10s, failure-action=pause, order=stop-first", func(f *docker.ServiceForm) *string { return &f.UpdateConfig }},
+	{"Rollback config", "parallelism=1, failure-action=pause", func(f *docker.ServiceForm) *string { return &f.RollbackConfig }},
+}
+
+const (
+	labelWidth = 20
+	maxWidth   = 110
+)
+
+type Model struct {
+	Visible bool
+	Width   int
+	Height  int
+
+	service swarm.Service
+	inputs  []textinput.Model
+	focus   int
+	step    step
+	update  *docker.ServiceUpdate
+	diff    viewport.Model
+	err     string
+
+	// fromEditor is set when reviewing a spec edited in $EDITOR: there is
+	// no form to go back to
+	fromEditor bool
+	note       string
+}
+
+func New(width, height int) *Model {
+	return &Model{Width: width, Height: height, diff: viewport.New(0, 0)}
+}
+
+func (m *Model) Init() tea.Cmd { return nil }
+
+// SetSize fits the dialog to the view.
+func (m *Model) SetSize(width, height int) {
+	m.Width = width
+	m.Height = height
+}
+
+func (m *Model) contentWidth() int {
+	w := m.Width - 8
+	if w > maxWidth {
+		w = maxWidth
+	}
+	if w < 60 {
+		w = 60
+	}
+	return w
+}
+
+// Show opens the form on the service's current spec.
+func (m *Model) Show(svc swarm.Service) *Model {
+	m.Visible = true
+	m.service = svc
+	m.step = stepEdit
+	m.update = nil
+	m.err = ""
+	m.focus = 0
+	m.fromEditor = false
+	m.note = ""
+
+	form := docker.NewServiceForm(svc)
+	m.inputs = make([]textinput.Model, len(fields))
+	for i, f := range fields {
+		in := textinput.New()
+		in.Prompt = ""
+		in.Placeholder = f.placeholder
+		in.CharLimit = 4096
+		in.Width = m.contentWidth() - labelWidth - 6
+		in.SetValue(*f.value(&form))
+		m.inputs[i] = in
+	}
+	if svc.Spec.Mode.Replicated == nil {
+		m.inputs[1].Placeholder = "(global service)"
+	}
+	m.inputs[0].Focus()
+	return m
+}
+
+// ShowReview opens the review of a spec edited in $EDITOR; note explains
+// where it comes from, e.g. a merge.
+func (m *Model) ShowReview(update *docker.ServiceUpdate, note string) *Model {
+	m.Visible = true
+	m.service = update.Service
+	m.fromEditor = true
+	m.note = note
+	m.setUpdate(update)
+	return m
+}
+
+func (m *Model) setUpdate(update *docker.ServiceUpdate) {
+	m.step = stepReview
+	m.update = update
+	m.err = ""
+	m.diff.SetContent(strings.Join(ui.RenderDiff(update.Diff, 3), "\n"))
+	m.diff.GotoTop()
+}
+
+func (m *Model) Hide() *Model {
+	m.Visible = false
+	return m
+}
+
+func (m *Model) form() docker.ServiceForm {
+	var form docker.ServiceForm
+	for i, f := range fields {
+		*f.value(&form) = m.inputs[i].Value()
+	}
+	return form
+}
+
+func (m *Model) setFocus(i int) {
+	m.inputs[m.focus].Blur()
+	m.focus = (i + len(m.inputs)) % len(m.inputs)
+	m.inputs[m.focus].Focus()
+}
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	if !m.Visible {
+		return nil
+	}
+	switch msg := msg.(type) {
+	case PreparedMsg:
+		if m.step != stepPreparing {
+			return nil
+		}
+		if msg.Err != nil {
+			m.step = stepEdit
+			m.err = msg.Err.Error()
+			return nil
+		}
+		m.setUpdate(msg.Update)
+		return nil
+
+	case ResultMsg:
+		if msg.Err != nil {
+			m.step = stepReview
+			m.err = msg.Err.Error()
+			return nil
+		}
+		m.Visible = false
+		return nil
+
+	case tea.KeyMsg:
+		switch m.step {
+		case stepEdit:
+			return m.handleEditKey(msg)
+		case stepReview:
+			return m.handleReviewKey(msg)
+		}
+	}
+	return nil
+}
+
+func (m *Model) handleEditKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		m.Visible = false
+		return nil
+	case "tab", "down":
+		m.setFocus(m.focus + 1)
+		return nil
+	case "shift+tab", "up":
+		m.setFocus(m.focus - 1)
+		return nil
+	case "ctrl+z":
+		// Restore the focused field from the loaded spec
+		form := docker.NewServiceForm(m.service)
+		m.inputs[m.focus].SetValue(*fields[m.focus].value(&form))
+		return nil
+	case "enter":
+		m.step = stepPreparing
+		m.err = ""
+		svc, form := m.service, m.form()
+		return docker.ContextCmd(func(ctxName string) tea.Msg {
+			update, err := docker.PrepareServiceUpdate(docker.WithContextName(context.Background(), ctxName), svc, form)
+			return PreparedMsg{Update: update, Err: err}
+		})
+	}
+	var cmd tea.Cmd
+	m.inputs[m.focus], cmd = m.inputs[m.focus].Update(msg)
+	return cmd
+}
+
+func (m *Model) handleReviewKey(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "b":
+		if m.fromEditor {
+			m.Visible = false
+			return nil
+		}
+		m.step = stepEdit
+		m.err = ""
+		return nil
+	case "e":
+		if !m.fromEditor {
+			return nil
+		}
+		m.Visible = false
+		update := m.update
+		return func() tea.Msg { return EditMsg{Update: update} }
+	case "enter", "y":
+		if !m.update.Changed() {
+			m.Visible = false
+			return nil
+		}
+		m.step = stepApplying
+		m.err = ""
+		update := m.update
+		return docker.ContextCmd(func(ctxName string) tea.Msg {
+			ctx, cancel := context.WithTimeout(docker.WithContextName(context.Background(), ctxName), 30*time.Second)
+			defer cancel()
+			warnings, err := docker.ApplyServiceUpdate(ctx, update)
+			return ResultMsg{ServiceName: update.Spec.Name, Update: update, Warnings: warnings, Err: err}
+		})
+	}
+	var cmd tea.Cmd
+	m.diff, cmd = m.diff.Update(msg)
+	return cmd
+}
+
+func (m *Model) View() string {
+	if !m.Visible {
+		return ""
+	}
+	contentWidth := m.contentWidth()
+
+	titleStyle := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(lipgloss.Color("15")).
+		Background(lipgloss.Color("63")).
+		Padding(0, 1).
+		Width(contentWidth)
+
+	labelStyle := lipgloss.NewStyle().Width(labelWidth).Foreground(lipgloss.Color("245"))
+	focusStyle := labelStyle.Foreground(lipgloss.Color("63")).Bold(true)
+	itemStyle := lipgloss.NewStyle().Padding(0, 2).Width(contentWidth)
+	errStyle := itemStyle.Foreground(lipgloss.Color("9"))
+
+	helpStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("240")).
+		Padding(0, 2).
+		Width(contentWidth)
+
+	keyStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("63")).
+		Bold(true)
+
+	borderStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("63")).
+		Width(contentWidth + 2)
+
+	var lines []string
+	var help string
+	switch m.step {
+	case stepEdit, stepPreparing:
+		lines = append(lines, titleStyle.Render(fmt.Sprintf(" Update Service: %s ", m.service.Spec.Name)))
+		lines = append(lines, "")
+		for i, f := range fields {
+			label := labelStyle.Render(f.label)
+			if i == m.focus {
+				label = focusStyle.Render(f.label)
+			}
+			lines = append(lines, itemStyle.Render(label+m.inputs[i].View()))
+		}
+		lines = append(lines, "")
+		lines = append(lines, helpStyle.Render(`Lists are comma separated; quote items containing commas: "A=x,y"`))
+		if m.step == stepPreparing {
+			lines = append(lines, itemStyle.Render("Building the new spec…"))
+		}
+		help = fmt.Sprintf("%s Next/previous field • %s Undo field • %s Review changes • %s Cancel",
+			keyStyle.Render("<Tab/↑↓>"),
+			keyStyle.Render("<Ctrl+Z>"),
+			keyStyle.Render("<Enter>"),
+			keyStyle.Render("<Esc>"))
+
+	case stepReview, stepApplying:
+		added, removed := diff.Stats(m.update.Diff)
+		lines = append(lines, titleStyle.Render(fmt.Sprintf(" Review Update: %s (+%d -%d) ", m.service.Spec.Name, added, removed)))
+		if m.note != "" {
+			lines = append(lines, itemStyle.Foreground(lipgloss.Color("11")).Render(m.note))
+		}
+		lines = append(lines, "")
+		if !m.update.Changed() {
+			lines = append(lines, itemStyle.Render("No changes."))
+			help = fmt.Sprintf("%s Close • %s Back", keyStyle.Render("<Enter>"), keyStyle.Render("<Esc>"))
+			if m.fromEditor {
+				help = fmt.Sprintf("%s Close • %s Edit again", keyStyle.Render("<Enter>"), keyStyle.Render("<e>"))
+			}
+			break
+		}
+		m.diff.Width = contentWidth - 4
+		m.diff.Height = min(m.diff.TotalLineCount(), max(m.Height-14, 5))
+		lines = append(lines, lipgloss.NewStyle().Padding(0, 2).Render(m.diff.View()))
+		if m.step == stepApplying {
+			lines = append(lines, "", itemStyle.Render("Updating service…"))
+		}
+		help = fmt.Sprintf("%s Apply • %s Scroll • %s Back to form",
+			keyStyle.Render("<Enter>"),
+			keyStyle.Render("<↑/↓>"),
+			keyStyle.Render("<Esc>"))
+		if m.fromEditor {
+			help = fmt.Sprintf("%s Apply • %s Scroll • %s Edit again • %s Cancel",
+				keyStyle.Render("<Enter>"),
+				keyStyle.Render("<↑/↓>"),
+				keyStyle.Render("<e>"),
+				keyStyle.Render("<Esc>"))
+		}
+	}
+
+	if m.err != "" {
+		lines = append(lines, "", errStyle.Render(m.err))
+	}
+	lines = append(lines, "")
+	lines = append(lines, helpStyle.Render(help))
+
+	return borderStyle.Render(strings.Join(lines, "\n"))
+}
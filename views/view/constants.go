@@ -18,4 +18,10 @@ const (
 	NameLogs         = "logs"
 	NameLoading      = "loading"
 	NameSystemInfo   = "systeminfo"
+	NameClusters     = "clusters"
+	NameExplain      = "explain"
+	NameDeploy       = "deploy"
+	NameDiff         = "diff"
+	NameGraph        = "graph"
+	NameXray         = "xray"
 )
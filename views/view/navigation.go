@@ -16,6 +16,21 @@ type NavigateToMsg struct {
 
 type NavigateBackMsg struct{}
 
+// SplitMsg changes the split-pane layout. Mode is "v" (side by side), "h"
+// (stacked), "off", or empty to toggle.
+type SplitMsg struct {
+	Mode string
+}
+
 type Navigator interface {
 	NavigateTo(name string, payload any) tea.Cmd
 }
+
+// OpenTabMsg opens a tab bound to a Docker context, or switches to the tab
+// already showing it.
+type OpenTabMsg struct {
+	Context string
+}
+
+// CloseTabMsg closes the current tab.
+type CloseTabMsg struct{}
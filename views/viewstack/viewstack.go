@@ -5,8 +5,12 @@ package viewstack
 
 import "swarmcli/views/view"
 
+// Stack is the navigation history: the views behind the current one and,
+// after going back, the views ahead of it. Views keep their state while they
+// are in the history.
 type Stack struct {
-	stack []view.View
+	stack   []view.View
+	forward []view.View // next view last
 }
 
 // Push a view onto the stack
@@ -53,7 +57,51 @@ func (s *Stack) Len() int {
 	return len(s.stack)
 }
 
-// Reset clears the stack
+// Reset clears the stack and the forward history
 func (s *Stack) Reset() {
 	s.stack = nil
+	s.forward = nil
+}
+
+// Forward returns the views ahead of the current one, next view first
+func (s *Stack) Forward() []view.View {
+	cpy := make([]view.View, len(s.forward))
+	for i, v := range s.forward {
+		cpy[len(s.forward)-1-i] = v
+	}
+	return cpy
+}
+
+// ForwardLen returns how many views are ahead of the current one
+func (s *Stack) ForwardLen() int {
+	return len(s.forward)
+}
+
+// ClearForward drops the forward history, e.g. when a new view is opened
+func (s *Stack) ClearForward() {
+	s.forward = nil
+}
+
+// Move walks the history by offset steps (negative goes back) starting from
+// current and returns the view that becomes current. Views passed over are
+// kept in the history. It returns nil, leaving the history untouched, when
+// the offset is out of range.
+func (s *Stack) Move(current view.View, offset int) view.View {
+	switch {
+	case offset < 0 && -offset <= len(s.stack):
+		for ; offset < 0; offset++ {
+			s.forward = append(s.forward, current)
+			current = s.stack[len(s.stack)-1]
+			s.stack = s.stack[:len(s.stack)-1]
+		}
+		return current
+	case offset > 0 && offset <= len(s.forward):
+		for ; offset > 0; offset-- {
+			s.stack = append(s.stack, current)
+			current = s.forward[len(s.forward)-1]
+			s.forward = s.forward[:len(s.forward)-1]
+		}
+		return current
+	}
+	return nil
 }
@@ -0,0 +1,287 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package viewstack
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"swarmcli/docker"
+	"swarmcli/views/helpbar"
+	logsview "swarmcli/views/logs"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/docker/docker/pkg/stdcopy"
+)
+
+// stubView is a view without behaviour, standing in for the views around
+// the one under test.
+type stubView struct{ name string }
+
+func (v *stubView) Update(tea.Msg) tea.Cmd              { return nil }
+func (v *stubView) View() string                        { return v.name }
+func (v *stubView) Init() tea.Cmd                       { return nil }
+func (v *stubView) Name() string                        { return v.name }
+func (v *stubView) ShortHelpItems() []helpbar.HelpEntry { return nil }
+func (v *stubView) OnEnter() tea.Cmd                    { return nil }
+func (v *stubView) OnExit() tea.Cmd                     { return nil }
+
+func names(views []view.View) (out []string) {
+	for _, v := range views {
+		out = append(out, v.Name())
+	}
+	return out
+}
+
+func TestMove(t *testing.T) {
+	a, b, c := &stubView{"a"}, &stubView{"b"}, &stubView{"c"}
+	var s Stack
+	s.Push(a)
+	s.Push(b)
+
+	if got := s.Move(c, -3); got != nil {
+		t.Fatalf("Move(-3) = %s, want nil", got.Name())
+	}
+	if got := s.Move(c, 1); got != nil {
+		t.Fatalf("Move(1) without forward history = %s, want nil", got.Name())
+	}
+
+	current := s.Move(c, -2)
+	if current != a {
+		t.Fatalf("Move(-2) = %s, want a", current.Name())
+	}
+	if got := names(s.Forward()); strings.Join(got, ",") != "b,c" || s.Len() != 0 {
+		t.Fatalf("after going back: forward = %q, len = %d, want b,c and 0", got, s.Len())
+	}
+
+	current = s.Move(current, 1)
+	if current != b {
+		t.Fatalf("Move(1) = %s, want b", current.Name())
+	}
+	if got := names(s.Views()); strings.Join(got, ",") != "a" || s.ForwardLen() != 1 {
+		t.Fatalf("after going forward: back = %q, forward = %d, want a and 1", got, s.ForwardLen())
+	}
+
+	s.ClearForward()
+	if s.Move(current, 1) != nil {
+		t.Fatal("Move(1) after ClearForward went forward")
+	}
+}
+
+// fakeDaemon serves the service logs endpoint of the Docker API from an
+// in-memory log, following it until the client hangs up.
+type fakeDaemon struct {
+	mu      sync.Mutex
+	lines   []string
+	times   []time.Time
+	changed chan struct{}
+	// streams counts the log requests being served
+	streams atomic.Int32
+}
+
+func newFakeDaemon(t *testing.T) *fakeDaemon {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake docker CLI is a shell script")
+	}
+	d := &fakeDaemon{changed: make(chan struct{})}
+	srv := httptest.NewServer(d)
+	t.Cleanup(func() {
+		// hang up on streams a failing test left open
+		srv.CloseClientConnections()
+		srv.Close()
+	})
+
+	// Clients of a Docker context are set up from `docker context inspect`.
+	dir := t.TempDir()
+	inspect := fmt.Sprintf(`[{"Endpoints":{"docker":{"Host":"tcp://%s"}},"Storage":{"TLSPath":"%s"}}]`,
+		srv.Listener.Addr(), dir)
+	script := "#!/bin/sh\ncat <<'EOF'\n" + inspect + "\nEOF\n"
+	if err := os.WriteFile(filepath.Join(dir, "docker"), []byte(script), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	return d
+}
+
+func (d *fakeDaemon) add(lines ...string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, line := range lines {
+		d.lines = append(d.lines, line)
+		d.times = append(d.times, time.Now())
+	}
+	close(d.changed)
+	d.changed = make(chan struct{})
+}
+
+func (d *fakeDaemon) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if strings.HasSuffix(r.URL.Path, "/_ping") {
+		w.Header().Set("Api-Version", "1.47")
+		_, _ = w.Write([]byte("OK"))
+		return
+	}
+	if !strings.HasSuffix(r.URL.Path, "/services/svc/logs") {
+		http.NotFound(w, r)
+		return
+	}
+	var since time.Time
+	if s := r.URL.Query().Get("since"); s != "" {
+		sec, nsec, _ := strings.Cut(s, ".")
+		secs, _ := strconv.ParseInt(sec, 10, 64)
+		nsecs, _ := strconv.ParseInt(nsec, 10, 64)
+		since = time.Unix(secs, nsecs)
+	}
+
+	d.streams.Add(1)
+	defer d.streams.Add(-1)
+	w.Header().Set("Content-Type", "application/vnd.docker.multiplexed-stream")
+	w.WriteHeader(http.StatusOK)
+	out := stdcopy.NewStdWriter(w, stdcopy.Stdout)
+	for sent := 0; ; {
+		d.mu.Lock()
+		var batch []string
+		for ; sent < len(d.lines); sent++ {
+			if d.times[sent].After(since) {
+				batch = append(batch, d.lines[sent])
+			}
+		}
+		changed := d.changed
+		d.mu.Unlock()
+
+		for _, line := range batch {
+			_, _ = out.Write([]byte(line + "\n"))
+		}
+		w.(http.Flusher).Flush()
+		select {
+		case <-changed:
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// waitForStreams waits until the daemon serves n log streams.
+func (d *fakeDaemon) waitForStreams(t *testing.T, n int32) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for d.streams.Load() != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("daemon serves %d log streams, want %d", d.streams.Load(), n)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// run runs cmd as the app would for a tab on the fake context.
+func run(t *testing.T, cmd tea.Cmd) tea.Msg {
+	t.Helper()
+	done := make(chan tea.Msg, 1)
+	go func() {
+		msg := cmd()
+		if req, ok := msg.(docker.ContextRequest); ok {
+			msg = req("fake")
+		}
+		done <- msg
+	}()
+	select {
+	case msg := <-done:
+		return msg
+	case <-time.After(5 * time.Second):
+		t.Fatal("command did not return")
+		return nil
+	}
+}
+
+// pump feeds the logs view the messages of cmd and of the commands it
+// returns until it received n lines, and returns the pending read.
+func pump(t *testing.T, m *logsview.Model, cmd tea.Cmd, n int) tea.Cmd {
+	t.Helper()
+	for n > 0 {
+		if cmd == nil {
+			t.Fatalf("stream stopped with %d lines to go", n)
+		}
+		msg := run(t, cmd)
+		if _, ok := msg.(logsview.LineMsg); ok {
+			n--
+		}
+		cmd = m.Update(msg)
+	}
+	return cmd
+}
+
+func loaded(m *logsview.Model) string {
+	_, text := m.CopyText(true)
+	return text
+}
+
+// TestLogsResumeThroughHistory goes back from a streaming logs view and
+// forward to it again: the stream stops while the view is in the history
+// and resumes with the lines written meanwhile, each line loaded once.
+func TestLogsResumeThroughHistory(t *testing.T) {
+	d := newFakeDaemon(t)
+	d.add("one", "two")
+
+	service := docker.ServiceEntry{ServiceID: "svc", ServiceName: "web"}
+	logs := logsview.New(80, 20, 100, service)
+	services := &stubView{"services"}
+	var s Stack
+	s.Push(services)
+
+	read := pump(t, logs, logsview.StartStreamingCmd(logs.StreamCtx, service, 0, 100), 2)
+	d.waitForStreams(t, 1)
+
+	// back: the stream stops while the logs wait in the history
+	if s.Move(logs, -1) != services {
+		t.Fatal("going back did not return to the services")
+	}
+	_ = logs.OnExit()
+	d.waitForStreams(t, 0)
+	stale := run(t, read)
+	if _, ok := stale.(logsview.StreamDoneMsg); !ok {
+		t.Fatalf("read of the stopped stream = %T, want StreamDoneMsg", stale)
+	}
+
+	d.add("three")
+
+	// forward: the view picks up where it stopped
+	if s.Move(services, 1) != logs {
+		t.Fatal("going forward did not return to the logs")
+	}
+	enter := logs.OnEnter()
+	if enter == nil {
+		t.Fatal("OnEnter did not resume the stream")
+	}
+	read = pump(t, logs, enter, 1)
+	d.waitForStreams(t, 1)
+	if logs.OnEnter() != nil {
+		t.Error("OnEnter on a streaming view started another stream")
+	}
+	if logs.Update(stale) != nil {
+		t.Error("the stopped stream's end was not ignored")
+	}
+
+	d.add("four")
+	read = pump(t, logs, read, 1)
+	if got, want := loaded(logs), "one\ntwo\nthree\nfour"; got != want {
+		t.Errorf("loaded lines = %q, want %q", got, want)
+	}
+	d.waitForStreams(t, 1)
+
+	_ = logs.OnExit()
+	d.waitForStreams(t, 0)
+	if _, ok := run(t, read).(logsview.StreamDoneMsg); !ok {
+		t.Error("the stream did not end after leaving the view")
+	}
+}
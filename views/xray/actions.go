@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package xrayview
+
+import (
+	"context"
+	"fmt"
+	"swarmcli/docker"
+	inspectview "swarmcli/views/inspect"
+	logsview "swarmcli/views/logs"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// kindTitles name the kinds of items in titles.
+var kindTitles = map[string]string{
+	docker.XrayCluster:   "Cluster",
+	docker.XrayStack:     "Stack",
+	docker.XrayNode:      "Node",
+	docker.XrayService:   "Service",
+	docker.XrayTask:      "Task",
+	docker.XrayContainer: "Container",
+	docker.XrayConfig:    "Config",
+	docker.XraySecret:    "Secret",
+}
+
+// logs opens the logs of the service under the cursor, or of the task the
+// item under the cursor belongs to.
+func (m *Model) logs() tea.Cmd {
+	r, ok := m.selectedRow()
+	if !ok {
+		return nil
+	}
+	it := r.item
+	if it.ServiceID == "" {
+		m.showError(fmt.Sprintf("A %s has no logs of its own: select one of its services or tasks.", it.Kind))
+		return nil
+	}
+	service := docker.ServiceEntry{ServiceID: it.ServiceID, ServiceName: it.ServiceName, StackName: it.StackName}
+	var payload any = service
+	if task := m.ancestor(m.List.Cursor, docker.XrayTask); task != nil {
+		payload = logsview.TaskTarget{Service: service, TaskID: task.ID, Task: task.Name}
+	}
+	return func() tea.Msg {
+		return view.NavigateToMsg{
+			ViewName: logsview.ViewName,
+			Payload:  payload,
+		}
+	}
+}
+
+// inspect opens the item under the cursor in the inspect view.
+func (m *Model) inspect() tea.Cmd {
+	it, ok := m.SelectedItem()
+	if !ok {
+		return nil
+	}
+	var t docker.InspectType
+	id := it.ID
+	switch it.Kind {
+	case docker.XrayStack:
+		t, id = docker.InspectStack, it.Name
+	case docker.XrayNode:
+		t = docker.InspectNode
+	case docker.XrayService:
+		t = docker.InspectService
+	case docker.XrayTask:
+		t = docker.InspectTask
+	case docker.XrayContainer:
+		t = docker.InspectContainer
+	case docker.XrayConfig, docker.XraySecret:
+	default:
+		m.showError("Select a stack, service, task or container to inspect.")
+		return nil
+	}
+	title := fmt.Sprintf("%s: %s", kindTitles[it.Kind], it.Name)
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		content, err := inspectItem(docker.WithContextName(context.Background(), ctxName), t, it, id)
+		if err != nil {
+			content = fmt.Sprintf("Error inspecting %s %q: %v", it.Kind, it.Name, err)
+		}
+		return view.NavigateToMsg{
+			ViewName: inspectview.ViewName,
+			Payload: map[string]interface{}{
+				"title": title,
+				"json":  content,
+			},
+		}
+	})
+}
+
+func inspectItem(ctx context.Context, t docker.InspectType, it *docker.XrayItem, id string) (string, error) {
+	switch it.Kind {
+	case docker.XrayConfig:
+		cfg, err := docker.InspectConfig(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		data, err := cfg.JSON()
+		return string(data), err
+	case docker.XraySecret:
+		sec, err := docker.InspectSecret(ctx, id)
+		if err != nil {
+			return "", err
+		}
+		data, err := sec.JSON()
+		return string(data), err
+	}
+	return docker.Inspect(ctx, t, id)
+}
+
+// confirmRestart asks to restart the services of the item under the
+// cursor: a stack restarts all of its own, and the items below a service
+// restart that service, as swarm only restarts tasks by service.
+func (m *Model) confirmRestart() {
+	r, ok := m.selectedRow()
+	if !ok {
+		return
+	}
+	it := r.item
+	var message string
+	switch {
+	case it.Kind == docker.XrayStack:
+		m.restarting = nil
+		for _, c := range it.Children {
+			m.restarting = append(m.restarting, c.ServiceName)
+		}
+		message = fmt.Sprintf("Restart the %d services of stack %q?", len(m.restarting), it.Name)
+	case it.Kind == docker.XrayService:
+		m.restarting = []string{it.ServiceName}
+		message = fmt.Sprintf("Restart service %q?", it.ServiceName)
+	case it.ServiceName != "":
+		m.restarting = []string{it.ServiceName}
+		message = fmt.Sprintf("Swarm restarts tasks by service.\n\nRestart service %q?", it.ServiceName)
+	default:
+		m.showError(fmt.Sprintf("Select a stack or one of its services to restart, not a %s.", it.Kind))
+		return
+	}
+	m.confirmDialog.Visible = true
+	m.confirmDialog.ErrorMode = false
+	m.confirmDialog.Title = "Restart"
+	m.confirmDialog.Message = message
+}
+
+func restartCmd(services []string) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx := docker.WithContextName(context.Background(), ctxName)
+		for _, name := range services {
+			l().Infof("Executing restart for service: %s", name)
+			if err := docker.RestartService(ctx, name); err != nil {
+				l().Errorf("Failed to restart service %s: %v", name, err)
+				return RestartedMsg{Services: services, Err: fmt.Errorf("restarting %s: %w", name, err)}
+			}
+		}
+		return RestartedMsg{Services: services}
+	})
+}
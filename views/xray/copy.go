@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package xrayview
+
+import "swarmcli/core/clipboard"
+
+// CopyText returns the name (or ID with alt) of the item under the cursor.
+func (m *Model) CopyText(alt bool) (string, string) {
+	it, ok := m.SelectedItem()
+	if !ok {
+		return clipboard.Describe(0, "name"), ""
+	}
+	if alt && it.ID != "" {
+		return clipboard.Describe(1, it.Kind+" ID"), it.ID
+	}
+	return clipboard.Describe(1, it.Kind+" name"), it.Name
+}
@@ -0,0 +1,26 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package xrayview
+
+import (
+	"strconv"
+	"swarmcli/core/export"
+)
+
+// ExportTable returns the items listed, in tree order.
+func (m *Model) ExportTable() export.Table {
+	t := export.Table{Columns: []string{"KIND", "NAME", "ID", "STATE", "NODE", "HEALTH", "EXIT CODE", "TARGET", "ERROR", "PARENT"}}
+	for _, r := range m.List.Filtered {
+		it := r.item
+		exit, parent := "", ""
+		if it.Exited {
+			exit = strconv.Itoa(it.ExitCode)
+		}
+		if r.parent >= 0 {
+			parent = m.List.Filtered[r.parent].item.Name
+		}
+		t.Rows = append(t.Rows, []string{it.Kind, it.Name, it.ID, it.State, it.Node, it.Health, exit, it.Target, it.Err, parent})
+	}
+	return t
+}
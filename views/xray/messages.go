@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package xrayview
+
+import (
+	"context"
+	"swarmcli/docker"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// RefreshInterval is how often the tree is rebuilt while shown.
+const RefreshInterval = 5 * time.Second
+
+type Msg struct {
+	Root    *docker.XrayItem
+	History bool
+	Err     error
+}
+
+type TickMsg time.Time
+
+// RestartedMsg reports the services restarted from the tree.
+type RestartedMsg struct {
+	Services []string
+	Err      error
+}
+
+func tickCmd() tea.Cmd {
+	return tea.Tick(RefreshInterval, func(t time.Time) tea.Msg {
+		return TickMsg(t)
+	})
+}
+
+// LoadCmd builds the tree of the scope; history keeps the tasks swarm
+// shut down.
+func LoadCmd(scope docker.XrayScope, history bool) tea.Cmd {
+	return docker.ContextCmd(func(ctxName string) tea.Msg {
+		ctx, cancel := context.WithTimeout(docker.WithContextName(context.Background(), ctxName), 30*time.Second)
+		defer cancel()
+		root, err := docker.LoadXray(ctx, scope, history)
+		return Msg{Root: root, History: history, Err: err}
+	})
+}
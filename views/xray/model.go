@@ -0,0 +1,125 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package xrayview
+
+import (
+	"swarmcli/docker"
+	"swarmcli/views/confirmdialog"
+	"swarmcli/views/helpbar"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+
+	filterlist "swarmcli/ui/components/filterable/list"
+)
+
+type Model struct {
+	List   filterlist.FilterableList[row]
+	width  int
+	height int
+
+	scope docker.XrayScope
+	root  *docker.XrayItem
+	// expanded records the items folded or unfolded by hand, by key.
+	expanded map[string]bool
+	// history lists the tasks swarm shut down too.
+	history bool
+	err     error
+
+	confirmDialog *confirmdialog.Model
+	// restarting holds the services to restart once confirmed.
+	restarting []string
+
+	loaded      bool
+	loading     bool
+	active      bool
+	tickPending bool
+}
+
+func New(width, height int, scope docker.XrayScope) *Model {
+	vp := viewport.New(width, height)
+	list := filterlist.FilterableList[row]{
+		Viewport: vp,
+		// The tree filters itself, keeping the ancestors of the matches
+		Score: func(row, string) (int, bool) { return 0, true },
+	}
+	return &Model{
+		List:          list,
+		width:         width,
+		height:        height,
+		scope:         scope,
+		expanded:      map[string]bool{},
+		confirmDialog: confirmdialog.New(width, height),
+	}
+}
+
+func (m *Model) Init() tea.Cmd { return nil }
+
+func (m *Model) Name() string { return ViewName }
+
+func (m *Model) ShortHelpItems() []helpbar.HelpEntry {
+	return []helpbar.HelpEntry{
+		{Key: "enter", Desc: "Fold/unfold"},
+		{Key: "l", Desc: "Logs"},
+		{Key: "i", Desc: "Inspect"},
+		{Key: "r", Desc: "Restart"},
+		{Key: "a", Desc: "All tasks"},
+		{Key: "/", Desc: "Filter"},
+		{Key: "?", Desc: "Help"},
+		{Key: "q", Desc: "Close"},
+	}
+}
+
+// OnEnter rebuilds the tree and resumes polling.
+func (m *Model) OnEnter() tea.Cmd {
+	m.active = true
+	return m.load()
+}
+
+// OnExit stops polling.
+func (m *Model) OnExit() tea.Cmd {
+	m.active = false
+	return nil
+}
+
+func (m *Model) load() tea.Cmd {
+	if m.loading {
+		return nil
+	}
+	m.loading = true
+	return LoadCmd(m.scope, m.history)
+}
+
+// IsSearching reports whether the list is currently in search mode.
+func (m *Model) IsSearching() bool {
+	return m.List.Mode == filterlist.ModeSearching
+}
+
+func (m *Model) HasActiveFilter() bool {
+	return m.List.Query != ""
+}
+
+// HasActiveDialog reports whether a dialog is currently visible.
+func (m *Model) HasActiveDialog() bool {
+	return m.confirmDialog.Visible
+}
+
+func (m *Model) showError(message string) {
+	m.confirmDialog.Visible = true
+	m.confirmDialog.ErrorMode = true
+	m.confirmDialog.Message = message
+}
+
+func (m *Model) selectedRow() (row, bool) {
+	if m.List.Cursor < 0 || m.List.Cursor >= len(m.List.Filtered) {
+		return row{}, false
+	}
+	return m.List.Filtered[m.List.Cursor], true
+}
+
+// SelectedItem returns the item under the cursor.
+func (m *Model) SelectedItem() (*docker.XrayItem, bool) {
+	r, ok := m.selectedRow()
+	return r.item, ok
+}
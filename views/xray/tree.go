@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package xrayview
+
+import (
+	"swarmcli/core/primitives/fuzzy"
+	"swarmcli/docker"
+)
+
+// row is an item of the tree as listed: the guides drawn before it and the
+// row of its parent, -1 for the root.
+type row struct {
+	item   *docker.XrayItem
+	guides string
+	parent int
+}
+
+// isExpanded reports whether the children of an item are listed.
+// Containers start folded, everything else unfolded.
+func (m *Model) isExpanded(it *docker.XrayItem) bool {
+	if v, ok := m.expanded[it.Key()]; ok {
+		return v
+	}
+	return it.Kind != docker.XrayContainer
+}
+
+// matches reports whether an item matches the filter query.
+func matches(it *docker.XrayItem, query string) bool {
+	_, ok := fuzzy.Score(query, it.Name, it.ID, it.State, it.Node, it.Health, it.Target)
+	return ok
+}
+
+// flatten lists the rows of the unfolded items. With a query, only the
+// items matching it are listed, with their ancestors unfolded.
+func (m *Model) flatten() []row {
+	if m.root == nil {
+		return nil
+	}
+	query := m.List.Query
+	keep := map[*docker.XrayItem]bool{}
+	var mark func(it *docker.XrayItem) bool
+	mark = func(it *docker.XrayItem) bool {
+		found := query == "" || matches(it, query)
+		for _, c := range it.Children {
+			if mark(c) {
+				found = true
+			}
+		}
+		keep[it] = found
+		return found
+	}
+	mark(m.root)
+
+	var rows []row
+	var walk func(it *docker.XrayItem, guides, indent string, parent int)
+	walk = func(it *docker.XrayItem, guides, indent string, parent int) {
+		rows = append(rows, row{item: it, guides: guides, parent: parent})
+		self := len(rows) - 1
+		if query == "" && !m.isExpanded(it) {
+			return
+		}
+		var children []*docker.XrayItem
+		for _, c := range it.Children {
+			if keep[c] {
+				children = append(children, c)
+			}
+		}
+		for i, c := range children {
+			if i == len(children)-1 {
+				walk(c, indent+"└─ ", indent+"   ", self)
+			} else {
+				walk(c, indent+"├─ ", indent+"│  ", self)
+			}
+		}
+	}
+	if keep[m.root] {
+		walk(m.root, "", "", -1)
+	}
+	return rows
+}
+
+// rebuild lists the rows again, keeping the cursor on the same item.
+func (m *Model) rebuild() {
+	selected := ""
+	if r, ok := m.selectedRow(); ok {
+		selected = r.item.Key()
+	}
+	rows := m.flatten()
+	m.List.Items = rows
+	m.List.Filtered = rows
+	cursor := min(m.List.Cursor, max(len(rows)-1, 0))
+	for i, r := range rows {
+		if r.item.Key() == selected {
+			cursor = i
+			break
+		}
+	}
+	m.List.Cursor = cursor
+}
+
+// setAll folds or unfolds every item but the root.
+func (m *Model) setAll(expanded bool) {
+	var walk func(it *docker.XrayItem)
+	walk = func(it *docker.XrayItem) {
+		if len(it.Children) > 0 && it != m.root {
+			m.expanded[it.Key()] = expanded
+		}
+		for _, c := range it.Children {
+			walk(c)
+		}
+	}
+	if m.root != nil {
+		walk(m.root)
+	}
+	m.rebuild()
+}
+
+// ancestor returns the nearest item of a kind above a row, or the row's
+// own item when it is of that kind.
+func (m *Model) ancestor(i int, kind string) *docker.XrayItem {
+	for i >= 0 && i < len(m.List.Filtered) {
+		r := m.List.Filtered[i]
+		if r.item.Kind == kind {
+			return r.item
+		}
+		i = r.parent
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package xrayview
+
+import (
+	"fmt"
+	"strings"
+	filterlist "swarmcli/ui/components/filterable/list"
+	"swarmcli/views/confirmdialog"
+	helpview "swarmcli/views/help"
+	"swarmcli/views/view"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func (m *Model) Update(msg tea.Msg) tea.Cmd {
+	switch msg := msg.(type) {
+	case Msg:
+		m.loading = false
+		if msg.History != m.history {
+			// Built before the tasks shown were toggled
+			return m.load()
+		}
+		m.loaded = true
+		m.err = msg.Err
+		if msg.Err != nil {
+			l().Errorf("building xray of %+v: %v", m.scope, msg.Err)
+		} else {
+			m.root = msg.Root
+			m.rebuild()
+		}
+		if m.active && !m.tickPending {
+			m.tickPending = true
+			return tickCmd()
+		}
+		return nil
+
+	case TickMsg:
+		m.tickPending = false
+		if m.active {
+			return m.load()
+		}
+		return nil
+
+	case RestartedMsg:
+		if msg.Err != nil {
+			m.showError(fmt.Sprintf("Failed to restart: %v", msg.Err))
+			return nil
+		}
+		m.showError(fmt.Sprintf("Restarting %s.", strings.Join(msg.Services, ", ")))
+		m.confirmDialog.Title = "Restarted"
+		return m.load()
+
+	case confirmdialog.ResultMsg:
+		m.confirmDialog.Visible = false
+		m.confirmDialog.ErrorMode = false
+		services := m.restarting
+		m.restarting = nil
+		if msg.Confirmed && len(services) > 0 {
+			return restartCmd(services)
+		}
+		return nil
+
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.List.Viewport.Width = msg.Width
+		m.List.Viewport.Height = msg.Height
+		m.confirmDialog.Width = msg.Width
+		m.confirmDialog.Height = msg.Height
+		return nil
+
+	case tea.KeyMsg:
+		if m.confirmDialog.Visible {
+			return m.confirmDialog.Update(msg)
+		}
+		if m.List.Mode == filterlist.ModeSearching {
+			m.List.HandleKey(msg)
+			m.rebuild()
+			return nil
+		}
+		if msg.Type == tea.KeyEsc && m.List.Query != "" {
+			m.List.Query = ""
+			m.rebuild()
+			return nil
+		}
+
+		m.List.HandleKey(msg)
+		if msg.String() == "/" {
+			m.rebuild()
+			return nil
+		}
+
+		switch msg.String() {
+		case "enter":
+			if r, ok := m.selectedRow(); ok && len(r.item.Children) > 0 && r.parent >= 0 {
+				m.expanded[r.item.Key()] = !m.isExpanded(r.item)
+				m.rebuild()
+			}
+		case "right":
+			if r, ok := m.selectedRow(); ok && len(r.item.Children) > 0 {
+				if m.isExpanded(r.item) || m.List.Query != "" {
+					m.List.Cursor++
+				} else {
+					m.expanded[r.item.Key()] = true
+					m.rebuild()
+				}
+			}
+		case "left":
+			if r, ok := m.selectedRow(); ok {
+				if len(r.item.Children) > 0 && m.isExpanded(r.item) && r.parent >= 0 && m.List.Query == "" {
+					m.expanded[r.item.Key()] = false
+					m.rebuild()
+				} else if r.parent >= 0 {
+					m.List.Cursor = r.parent
+				}
+			}
+		case "+":
+			m.setAll(true)
+		case "-":
+			m.setAll(false)
+		case "a":
+			m.history = !m.history
+			return m.load()
+		case "l":
+			return m.logs()
+		case "i":
+			return m.inspect()
+		case "r":
+			m.confirmRestart()
+		case "?":
+			return func() tea.Msg {
+				return view.NavigateToMsg{
+					ViewName: view.NameHelp,
+					Payload:  GetXrayHelpContent(),
+				}
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
+// GetXrayHelpContent returns categorized help for the xray view
+func GetXrayHelpContent() []helpview.HelpCategory {
+	return []helpview.HelpCategory{
+		{
+			Title: "General",
+			Items: []helpview.HelpItem{
+				{Keys: "<l>", Description: "Logs of the service or task"},
+				{Keys: "<i>", Description: "Inspect"},
+				{Keys: "<r>", Description: "Restart the stack or service"},
+				{Keys: "<a>", Description: "Toggle the tasks swarm shut down"},
+				{Keys: "</>", Description: "Filter, keeping the parents of matches"},
+			},
+		},
+		{
+			Title: "Tree",
+			Items: []helpview.HelpItem{
+				{Keys: "<enter>", Description: "Fold/unfold"},
+				{Keys: "<→>", Description: "Unfold, or go to the first child"},
+				{Keys: "<←>", Description: "Fold, or go to the parent"},
+				{Keys: "<+>", Description: "Unfold all"},
+				{Keys: "<->", Description: "Fold all"},
+			},
+		},
+		{
+			Title: "Navigation",
+			Items: []helpview.HelpItem{
+				{Keys: "<↑/↓>", Description: "Navigate"},
+				{Keys: "<pgup>", Description: "Page up"},
+				{Keys: "<pgdown>", Description: "Page down"},
+				{Keys: "<[/]>", Description: "History back/forward"},
+				{Keys: "<ctrl+b>", Description: "Jump to a breadcrumb"},
+				{Keys: "<q>", Description: "Close"},
+			},
+		},
+	}
+}
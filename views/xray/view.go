@@ -0,0 +1,221 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+package xrayview
+
+import (
+	"fmt"
+	"strings"
+	"swarmcli/docker"
+	"swarmcli/ui"
+	filterlist "swarmcli/ui/components/filterable/list"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	guideStyle    = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+	kindStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("8"))
+	nameStyle     = lipgloss.NewStyle().Foreground(lipgloss.Color("15")).Bold(true)
+	detailStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("244"))
+	okStyle       = lipgloss.NewStyle().Foreground(lipgloss.Color("42"))
+	pendingStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("214"))
+	errStyle      = lipgloss.NewStyle().Foreground(lipgloss.Color("196"))
+	selectedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("230")).Background(lipgloss.Color("63")).Bold(true)
+)
+
+// segment is a part of a row with its style.
+type segment struct {
+	text  string
+	style lipgloss.Style
+}
+
+// stateStyle colours a state by how healthy it is.
+func stateStyle(state string) lipgloss.Style {
+	switch state {
+	case "running", "healthy":
+		return okStyle
+	case "failed", "rejected", "unhealthy", "orphaned":
+		return errStyle
+	case "complete", "shutdown", "exited", "removed":
+		return detailStyle
+	}
+	return pendingStyle
+}
+
+// replicasStyle colours the running/desired replicas of a service.
+func replicasStyle(state string) lipgloss.Style {
+	running, desired, _ := strings.Cut(state, "/")
+	if running == desired {
+		return okStyle
+	}
+	return pendingStyle
+}
+
+// segments spells out an item: its kind, name and what is known of it.
+func segments(it *docker.XrayItem) []segment {
+	parts := []segment{{it.Kind + " ", kindStyle}, {it.Name, nameStyle}}
+	add := func(text string, style lipgloss.Style) {
+		if text != "" {
+			parts = append(parts, segment{"  " + text, style})
+		}
+	}
+	switch it.Kind {
+	case docker.XrayService:
+		add(it.State, replicasStyle(it.State))
+	case docker.XrayTask:
+		add(it.State, stateStyle(it.State))
+		add("on "+it.Node, detailStyle)
+		add(it.Err, errStyle)
+	case docker.XrayContainer:
+		add(it.State, stateStyle(it.State))
+		add(it.Health, stateStyle(it.Health))
+		if it.Exited {
+			style := detailStyle
+			if it.ExitCode != 0 {
+				style = errStyle
+			}
+			add(fmt.Sprintf("exit %d", it.ExitCode), style)
+		}
+		add("on "+it.Node, detailStyle)
+	case docker.XrayConfig, docker.XraySecret:
+		add("→ "+it.Target, detailStyle)
+	case docker.XrayNode:
+		style := errStyle
+		if strings.HasPrefix(it.State, "ready") {
+			style = okStyle
+		}
+		add(it.State, style)
+	default:
+		add(it.State, detailStyle)
+	}
+	return parts
+}
+
+// toggle marks whether an item is folded.
+func (m *Model) toggle(r row) string {
+	switch {
+	case len(r.item.Children) == 0:
+		return "  "
+	case m.List.Query != "" || m.isExpanded(r.item):
+		return "▾ "
+	}
+	return "▸ "
+}
+
+func (m *Model) renderRow(r row, selected bool, width int) string {
+	parts := segments(r.item)
+	if selected {
+		var b strings.Builder
+		b.WriteString(r.guides + m.toggle(r))
+		for _, p := range parts {
+			b.WriteString(p.text)
+		}
+		line := b.String()
+		if pad := width - lipgloss.Width(line); pad > 0 {
+			line += strings.Repeat(" ", pad)
+		}
+		return selectedStyle.Render(line)
+	}
+	var b strings.Builder
+	b.WriteString(guideStyle.Render(r.guides + m.toggle(r)))
+	for _, p := range parts {
+		b.WriteString(p.style.Render(p.text))
+	}
+	return b.String()
+}
+
+// describe spells out the item under the cursor for the status bar.
+func describe(it *docker.XrayItem) string {
+	s := it.Kind + " " + it.Name
+	if it.ID != "" && it.ID != it.Name {
+		s += " (" + it.ID + ")"
+	}
+	if it.Kind == docker.XrayContainer && it.Health == "" && it.State == "running" {
+		s += " — health is only known for the node swarmcli is connected to"
+	}
+	if it.Err != "" {
+		s += ": " + it.Err
+	}
+	return s
+}
+
+func (m *Model) title() string {
+	title := "Xray: "
+	switch {
+	case m.scope.Node != "":
+		title += "node " + m.scope.Node
+	case m.scope.Stack != "":
+		title += "stack " + m.scope.Stack
+	default:
+		title += "cluster"
+	}
+	switch {
+	case m.loading && !m.loaded:
+		title += " — loading…"
+	case m.err != nil:
+		title += " — failed"
+	}
+	return title
+}
+
+func (m *Model) View() string {
+	width := m.List.Viewport.Width
+	if width <= 0 {
+		if m.width > 0 {
+			width = m.width
+		} else {
+			width = 80
+		}
+	}
+
+	tasks := "current tasks"
+	if m.history {
+		tasks = "all tasks, shut down included"
+	}
+	header := ui.FrameHeaderStyle.Render(fmt.Sprintf("Showing %s • %d items listed", tasks, len(m.List.Filtered)))
+	m.List.RenderItem = func(r row, selected bool, _ int) string {
+		return m.renderRow(r, selected, width)
+	}
+
+	status := "Nothing selected"
+	if it, ok := m.SelectedItem(); ok {
+		status = describe(it)
+	}
+	footer := ui.StatusBarStyle.Render(status)
+	if m.List.Mode == filterlist.ModeSearching {
+		footer += "\n" + ui.StatusBarStyle.Render("Filter (type then Enter): "+m.List.Query)
+	} else if m.List.Query != "" {
+		footer += "\n" + ui.StatusBarStyle.Render("Filter: "+m.List.Query)
+	}
+
+	frame := ui.ComputeFrameDimensions(
+		m.List.Viewport.Width,
+		m.List.Viewport.Height,
+		m.width,
+		m.height,
+		header,
+		footer,
+	)
+	if frame.DesiredContentLines < 1 {
+		frame.DesiredContentLines = 1
+	}
+
+	var content string
+	switch {
+	case !m.loaded:
+		content = "Loading..."
+	case m.err != nil && m.root == nil:
+		content = "Could not build the tree: " + m.err.Error()
+	case len(m.List.Filtered) == 0:
+		content = "No items match: " + m.List.Query
+	default:
+		content = m.List.VisibleContent(frame.DesiredContentLines)
+	}
+
+	framed := ui.RenderFramedBoxHeight(m.title(), header, content, footer, frame.FrameWidth, frame.FrameHeight)
+	if m.confirmDialog.Visible {
+		framed = ui.OverlayCentered(framed, m.confirmDialog.View(), frame.FrameWidth, frame.FrameHeight)
+	}
+	return framed
+}
@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: Apache-2.0
+// Copyright © 2026 Eldara Tech
+
+// Package xrayview shows a stack, a node or the whole cluster as a tree:
+// services, their tasks, the containers of the tasks and the configs and
+// secrets mounted in them. Every level folds, and logs, inspect and restart
+// work on any item.
+package xrayview
+
+import swarmlog "swarmcli/utils/log"
+
+const ViewName = "xray"
+
+func l() *swarmlog.SwarmLogger {
+	return swarmlog.L().With("view", "xray")
+}